@@ -52,6 +52,10 @@ type ClientAuthenticationRequest struct {
 	ClientSecret        *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
 	ClientAssertionType *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=client_assertion_type,json=clientAssertionType,proto3" json:"client_assertion_type,omitempty"`
 	ClientAssertion     *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=client_assertion,json=clientAssertion,proto3" json:"client_assertion,omitempty"`
+	// OPTIONAL. Set when client_id and client_secret are given using HTTP Basic
+	// authentication scheme.
+	// https://tools.ietf.org/html/rfc6749#section-2.3.1
+	BasicAuthentication bool `protobuf:"varint,5,opt,name=basic_authentication,json=basicAuthentication,proto3" json:"basic_authentication,omitempty"`
 }
 
 func (x *ClientAuthenticationRequest) Reset() {
@@ -114,32 +118,527 @@ func (x *ClientAuthenticationRequest) GetClientAssertion() *wrapperspb.StringVal
 	return nil
 }
 
+func (x *ClientAuthenticationRequest) GetBasicAuthentication() bool {
+	if x != nil {
+		return x.BasicAuthentication
+	}
+	return false
+}
+
 type ClientAuthenticationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error  *Error  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Client *Client `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Error  *Error  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Client *Client `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+}
+
+func (x *ClientAuthenticationResponse) Reset() {
+	*x = ClientAuthenticationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientAuthenticationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientAuthenticationResponse) ProtoMessage() {}
+
+func (x *ClientAuthenticationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientAuthenticationResponse.ProtoReflect.Descriptor instead.
+func (*ClientAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClientAuthenticationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ClientAuthenticationResponse) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+// https://tools.ietf.org/html/rfc7591#section-2
+type ClientRegistrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *ClientMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Bearer token authorizing the registration.
+	// https://tools.ietf.org/html/rfc7591#section-3
+	InitialAccessToken *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=initial_access_token,json=initialAccessToken,proto3" json:"initial_access_token,omitempty"`
+}
+
+func (x *ClientRegistrationRequest) Reset() {
+	*x = ClientRegistrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRegistrationRequest) ProtoMessage() {}
+
+func (x *ClientRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*ClientRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClientRegistrationRequest) GetMetadata() *ClientMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ClientRegistrationRequest) GetInitialAccessToken() *wrapperspb.StringValue {
+	if x != nil {
+		return x.InitialAccessToken
+	}
+	return nil
+}
+
+type ClientRegistrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error  *Error  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Client *Client `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	// https://tools.ietf.org/html/rfc7592#section-3
+	RegistrationAccessToken string `protobuf:"bytes,3,opt,name=registration_access_token,json=registrationAccessToken,proto3" json:"registration_access_token,omitempty"`
+	RegistrationClientUri   string `protobuf:"bytes,4,opt,name=registration_client_uri,json=registrationClientUri,proto3" json:"registration_client_uri,omitempty"`
+}
+
+func (x *ClientRegistrationResponse) Reset() {
+	*x = ClientRegistrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRegistrationResponse) ProtoMessage() {}
+
+func (x *ClientRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*ClientRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClientRegistrationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ClientRegistrationResponse) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ClientRegistrationResponse) GetRegistrationAccessToken() string {
+	if x != nil {
+		return x.RegistrationAccessToken
+	}
+	return ""
+}
+
+func (x *ClientRegistrationResponse) GetRegistrationClientUri() string {
+	if x != nil {
+		return x.RegistrationClientUri
+	}
+	return ""
+}
+
+// https://tools.ietf.org/html/rfc7592#section-2.1
+type ClientReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId                string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RegistrationAccessToken string `protobuf:"bytes,2,opt,name=registration_access_token,json=registrationAccessToken,proto3" json:"registration_access_token,omitempty"`
+}
+
+func (x *ClientReadRequest) Reset() {
+	*x = ClientReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientReadRequest) ProtoMessage() {}
+
+func (x *ClientReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientReadRequest.ProtoReflect.Descriptor instead.
+func (*ClientReadRequest) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClientReadRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientReadRequest) GetRegistrationAccessToken() string {
+	if x != nil {
+		return x.RegistrationAccessToken
+	}
+	return ""
+}
+
+type ClientReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error                 *Error  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Client                *Client `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	RegistrationClientUri string  `protobuf:"bytes,3,opt,name=registration_client_uri,json=registrationClientUri,proto3" json:"registration_client_uri,omitempty"`
+}
+
+func (x *ClientReadResponse) Reset() {
+	*x = ClientReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientReadResponse) ProtoMessage() {}
+
+func (x *ClientReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientReadResponse.ProtoReflect.Descriptor instead.
+func (*ClientReadResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClientReadResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ClientReadResponse) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ClientReadResponse) GetRegistrationClientUri() string {
+	if x != nil {
+		return x.RegistrationClientUri
+	}
+	return ""
+}
+
+// https://tools.ietf.org/html/rfc7592#section-2.2
+type ClientUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId                string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RegistrationAccessToken string `protobuf:"bytes,2,opt,name=registration_access_token,json=registrationAccessToken,proto3" json:"registration_access_token,omitempty"`
+	// Client metadata replacing the registered values, omitted values are
+	// removed from the registration.
+	Metadata *ClientMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ClientUpdateRequest) Reset() {
+	*x = ClientUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientUpdateRequest) ProtoMessage() {}
+
+func (x *ClientUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ClientUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClientUpdateRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientUpdateRequest) GetRegistrationAccessToken() string {
+	if x != nil {
+		return x.RegistrationAccessToken
+	}
+	return ""
+}
+
+func (x *ClientUpdateRequest) GetMetadata() *ClientMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ClientUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error                 *Error  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Client                *Client `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	RegistrationClientUri string  `protobuf:"bytes,3,opt,name=registration_client_uri,json=registrationClientUri,proto3" json:"registration_client_uri,omitempty"`
+}
+
+func (x *ClientUpdateResponse) Reset() {
+	*x = ClientUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientUpdateResponse) ProtoMessage() {}
+
+func (x *ClientUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientUpdateResponse.ProtoReflect.Descriptor instead.
+func (*ClientUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClientUpdateResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ClientUpdateResponse) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ClientUpdateResponse) GetRegistrationClientUri() string {
+	if x != nil {
+		return x.RegistrationClientUri
+	}
+	return ""
+}
+
+// https://tools.ietf.org/html/rfc7592#section-2.3
+type ClientDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId                string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RegistrationAccessToken string `protobuf:"bytes,2,opt,name=registration_access_token,json=registrationAccessToken,proto3" json:"registration_access_token,omitempty"`
+}
+
+func (x *ClientDeleteRequest) Reset() {
+	*x = ClientDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientDeleteRequest) ProtoMessage() {}
+
+func (x *ClientDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ClientDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClientDeleteRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientDeleteRequest) GetRegistrationAccessToken() string {
+	if x != nil {
+		return x.RegistrationAccessToken
+	}
+	return ""
+}
+
+type ClientDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ClientAuthenticationResponse) Reset() {
-	*x = ClientAuthenticationResponse{}
+func (x *ClientDeleteResponse) Reset() {
+	*x = ClientDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oidc_core_v1_client_api_proto_msgTypes[1]
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientAuthenticationResponse) String() string {
+func (x *ClientDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientAuthenticationResponse) ProtoMessage() {}
+func (*ClientDeleteResponse) ProtoMessage() {}
 
-func (x *ClientAuthenticationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oidc_core_v1_client_api_proto_msgTypes[1]
+func (x *ClientDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,51 +649,119 @@ func (x *ClientAuthenticationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientAuthenticationResponse.ProtoReflect.Descriptor instead.
-func (*ClientAuthenticationResponse) Descriptor() ([]byte, []int) {
-	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ClientDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ClientDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ClientAuthenticationResponse) GetError() *Error {
+func (x *ClientDeleteResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-func (x *ClientAuthenticationResponse) GetClient() *Client {
+type InitialAccessTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Allowed application types, all types are allowed when empty.
+	ApplicationTypes []string `protobuf:"bytes,1,rep,name=application_types,json=applicationTypes,proto3" json:"application_types,omitempty"`
+	// Allowed grant types, all types are allowed when empty.
+	GrantTypes []string `protobuf:"bytes,2,rep,name=grant_types,json=grantTypes,proto3" json:"grant_types,omitempty"`
+	// Token lifetime in seconds, the default lifetime is used when zero.
+	ExpiresIn uint64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// Maximum registration count, unlimited when zero.
+	UsageLimit uint64 `protobuf:"varint,4,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+}
+
+func (x *InitialAccessTokenRequest) Reset() {
+	*x = InitialAccessTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitialAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitialAccessTokenRequest) ProtoMessage() {}
+
+func (x *InitialAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitialAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*InitialAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InitialAccessTokenRequest) GetApplicationTypes() []string {
 	if x != nil {
-		return x.Client
+		return x.ApplicationTypes
 	}
 	return nil
 }
 
-// https://tools.ietf.org/html/rfc7591#section-2
-type ClientRegistrationRequest struct {
+func (x *InitialAccessTokenRequest) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *InitialAccessTokenRequest) GetExpiresIn() uint64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *InitialAccessTokenRequest) GetUsageLimit() uint64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+type InitialAccessTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata *ClientMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Error *Error              `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Token *InitialAccessToken `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *ClientRegistrationRequest) Reset() {
-	*x = ClientRegistrationRequest{}
+func (x *InitialAccessTokenResponse) Reset() {
+	*x = InitialAccessTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oidc_core_v1_client_api_proto_msgTypes[2]
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientRegistrationRequest) String() string {
+func (x *InitialAccessTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientRegistrationRequest) ProtoMessage() {}
+func (*InitialAccessTokenResponse) ProtoMessage() {}
 
-func (x *ClientRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oidc_core_v1_client_api_proto_msgTypes[2]
+func (x *InitialAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,19 +772,82 @@ func (x *ClientRegistrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientRegistrationRequest.ProtoReflect.Descriptor instead.
-func (*ClientRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use InitialAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*InitialAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ClientRegistrationRequest) GetMetadata() *ClientMeta {
+func (x *InitialAccessTokenResponse) GetError() *Error {
 	if x != nil {
-		return x.Metadata
+		return x.Error
 	}
 	return nil
 }
 
-type ClientRegistrationResponse struct {
+func (x *InitialAccessTokenResponse) GetToken() *InitialAccessToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type ClientStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Target status, use CLIENT_STATUS_ACTIVE to approve a pending client.
+	Status ClientStatus `protobuf:"varint,2,opt,name=status,proto3,enum=oidc.core.v1.ClientStatus" json:"status,omitempty"`
+}
+
+func (x *ClientStatusRequest) Reset() {
+	*x = ClientStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientStatusRequest) ProtoMessage() {}
+
+func (x *ClientStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientStatusRequest.ProtoReflect.Descriptor instead.
+func (*ClientStatusRequest) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClientStatusRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientStatusRequest) GetStatus() ClientStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ClientStatus_CLIENT_STATUS_INVALID
+}
+
+type ClientStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -226,23 +856,23 @@ type ClientRegistrationResponse struct {
 	Client *Client `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (x *ClientRegistrationResponse) Reset() {
-	*x = ClientRegistrationResponse{}
+func (x *ClientStatusResponse) Reset() {
+	*x = ClientStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oidc_core_v1_client_api_proto_msgTypes[3]
+		mi := &file_oidc_core_v1_client_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientRegistrationResponse) String() string {
+func (x *ClientStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientRegistrationResponse) ProtoMessage() {}
+func (*ClientStatusResponse) ProtoMessage() {}
 
-func (x *ClientRegistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oidc_core_v1_client_api_proto_msgTypes[3]
+func (x *ClientStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_client_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,19 +883,19 @@ func (x *ClientRegistrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientRegistrationResponse.ProtoReflect.Descriptor instead.
-func (*ClientRegistrationResponse) Descriptor() ([]byte, []int) {
-	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ClientStatusResponse.ProtoReflect.Descriptor instead.
+func (*ClientStatusResponse) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_client_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ClientRegistrationResponse) GetError() *Error {
+func (x *ClientStatusResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-func (x *ClientRegistrationResponse) GetClient() *Client {
+func (x *ClientStatusResponse) GetClient() *Client {
 	if x != nil {
 		return x.Client
 	}
@@ -283,7 +913,7 @@ var file_oidc_core_v1_client_api_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74,
+	0x74, 0x6f, 0x22, 0xe9, 0x02, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74,
 	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
@@ -302,45 +932,174 @@ var file_oidc_core_v1_client_api_proto_rawDesc = []byte{
 	0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x1c, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65,
+	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x62,
+	0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x61, 0x73, 0x69, 0x63,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x77,
+	0x0a, 0x1c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x69, 0x64, 0x63,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x19, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x14, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe9, 0x01, 0x0a, 0x1a,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x69, 0x64, 0x63,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x36, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x69, 0x22, 0x6c, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x69,
+	0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x69,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x69, 0x22, 0xa4, 0x01,
+	0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xa7, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f,
+	0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72,
+	0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x69, 0x22, 0x6e,
+	0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41,
+	0x0a, 0x14, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xa9, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7f, 0x0a,
+	0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65,
 	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x69, 0x64,
 	0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x75, 0x0a, 0x1a, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66,
+	0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x14, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x69, 0x64, 0x63,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x32, 0x82, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x50, 0x49, 0x12, 0x67, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xe8, 0x01, 0x0a,
+	0x15, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x5f, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x27, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x69,
+	0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
 	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x32, 0x82,
-	0x01, 0x0a, 0x17, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x67, 0x0a, 0x0c, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x6f, 0x69, 0x64,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x69,
+	0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x8b, 0x02, 0x0a, 0x16, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x50, 0x49, 0x12, 0x4b, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x2e, 0x6f, 0x69, 0x64,
+	0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x69,
+	0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6f, 0x69, 0x64, 0x63,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f,
+	0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6f,
+	0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x6c, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x50, 0x49, 0x12, 0x57, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x6f, 0x69, 0x64,
 	0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x32, 0x78, 0x0a, 0x15, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x50, 0x49, 0x12, 0x5f, 0x0a, 0x08,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x15, 0x5a,
-	0x13, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f,
-	0x72, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x72, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -355,36 +1114,70 @@ func file_oidc_core_v1_client_api_proto_rawDescGZIP() []byte {
 	return file_oidc_core_v1_client_api_proto_rawDescData
 }
 
-var file_oidc_core_v1_client_api_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_oidc_core_v1_client_api_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_oidc_core_v1_client_api_proto_goTypes = []interface{}{
 	(*ClientAuthenticationRequest)(nil),  // 0: oidc.core.v1.ClientAuthenticationRequest
 	(*ClientAuthenticationResponse)(nil), // 1: oidc.core.v1.ClientAuthenticationResponse
 	(*ClientRegistrationRequest)(nil),    // 2: oidc.core.v1.ClientRegistrationRequest
 	(*ClientRegistrationResponse)(nil),   // 3: oidc.core.v1.ClientRegistrationResponse
-	(*wrapperspb.StringValue)(nil),       // 4: google.protobuf.StringValue
-	(*Error)(nil),                        // 5: oidc.core.v1.Error
-	(*Client)(nil),                       // 6: oidc.core.v1.Client
-	(*ClientMeta)(nil),                   // 7: oidc.core.v1.ClientMeta
+	(*ClientReadRequest)(nil),            // 4: oidc.core.v1.ClientReadRequest
+	(*ClientReadResponse)(nil),           // 5: oidc.core.v1.ClientReadResponse
+	(*ClientUpdateRequest)(nil),          // 6: oidc.core.v1.ClientUpdateRequest
+	(*ClientUpdateResponse)(nil),         // 7: oidc.core.v1.ClientUpdateResponse
+	(*ClientDeleteRequest)(nil),          // 8: oidc.core.v1.ClientDeleteRequest
+	(*ClientDeleteResponse)(nil),         // 9: oidc.core.v1.ClientDeleteResponse
+	(*InitialAccessTokenRequest)(nil),    // 10: oidc.core.v1.InitialAccessTokenRequest
+	(*InitialAccessTokenResponse)(nil),   // 11: oidc.core.v1.InitialAccessTokenResponse
+	(*ClientStatusRequest)(nil),          // 12: oidc.core.v1.ClientStatusRequest
+	(*ClientStatusResponse)(nil),         // 13: oidc.core.v1.ClientStatusResponse
+	(*wrapperspb.StringValue)(nil),       // 14: google.protobuf.StringValue
+	(*Error)(nil),                        // 15: oidc.core.v1.Error
+	(*Client)(nil),                       // 16: oidc.core.v1.Client
+	(*ClientMeta)(nil),                   // 17: oidc.core.v1.ClientMeta
+	(*InitialAccessToken)(nil),           // 18: oidc.core.v1.InitialAccessToken
+	(ClientStatus)(0),                    // 19: oidc.core.v1.ClientStatus
 }
 var file_oidc_core_v1_client_api_proto_depIdxs = []int32{
-	4,  // 0: oidc.core.v1.ClientAuthenticationRequest.client_id:type_name -> google.protobuf.StringValue
-	4,  // 1: oidc.core.v1.ClientAuthenticationRequest.client_secret:type_name -> google.protobuf.StringValue
-	4,  // 2: oidc.core.v1.ClientAuthenticationRequest.client_assertion_type:type_name -> google.protobuf.StringValue
-	4,  // 3: oidc.core.v1.ClientAuthenticationRequest.client_assertion:type_name -> google.protobuf.StringValue
-	5,  // 4: oidc.core.v1.ClientAuthenticationResponse.error:type_name -> oidc.core.v1.Error
-	6,  // 5: oidc.core.v1.ClientAuthenticationResponse.client:type_name -> oidc.core.v1.Client
-	7,  // 6: oidc.core.v1.ClientRegistrationRequest.metadata:type_name -> oidc.core.v1.ClientMeta
-	5,  // 7: oidc.core.v1.ClientRegistrationResponse.error:type_name -> oidc.core.v1.Error
-	6,  // 8: oidc.core.v1.ClientRegistrationResponse.client:type_name -> oidc.core.v1.Client
-	0,  // 9: oidc.core.v1.ClientAuthenticationAPI.Authenticate:input_type -> oidc.core.v1.ClientAuthenticationRequest
-	2,  // 10: oidc.core.v1.ClientRegistrationAPI.Register:input_type -> oidc.core.v1.ClientRegistrationRequest
-	1,  // 11: oidc.core.v1.ClientAuthenticationAPI.Authenticate:output_type -> oidc.core.v1.ClientAuthenticationResponse
-	3,  // 12: oidc.core.v1.ClientRegistrationAPI.Register:output_type -> oidc.core.v1.ClientRegistrationResponse
-	11, // [11:13] is the sub-list for method output_type
-	9,  // [9:11] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	14, // 0: oidc.core.v1.ClientAuthenticationRequest.client_id:type_name -> google.protobuf.StringValue
+	14, // 1: oidc.core.v1.ClientAuthenticationRequest.client_secret:type_name -> google.protobuf.StringValue
+	14, // 2: oidc.core.v1.ClientAuthenticationRequest.client_assertion_type:type_name -> google.protobuf.StringValue
+	14, // 3: oidc.core.v1.ClientAuthenticationRequest.client_assertion:type_name -> google.protobuf.StringValue
+	15, // 4: oidc.core.v1.ClientAuthenticationResponse.error:type_name -> oidc.core.v1.Error
+	16, // 5: oidc.core.v1.ClientAuthenticationResponse.client:type_name -> oidc.core.v1.Client
+	17, // 6: oidc.core.v1.ClientRegistrationRequest.metadata:type_name -> oidc.core.v1.ClientMeta
+	14, // 7: oidc.core.v1.ClientRegistrationRequest.initial_access_token:type_name -> google.protobuf.StringValue
+	15, // 8: oidc.core.v1.ClientRegistrationResponse.error:type_name -> oidc.core.v1.Error
+	16, // 9: oidc.core.v1.ClientRegistrationResponse.client:type_name -> oidc.core.v1.Client
+	15, // 10: oidc.core.v1.ClientReadResponse.error:type_name -> oidc.core.v1.Error
+	16, // 11: oidc.core.v1.ClientReadResponse.client:type_name -> oidc.core.v1.Client
+	17, // 12: oidc.core.v1.ClientUpdateRequest.metadata:type_name -> oidc.core.v1.ClientMeta
+	15, // 13: oidc.core.v1.ClientUpdateResponse.error:type_name -> oidc.core.v1.Error
+	16, // 14: oidc.core.v1.ClientUpdateResponse.client:type_name -> oidc.core.v1.Client
+	15, // 15: oidc.core.v1.ClientDeleteResponse.error:type_name -> oidc.core.v1.Error
+	15, // 16: oidc.core.v1.InitialAccessTokenResponse.error:type_name -> oidc.core.v1.Error
+	18, // 17: oidc.core.v1.InitialAccessTokenResponse.token:type_name -> oidc.core.v1.InitialAccessToken
+	19, // 18: oidc.core.v1.ClientStatusRequest.status:type_name -> oidc.core.v1.ClientStatus
+	15, // 19: oidc.core.v1.ClientStatusResponse.error:type_name -> oidc.core.v1.Error
+	16, // 20: oidc.core.v1.ClientStatusResponse.client:type_name -> oidc.core.v1.Client
+	0,  // 21: oidc.core.v1.ClientAuthenticationAPI.Authenticate:input_type -> oidc.core.v1.ClientAuthenticationRequest
+	2,  // 22: oidc.core.v1.ClientRegistrationAPI.Register:input_type -> oidc.core.v1.ClientRegistrationRequest
+	10, // 23: oidc.core.v1.ClientRegistrationAPI.IssueInitialAccessToken:input_type -> oidc.core.v1.InitialAccessTokenRequest
+	4,  // 24: oidc.core.v1.ClientConfigurationAPI.Read:input_type -> oidc.core.v1.ClientReadRequest
+	6,  // 25: oidc.core.v1.ClientConfigurationAPI.Update:input_type -> oidc.core.v1.ClientUpdateRequest
+	8,  // 26: oidc.core.v1.ClientConfigurationAPI.Delete:input_type -> oidc.core.v1.ClientDeleteRequest
+	12, // 27: oidc.core.v1.ClientApprovalAPI.UpdateStatus:input_type -> oidc.core.v1.ClientStatusRequest
+	1,  // 28: oidc.core.v1.ClientAuthenticationAPI.Authenticate:output_type -> oidc.core.v1.ClientAuthenticationResponse
+	3,  // 29: oidc.core.v1.ClientRegistrationAPI.Register:output_type -> oidc.core.v1.ClientRegistrationResponse
+	11, // 30: oidc.core.v1.ClientRegistrationAPI.IssueInitialAccessToken:output_type -> oidc.core.v1.InitialAccessTokenResponse
+	5,  // 31: oidc.core.v1.ClientConfigurationAPI.Read:output_type -> oidc.core.v1.ClientReadResponse
+	7,  // 32: oidc.core.v1.ClientConfigurationAPI.Update:output_type -> oidc.core.v1.ClientUpdateResponse
+	9,  // 33: oidc.core.v1.ClientConfigurationAPI.Delete:output_type -> oidc.core.v1.ClientDeleteResponse
+	13, // 34: oidc.core.v1.ClientApprovalAPI.UpdateStatus:output_type -> oidc.core.v1.ClientStatusResponse
+	28, // [28:35] is the sub-list for method output_type
+	21, // [21:28] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_oidc_core_v1_client_api_proto_init() }
@@ -443,6 +1236,126 @@ func file_oidc_core_v1_client_api_proto_init() {
 				return nil
 			}
 		}
+		file_oidc_core_v1_client_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitialAccessTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitialAccessTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oidc_core_v1_client_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -450,9 +1363,9 @@ func file_oidc_core_v1_client_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oidc_core_v1_client_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   14,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   4,
 		},
 		GoTypes:           file_oidc_core_v1_client_api_proto_goTypes,
 		DependencyIndexes: file_oidc_core_v1_client_api_proto_depIdxs,
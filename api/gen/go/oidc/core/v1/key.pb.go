@@ -0,0 +1,314 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: oidc/core/v1/key.proto
+
+package corev1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// KeyStatus describes server key lifecycle states.
+type KeyStatus int32
+
+const (
+	// Default value
+	KeyStatus_KEY_STATUS_INVALID KeyStatus = 0
+	// Explicit unknown
+	KeyStatus_KEY_STATUS_UNKNOWN KeyStatus = 1
+	// Published in the key set before being used for signing, to let relying
+	// parties refresh their key cache.
+	KeyStatus_KEY_STATUS_PENDING KeyStatus = 2
+	// Used for signing and published in the key set.
+	KeyStatus_KEY_STATUS_ACTIVE KeyStatus = 3
+	// No longer used for signing, published in the key set until all tokens
+	// signed by this key are expired.
+	KeyStatus_KEY_STATUS_RETIRED KeyStatus = 4
+)
+
+// Enum value maps for KeyStatus.
+var (
+	KeyStatus_name = map[int32]string{
+		0: "KEY_STATUS_INVALID",
+		1: "KEY_STATUS_UNKNOWN",
+		2: "KEY_STATUS_PENDING",
+		3: "KEY_STATUS_ACTIVE",
+		4: "KEY_STATUS_RETIRED",
+	}
+	KeyStatus_value = map[string]int32{
+		"KEY_STATUS_INVALID": 0,
+		"KEY_STATUS_UNKNOWN": 1,
+		"KEY_STATUS_PENDING": 2,
+		"KEY_STATUS_ACTIVE":  3,
+		"KEY_STATUS_RETIRED": 4,
+	}
+)
+
+func (x KeyStatus) Enum() *KeyStatus {
+	p := new(KeyStatus)
+	*p = x
+	return p
+}
+
+func (x KeyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_oidc_core_v1_key_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyStatus) Type() protoreflect.EnumType {
+	return &file_oidc_core_v1_key_proto_enumTypes[0]
+}
+
+func (x KeyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyStatus.Descriptor instead.
+func (KeyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_oidc_core_v1_key_proto_rawDescGZIP(), []int{0}
+}
+
+// Key describes a server signing key.
+type Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key identifier, the JWK thumbprint.
+	Kid    string    `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Status KeyStatus `protobuf:"varint,2,opt,name=status,proto3,enum=oidc.core.v1.KeyStatus" json:"status,omitempty"`
+	// JSON encoded private JWK.
+	Jwk         []byte `protobuf:"bytes,3,opt,name=jwk,proto3" json:"jwk,omitempty"`
+	CreatedAt   uint64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActivatedAt uint64 `protobuf:"varint,5,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	RetiredAt   uint64 `protobuf:"varint,6,opt,name=retired_at,json=retiredAt,proto3" json:"retired_at,omitempty"`
+	// Deletion time of a retired key.
+	ExpiresAt uint64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Signature algorithm, each algorithm has its own key lifecycle.
+	Alg string `protobuf:"bytes,8,opt,name=alg,proto3" json:"alg,omitempty"`
+}
+
+func (x *Key) Reset() {
+	*x = Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oidc_core_v1_key_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Key) ProtoMessage() {}
+
+func (x *Key) ProtoReflect() protoreflect.Message {
+	mi := &file_oidc_core_v1_key_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Key.ProtoReflect.Descriptor instead.
+func (*Key) Descriptor() ([]byte, []int) {
+	return file_oidc_core_v1_key_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Key) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *Key) GetStatus() KeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KeyStatus_KEY_STATUS_INVALID
+}
+
+func (x *Key) GetJwk() []byte {
+	if x != nil {
+		return x.Jwk
+	}
+	return nil
+}
+
+func (x *Key) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Key) GetActivatedAt() uint64 {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return 0
+}
+
+func (x *Key) GetRetiredAt() uint64 {
+	if x != nil {
+		return x.RetiredAt
+	}
+	return 0
+}
+
+func (x *Key) GetExpiresAt() uint64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Key) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+var File_oidc_core_v1_key_proto protoreflect.FileDescriptor
+
+var file_oidc_core_v1_key_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6f, 0x69, 0x64, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6b,
+	0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x22, 0xec, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x69, 0x64,
+	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x6f, 0x69, 0x64, 0x63, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6a, 0x77, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x6c, 0x67, 0x2a, 0x82, 0x01, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4b,
+	0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4b,
+	0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x54, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x42, 0x15, 0x5a, 0x13, 0x6f, 0x69,
+	0x64, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x72, 0x65, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_oidc_core_v1_key_proto_rawDescOnce sync.Once
+	file_oidc_core_v1_key_proto_rawDescData = file_oidc_core_v1_key_proto_rawDesc
+)
+
+func file_oidc_core_v1_key_proto_rawDescGZIP() []byte {
+	file_oidc_core_v1_key_proto_rawDescOnce.Do(func() {
+		file_oidc_core_v1_key_proto_rawDescData = protoimpl.X.CompressGZIP(file_oidc_core_v1_key_proto_rawDescData)
+	})
+	return file_oidc_core_v1_key_proto_rawDescData
+}
+
+var file_oidc_core_v1_key_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_oidc_core_v1_key_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_oidc_core_v1_key_proto_goTypes = []interface{}{
+	(KeyStatus)(0), // 0: oidc.core.v1.KeyStatus
+	(*Key)(nil),    // 1: oidc.core.v1.Key
+}
+var file_oidc_core_v1_key_proto_depIdxs = []int32{
+	0, // 0: oidc.core.v1.Key.status:type_name -> oidc.core.v1.KeyStatus
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_oidc_core_v1_key_proto_init() }
+func file_oidc_core_v1_key_proto_init() {
+	if File_oidc_core_v1_key_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oidc_core_v1_key_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_oidc_core_v1_key_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_oidc_core_v1_key_proto_goTypes,
+		DependencyIndexes: file_oidc_core_v1_key_proto_depIdxs,
+		EnumInfos:         file_oidc_core_v1_key_proto_enumTypes,
+		MessageInfos:      file_oidc_core_v1_key_proto_msgTypes,
+	}.Build()
+	File_oidc_core_v1_key_proto = out.File
+	file_oidc_core_v1_key_proto_rawDesc = nil
+	file_oidc_core_v1_key_proto_goTypes = nil
+	file_oidc_core_v1_key_proto_depIdxs = nil
+}
@@ -67,6 +67,31 @@ const (
 	ResponseTypeToken = "token"
 )
 
+// Response Modes --------------------------------------------------------------
+// https://openid.net/specs/oauth-v2-jarm.html#section-2.3
+
+const (
+	// ResponseModeQuery encodes response parameters in the redirect uri query.
+	ResponseModeQuery = "query"
+	// ResponseModeFragment encodes response parameters in the redirect uri
+	// fragment.
+	ResponseModeFragment = "fragment"
+	// ResponseModeFormPost encodes response parameters as HTML form values
+	// auto-submitted by the user agent.
+	ResponseModeFormPost = "form_post"
+	// ResponseModeQueryJWT encodes the JARM response in the redirect uri query.
+	ResponseModeQueryJWT = "query.jwt"
+	// ResponseModeFragmentJWT encodes the JARM response in the redirect uri
+	// fragment.
+	ResponseModeFragmentJWT = "fragment.jwt"
+	// ResponseModeFormPostJWT encodes the JARM response as an HTML form value
+	// auto-submitted by the user agent.
+	ResponseModeFormPostJWT = "form_post.jwt"
+	// ResponseModeJWT is the JARM shortcut using the default response mode of
+	// the response type.
+	ResponseModeJWT = "jwt"
+)
+
 // Authentication Methods ------------------------------------------------------
 
 const (
@@ -78,6 +103,9 @@ const (
 	// AuthMethodClientSecretBasic : The client uses HTTP Basic as defined in
 	// OAuth 2.0
 	AuthMethodClientSecretBasic = "client_secret_basic"
+	// AuthMethodClientSecretJWT : The client uses JWT assertion signed with
+	// its client secret.
+	AuthMethodClientSecretJWT = "client_secret_jwt"
 	// AuthMethodPrivateKeyJWT : The client uses JWT assertion.
 	AuthMethodPrivateKeyJWT = "private_key_jwt"
 )
@@ -109,8 +109,7 @@ func callback(solidClient client.Client, config *session.Config, prover dpop.Pro
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var (
 			ctx         = r.Context()
-			q           = r.URL.Query()
-			responseRaw = q.Get("response")
+			responseRaw = r.FormValue("response")
 		)
 
 		// Retrieve session
@@ -231,6 +230,18 @@ var clientPrivateKey = []byte(`{
     "alg": "ES384"
 }`)
 
+// clientEncryptionKey is used to decrypt nested JARM responses.
+var clientEncryptionKey = []byte(`{
+    "kty": "EC",
+    "kid": "client-enc",
+    "d": "5-HSBgaTsJcpd8VGFeSA4BKCZhMbj5CjMDpxhks9nes",
+    "use": "enc",
+    "crv": "P-256",
+    "x": "E3BxhdU521YzUdyIWFacHR9zilN-bBMviBwGLJpw4xA",
+    "y": "GX8t2xQ3wzaiTvqYJOLzEfiJgFqRkGFNvhsZEhWSQM4",
+    "alg": "ECDH-ES+A256KW"
+}`)
+
 func keyProvider() jwk.KeyProviderFunc {
 	var privateKey jose.JSONWebKey
 
@@ -267,34 +278,24 @@ func dpopProver() (dpop.Prover, error) {
 }
 
 func arEncoder(keyProvider jwk.KeyProviderFunc) (jwsreq.AuthorizationEncoder, error) {
-	// Retrieve private key
-	pk, err := keyProvider(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve signing key: %w", err)
-	}
-
-	// Signer options
+	// Signer options, algorithm is derived from the client key
 	options := (&jose.SignerOptions{}).WithType(jwsreq.HeaderType)
-	arSigner := jwt.DefaultSigner(jose.SigningKey{
-		Algorithm: jose.ES384,
-		Key:       pk,
-	}, options)
+	arSigner := jwt.ProviderSigner("", keyProvider, options)
 
 	// No error
 	return jwsreq.JWTAuthorizationEncoder(arSigner), nil
 }
 
-func jarmDecoder(issuer string, c client.Client) (jarm.ResponseDecoder, error) {
-	return jarm.JWTDecoder(issuer, jwt.DefaultVerifier(func(ctx context.Context) (*jose.JSONWebKeySet, error) {
-		// Retrieve server publickeys
-		jwks, _, err := c.PublicKeys(ctx)
-		if err != nil {
-			return nil, err
-		}
+func responseDecryptionKeys() (*jose.JSONWebKeySet, error) {
+	var privateKey jose.JSONWebKey
 
-		// No error
-		return jwks, nil
-	}, []string{"ES384"})), nil
+	// Decode JWK
+	if err := json.Unmarshal(clientEncryptionKey, &privateKey); err != nil {
+		return nil, err
+	}
+
+	// No error
+	return &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{privateKey}}, nil
 }
 
 func main() {
@@ -320,16 +321,20 @@ func main() {
 		JWK:         clientPrivateKey,
 		RedirectURI: "http://127.0.0.1:8085/oidc/as/127.0.0.1",
 		Scopes:      []string{"user", "profile", "email", oidc.ScopeOfflineAccess},
+		// Encrypt request objects
+		RequestObjectEncryptionAlg: "ECDH-ES+A256KW",
+		RequestObjectEncryptionEnc: "A256GCM",
 	})
 	if err != nil {
 		panic(err)
 	}
 
 	// JARM
-	jarmDecoder, err := jarmDecoder(issuer, solidClient)
+	decryptionKeys, err := responseDecryptionKeys()
 	if err != nil {
 		panic(err)
 	}
+	jarmDecoder := client.ResponseDecoder(solidClient, decryptionKeys)
 
 	// Cookie session
 	sessions := &session.Config{
@@ -18,37 +18,73 @@
 package handlers
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
+	"html/template"
 	"log"
 	"net/http"
 	"net/url"
 
-	"github.com/square/go-jose/v3"
-
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/examples/server/middleware"
 	"zntr.io/solid/pkg/sdk/jarm"
+	"zntr.io/solid/pkg/sdk/jwe"
 	"zntr.io/solid/pkg/sdk/jwsreq"
 	"zntr.io/solid/pkg/sdk/jwt"
+	"zntr.io/solid/pkg/sdk/locale"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/authorizationserver"
+	"zntr.io/solid/pkg/server/clientkeys"
+	"zntr.io/solid/pkg/server/responsemode"
 	"zntr.io/solid/pkg/server/storage"
 )
 
-// Authorization handles authorization HTTP requests.
-func Authorization(as authorizationserver.AuthorizationServer, clients storage.ClientReader, requestDecoder jwsreq.AuthorizationDecoder, jarmEncoder jarm.ResponseEncoder) http.Handler {
+var consentForm = template.Must(template.New("consent").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+  </head>
+  <body>
+	{{ if .LogoURI }}<img src="{{ .LogoURI }}" alt="">{{ end }}
+	<p>{{ .ClientName }} requests access to your account.</p>
+	<ul>
+	  {{ if .TosURI }}<li><a href="{{ .TosURI }}">Terms of service</a></li>{{ end }}
+	  {{ if .PolicyURI }}<li><a href="{{ .PolicyURI }}">Privacy policy</a></li>{{ end }}
+	</ul>
+	<form action="" method="post">
+	  <input type="hidden" name="request" value="{{ .Request }}">
+	  <button type="submit" name="consent" value="allow">Allow</button>
+	  <button type="submit" name="consent" value="deny">Deny</button>
+	</form>
+  </body>
+</html>`))
+
+// Authorization handles authorization HTTP requests. The user consent is
+// requested before issuing the authorization code, client metadata are
+// displayed according to the request ui_locales.
+func Authorization(as authorizationserver.AuthorizationServer, clients storage.ClientReader, clientKeys clientkeys.Resolver, requestDecrypter jwe.Decrypter, jarmEncoder func(alg string) jarm.ResponseEncoder) http.Handler {
 
 	issuer := as.Issuer().String()
 
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Only GET verb
-		if r.Method != http.MethodGet {
-			withError(w, r, http.StatusMethodNotAllowed, rfcerrors.InvalidRequest().Build())
-			return
+	// Display consent form
+	displayConsent := func(w http.ResponseWriter, r *http.Request, client *corev1.Client, ar *corev1.AuthorizationRequest, requestRaw string) {
+		uiLocales := ar.GetUiLocales().GetValue()
+
+		// Allow client logo and form submission
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; img-src https:; form-action 'self'")
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		// Write template to output
+		if err := consentForm.Execute(w, map[string]string{
+			"ClientName": locale.ClientName(client, uiLocales),
+			"LogoURI":    locale.LogoURI(client, uiLocales),
+			"TosURI":     locale.TosURI(client, uiLocales),
+			"PolicyURI":  locale.PolicyURI(client, uiLocales),
+			"Request":    requestRaw,
+		}); err != nil {
+			log.Println("unable to display consent form:", err)
 		}
+	}
 
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Parameters
 		var (
 			ctx        = r.Context()
@@ -57,6 +93,20 @@ func Authorization(as authorizationserver.AuthorizationServer, clients storage.C
 			requestRaw = q.Get("request")
 		)
 
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			// Consent must be submitted from the consent form
+			if r.Header.Get("Origin") != issuer {
+				withError(w, r, http.StatusForbidden, rfcerrors.InvalidRequest().Build())
+				return
+			}
+			requestRaw = r.PostFormValue("request")
+		default:
+			withError(w, r, http.StatusMethodNotAllowed, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
 		// Retrieve subject form context
 		sub, ok := middleware.Subject(ctx)
 		if !ok || sub == "" {
@@ -72,15 +122,7 @@ func Authorization(as authorizationserver.AuthorizationServer, clients storage.C
 		}
 
 		// Prepare client request decoder
-		clientRequestDecoder := jwsreq.JWTAuthorizationDecoder(jwt.DefaultVerifier(func(ctx context.Context) (*jose.JSONWebKeySet, error) {
-			var jwks jose.JSONWebKeySet
-			if err := json.Unmarshal(client.Jwks, &jwks); err != nil {
-				return nil, fmt.Errorf("unable to decode client JWKS")
-			}
-
-			// No error
-			return &jwks, nil
-		}, []string{"ES384"}))
+		clientRequestDecoder := jwsreq.JWEAuthorizationDecoder(requestDecrypter, jwsreq.JWTAuthorizationDecoder(jwt.DefaultVerifier(clientkeys.KeySetProvider(clientKeys, client), requestObjectAlgorithms(client))))
 
 		// Decode request
 		ar, err := clientRequestDecoder.Decode(ctx, requestRaw)
@@ -90,6 +132,16 @@ func Authorization(as authorizationserver.AuthorizationServer, clients storage.C
 			return
 		}
 
+		// Request user consent
+		if r.Method == http.MethodGet {
+			displayConsent(w, r, client, ar, requestRaw)
+			return
+		}
+		if r.PostFormValue("consent") != "allow" {
+			withError(w, r, http.StatusForbidden, rfcerrors.AccessDenied().Build())
+			return
+		}
+
 		// Send request to reactor
 		res, err := as.Do(ctx, &corev1.AuthorizationCodeRequest{
 			Issuer:               issuer,
@@ -107,30 +159,46 @@ func Authorization(as authorizationserver.AuthorizationServer, clients storage.C
 			return
 		}
 
-		// Build redirection uri
-		u, err := url.ParseRequestURI(authRes.RedirectUri)
-		if err != nil {
-			log.Println("unable to process redirect uri:", err)
-			withError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
-			return
+		// Resolve response mode
+		mode := authRes.ResponseMode
+		if mode == "" {
+			mode = oidc.ResponseModeQueryJWT
 		}
-
-		// Encode JARM
-		jarmToken, err := jarmEncoder.Encode(ctx, as.Issuer().String(), authRes)
+		responseWriter, err := responsemode.FromMode(mode)
 		if err != nil {
-			log.Println("unable to produce JARM token:", err)
-			withError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			log.Println("unable to resolve response mode:", err)
+			withError(w, r, http.StatusBadRequest, rfcerrors.InvalidRequest().Build())
 			return
 		}
 
-		// Assemble final uri
+		// Assemble response parameters
 		params := url.Values{}
-		params.Set("response", jarmToken)
+		if responsemode.IsJWT(mode) {
+			// Prepare response encoder with client signature algorithm
+			responseEncoder := jarmEncoder(client.AuthorizationSignedResponseAlg)
+			if client.AuthorizationEncryptedResponseAlg != "" {
+				responseEncoder = jarm.JWEEncoder(jwe.DefaultEncrypter(clientkeys.EncryptionKeyProvider(clientKeys, client), client.AuthorizationEncryptedResponseAlg, client.AuthorizationEncryptedResponseEnc), responseEncoder)
+			}
 
-		// Assign new params
-		u.RawQuery = params.Encode()
+			// Encode JARM
+			jarmToken, err := responseEncoder.Encode(ctx, issuer, authRes)
+			if err != nil {
+				log.Println("unable to produce JARM token:", err)
+				withError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+				return
+			}
+			params.Set("response", jarmToken)
+		} else {
+			params.Set("code", authRes.Code)
+			params.Set("state", authRes.State)
+			params.Set("iss", authRes.Issuer)
+		}
 
-		// Redirect to application
-		http.Redirect(w, r, u.String(), http.StatusFound)
+		// Send response to application
+		if err := responseWriter.Write(w, r, authRes.RedirectUri, params); err != nil {
+			log.Println("unable to send authorization response:", err)
+			withError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
 	})
 }
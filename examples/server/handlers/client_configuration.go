@@ -0,0 +1,149 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/authorizationserver"
+)
+
+// ClientConfiguration handles dynamic client registration management.
+// https://tools.ietf.org/html/rfc7592
+func ClientConfiguration(as authorizationserver.AuthorizationServer, prefix string) http.Handler {
+	const bodyLimiterSize = 5 << 20 // 5 Mb
+
+	withConfigurationError := func(w http.ResponseWriter, r *http.Request, err *corev1.Error) {
+		if err != nil && err.Err == "invalid_token" {
+			withError(w, r, http.StatusUnauthorized, err)
+			return
+		}
+		withError(w, r, http.StatusBadRequest, err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Extract client_id from path
+		clientID := strings.TrimPrefix(r.URL.Path, prefix)
+		if clientID == "" || strings.Contains(clientID, "/") {
+			withError(w, r, http.StatusNotFound, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
+		// Extract registration access token
+		token, err := bearerToken(r)
+		if err != nil {
+			withError(w, r, http.StatusUnauthorized, rfcerrors.InvalidToken().Build())
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			// Delegate message to reactor
+			res, err := as.Do(r.Context(), &corev1.ClientReadRequest{
+				ClientId:                clientID,
+				RegistrationAccessToken: token,
+			})
+			readRes, ok := res.(*corev1.ClientReadResponse)
+			if !ok {
+				withJSON(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+				return
+			}
+			if err != nil {
+				log.Printf("unable to process client read request: %v", err)
+				withConfigurationError(w, r, readRes.Error)
+				return
+			}
+
+			// Send json reponse
+			withJSON(w, r, http.StatusOK, toClientResponse(readRes.Client, "", readRes.RegistrationClientUri))
+
+		case http.MethodPut:
+			// Decode body
+			var reqw clientMetadataRequest
+			if err := json.NewDecoder(io.LimitReader(r.Body, bodyLimiterSize)).Decode(&reqw); err != nil {
+				log.Printf("unable to decode json request: %v", err)
+				withError(w, r, http.StatusBadRequest, rfcerrors.InvalidRequest().Build())
+				return
+			}
+
+			// Body must identify the same client
+			// https://tools.ietf.org/html/rfc7592#section-2.2
+			if reqw.ClientID != clientID {
+				withError(w, r, http.StatusBadRequest, rfcerrors.InvalidRequest().Build())
+				return
+			}
+
+			// Create request
+			meta, err := toClientMeta(&reqw)
+			if err != nil {
+				log.Printf("unable to prepare meta: %v", err)
+				withError(w, r, http.StatusBadRequest, rfcerrors.InvalidClientMetadata().Build())
+				return
+			}
+
+			// Delegate message to reactor
+			res, err := as.Do(r.Context(), &corev1.ClientUpdateRequest{
+				ClientId:                clientID,
+				RegistrationAccessToken: token,
+				Metadata:                meta,
+			})
+			updateRes, ok := res.(*corev1.ClientUpdateResponse)
+			if !ok {
+				withJSON(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+				return
+			}
+			if err != nil {
+				log.Printf("unable to process client update request: %v", err)
+				withConfigurationError(w, r, updateRes.Error)
+				return
+			}
+
+			// Send json reponse
+			withJSON(w, r, http.StatusOK, toClientResponse(updateRes.Client, "", updateRes.RegistrationClientUri))
+
+		case http.MethodDelete:
+			// Delegate message to reactor
+			res, err := as.Do(r.Context(), &corev1.ClientDeleteRequest{
+				ClientId:                clientID,
+				RegistrationAccessToken: token,
+			})
+			deleteRes, ok := res.(*corev1.ClientDeleteResponse)
+			if !ok {
+				withJSON(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+				return
+			}
+			if err != nil {
+				log.Printf("unable to process client delete request: %v", err)
+				withConfigurationError(w, r, deleteRes.Error)
+				return
+			}
+
+			// No content
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			withError(w, r, http.StatusMethodNotAllowed, rfcerrors.InvalidRequest().Build())
+		}
+	})
+}
@@ -0,0 +1,78 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/authorizationserver"
+)
+
+var clientStatuses = map[string]corev1.ClientStatus{
+	"active":    corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+	"suspended": corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+	"disabled":  corev1.ClientStatus_CLIENT_STATUS_DISABLED,
+}
+
+// ClientStatus handles client approval administration requests.
+func ClientStatus(as authorizationserver.AuthorizationServer, prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only POST verb
+		if r.Method != http.MethodPost {
+			withError(w, r, http.StatusMethodNotAllowed, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
+		// Extract client_id from path
+		clientID := strings.TrimPrefix(r.URL.Path, prefix)
+		if clientID == "" || strings.Contains(clientID, "/") {
+			withError(w, r, http.StatusNotFound, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
+		// Resolve target status
+		status, ok := clientStatuses[r.FormValue("status")]
+		if !ok {
+			withError(w, r, http.StatusBadRequest, rfcerrors.InvalidRequest().Build())
+			return
+		}
+
+		// Delegate message to reactor
+		res, err := as.Do(r.Context(), &corev1.ClientStatusRequest{
+			ClientId: clientID,
+			Status:   status,
+		})
+		statusRes, ok := res.(*corev1.ClientStatusResponse)
+		if !ok {
+			withJSON(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
+		if err != nil {
+			log.Printf("unable to process client status request: %v", err)
+			withError(w, r, http.StatusBadRequest, statusRes.Error)
+			return
+		}
+
+		// No content
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -28,10 +28,12 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/imdario/mergo"
 	"github.com/square/go-jose/v3"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/locale"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/authorizationserver"
 )
@@ -40,94 +42,6 @@ import (
 func DCR(as authorizationserver.AuthorizationServer) http.Handler {
 	const bodyLimiterSize = 5 << 20 // 5 Mb
 
-	type request struct {
-		ApplicationType         string              `json:"application_type,omitempty"`
-		RedirectURIs            []string            `json:"redirect_uris,omitempty"`
-		TokenEndpointAuthMethod string              `json:"token_endpoint_auth_method,omitempty"`
-		GrantTypes              []string            `json:"grant_types,omitempty"`
-		ResponseTypes           []string            `json:"response_types,omitempty"`
-		ClientName              string              `json:"client_name,omitempty"`
-		ClientURI               string              `json:"client_uri,omitempty"`
-		LogoURI                 string              `json:"logo_uri,omitempty"`
-		Scope                   string              `json:"scope,omitempty"`
-		Contacts                []string            `json:"contacts,omitempty"`
-		TosURI                  string              `json:"tos_uri,omitempty"`
-		PolicyURI               string              `json:"policy_uri,omitempty"`
-		JwksURI                 string              `json:"jwks_uri,omitempty"`
-		JWKS                    *jose.JSONWebKeySet `json:"jwks,omitempty"`
-		SoftwareID              string              `json:"software_id,omitempty"`
-		SoftwareVersion         string              `json:"software_version,omitempty"`
-		SoftwareStatement       string              `json:"software_statement,omitempty"`
-	}
-
-	toClientMeta := func(r *request) (*corev1.ClientMeta, error) {
-		// Copy array
-		meta := &corev1.ClientMeta{
-			Contacts:      r.Contacts,
-			GrantTypes:    r.GrantTypes,
-			RedirectUris:  r.RedirectURIs,
-			ResponseTypes: r.ResponseTypes,
-		}
-
-		// Process optional fields
-		if r.ApplicationType != "" {
-			meta.ApplicationType = &wrapperspb.StringValue{Value: r.ApplicationType}
-		}
-		if r.ClientName != "" {
-			meta.ClientName = &wrapperspb.StringValue{Value: r.ClientName}
-		}
-		if r.ClientURI != "" {
-			meta.ClientUri = &wrapperspb.StringValue{Value: r.ClientURI}
-		}
-		if r.JwksURI != "" {
-			meta.JwkUri = &wrapperspb.StringValue{Value: r.JwksURI}
-		}
-		if r.LogoURI != "" {
-			meta.LogoUri = &wrapperspb.StringValue{Value: r.LogoURI}
-		}
-		if r.PolicyURI != "" {
-			meta.PolicyUri = &wrapperspb.StringValue{Value: r.PolicyURI}
-		}
-		if r.Scope != "" {
-			meta.Scope = &wrapperspb.StringValue{Value: r.Scope}
-		}
-		if r.SoftwareID != "" {
-			meta.SoftwareId = &wrapperspb.StringValue{Value: r.SoftwareID}
-		}
-		if r.SoftwareVersion != "" {
-			meta.SoftwareVersion = &wrapperspb.StringValue{Value: r.SoftwareVersion}
-		}
-		if r.TokenEndpointAuthMethod != "" {
-			meta.TokenEndpointAuthMethod = &wrapperspb.StringValue{Value: r.TokenEndpointAuthMethod}
-		}
-		if r.TosURI != "" {
-			meta.TosUri = &wrapperspb.StringValue{Value: r.TosURI}
-		}
-
-		// JWKS
-		if r.JWKS != nil {
-			var buf bytes.Buffer
-			if err := json.NewEncoder(&buf).Encode(r.JWKS); err != nil {
-				return nil, fmt.Errorf("unable to encode JWK: %w", err)
-			}
-
-			// Set JWKS
-			meta.Jwks = &wrappers.BytesValue{Value: buf.Bytes()}
-		}
-
-		// Merge with default values
-		if err := mergo.Merge(meta, corev1.ClientMeta{
-			GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-			ResponseTypes: []string{oidc.ResponseTypeCode},
-			Scope:         &wrapperspb.StringValue{Value: "openid"},
-		}); err != nil {
-			return nil, fmt.Errorf("unable to merge with default values: %w", err)
-		}
-
-		// No error
-		return meta, nil
-	}
-
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Only POST verb
 		if r.Method != http.MethodPost {
@@ -136,9 +50,9 @@ func DCR(as authorizationserver.AuthorizationServer) http.Handler {
 		}
 
 		// Decode body
-		var reqw request
+		var reqw clientMetadataRequest
 		if err := json.NewDecoder(io.LimitReader(r.Body, bodyLimiterSize)).Decode(&reqw); err != nil {
-			log.Printf("unable to decode json request: %w", err)
+			log.Printf("unable to decode json request: %v", err)
 			withError(w, r, http.StatusBadRequest, rfcerrors.InvalidRequest().Build())
 			return
 		}
@@ -146,27 +60,224 @@ func DCR(as authorizationserver.AuthorizationServer) http.Handler {
 		// Create request
 		meta, err := toClientMeta(&reqw)
 		if err != nil {
-			log.Printf("unable to prepare meta: %w", err)
+			log.Printf("unable to prepare meta: %v", err)
 			withError(w, r, http.StatusBadRequest, rfcerrors.InvalidClientMetadata().Build())
 			return
 		}
 
-		// Delegate message to reactor
-		res, err := as.Do(r.Context(), &corev1.ClientRegistrationRequest{
+		// Prepare registration request
+		req := &corev1.ClientRegistrationRequest{
 			Metadata: meta,
-		})
+		}
+
+		// Extract initial access token
+		if r.Header.Get("Authorization") != "" {
+			token, err := bearerToken(r)
+			if err != nil {
+				withError(w, r, http.StatusUnauthorized, rfcerrors.InvalidToken().Build())
+				return
+			}
+			req.InitialAccessToken = &wrapperspb.StringValue{Value: token}
+		}
+
+		// Delegate message to reactor
+		res, err := as.Do(r.Context(), req)
 		dcrRes, ok := res.(*corev1.ClientRegistrationResponse)
 		if !ok {
 			withJSON(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
 			return
 		}
 		if err != nil {
-			log.Printf("unable to process registration request: %w", err)
+			log.Printf("unable to process registration request: %v", err)
+			if dcrRes.Error != nil && dcrRes.Error.Err == "invalid_token" {
+				withError(w, r, http.StatusUnauthorized, dcrRes.Error)
+				return
+			}
 			withError(w, r, http.StatusBadRequest, dcrRes.Error)
 			return
 		}
 
 		// Send json reponse
-		withJSON(w, r, http.StatusCreated, dcrRes.Client)
+		withJSON(w, r, http.StatusCreated, toClientResponse(dcrRes.Client, dcrRes.RegistrationAccessToken, dcrRes.RegistrationClientUri))
 	})
 }
+
+// -----------------------------------------------------------------------------
+
+type clientMetadataRequest struct {
+	ClientID                          string              `json:"client_id,omitempty"`
+	ApplicationType                   string              `json:"application_type,omitempty"`
+	RedirectURIs                      []string            `json:"redirect_uris,omitempty"`
+	TokenEndpointAuthMethod           string              `json:"token_endpoint_auth_method,omitempty"`
+	GrantTypes                        []string            `json:"grant_types,omitempty"`
+	ResponseTypes                     []string            `json:"response_types,omitempty"`
+	ResponseModes                     []string            `json:"response_modes,omitempty"`
+	ClientName                        string              `json:"client_name,omitempty"`
+	ClientURI                         string              `json:"client_uri,omitempty"`
+	LogoURI                           string              `json:"logo_uri,omitempty"`
+	Scope                             string              `json:"scope,omitempty"`
+	Contacts                          []string            `json:"contacts,omitempty"`
+	TosURI                            string              `json:"tos_uri,omitempty"`
+	PolicyURI                         string              `json:"policy_uri,omitempty"`
+	JwksURI                           string              `json:"jwks_uri,omitempty"`
+	JWKS                              *jose.JSONWebKeySet `json:"jwks,omitempty"`
+	SoftwareID                        string              `json:"software_id,omitempty"`
+	SoftwareVersion                   string              `json:"software_version,omitempty"`
+	SoftwareStatement                 string              `json:"software_statement,omitempty"`
+	TLSBoundAccessTokens              *bool               `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	DPoPBoundAccessTokens             *bool               `json:"dpop_bound_access_tokens,omitempty"`
+	AuthorizationEncryptedResponseAlg string              `json:"authorization_encrypted_response_alg,omitempty"`
+	AuthorizationEncryptedResponseEnc string              `json:"authorization_encrypted_response_enc,omitempty"`
+	AuthorizationSignedResponseAlg    string              `json:"authorization_signed_response_alg,omitempty"`
+	TokenEndpointAuthSigningAlg       string              `json:"token_endpoint_auth_signing_alg,omitempty"`
+	RequestObjectSigningAlg           string              `json:"request_object_signing_alg,omitempty"`
+
+	// Localized values indexed by language tag
+	ClientNameI18N map[string]string `json:"-"`
+	LogoURII18N    map[string]string `json:"-"`
+	TosURII18N     map[string]string `json:"-"`
+	PolicyURII18N  map[string]string `json:"-"`
+}
+
+// UnmarshalJSON decodes client metadata and collects localized members
+// expressed as `<name>#<language tag>`.
+// https://openid.net/specs/openid-connect-registration-1_0.html#LanguagesAndScripts
+func (r *clientMetadataRequest) UnmarshalJSON(data []byte) error {
+	type alias clientMetadataRequest
+	if err := json.Unmarshal(data, (*alias)(r)); err != nil {
+		return err
+	}
+
+	// Decode localized members
+	members, err := locale.Members(data, "client_name", "logo_uri", "tos_uri", "policy_uri")
+	if err != nil {
+		return err
+	}
+	r.ClientNameI18N = members["client_name"]
+	r.LogoURII18N = members["logo_uri"]
+	r.TosURII18N = members["tos_uri"]
+	r.PolicyURII18N = members["policy_uri"]
+
+	// No error
+	return nil
+}
+
+func toClientMeta(r *clientMetadataRequest) (*corev1.ClientMeta, error) {
+	// Copy array
+	meta := &corev1.ClientMeta{
+		Contacts:      r.Contacts,
+		GrantTypes:    r.GrantTypes,
+		RedirectUris:  r.RedirectURIs,
+		ResponseTypes: r.ResponseTypes,
+		ResponseModes: r.ResponseModes,
+
+		ClientNameI18N: r.ClientNameI18N,
+		LogoUriI18N:    r.LogoURII18N,
+		TosUriI18N:     r.TosURII18N,
+		PolicyUriI18N:  r.PolicyURII18N,
+	}
+
+	// Process optional fields
+	if r.ApplicationType != "" {
+		meta.ApplicationType = &wrapperspb.StringValue{Value: r.ApplicationType}
+	}
+	if r.ClientName != "" {
+		meta.ClientName = &wrapperspb.StringValue{Value: r.ClientName}
+	}
+	if r.ClientURI != "" {
+		meta.ClientUri = &wrapperspb.StringValue{Value: r.ClientURI}
+	}
+	if r.JwksURI != "" {
+		meta.JwkUri = &wrapperspb.StringValue{Value: r.JwksURI}
+	}
+	if r.LogoURI != "" {
+		meta.LogoUri = &wrapperspb.StringValue{Value: r.LogoURI}
+	}
+	if r.PolicyURI != "" {
+		meta.PolicyUri = &wrapperspb.StringValue{Value: r.PolicyURI}
+	}
+	if r.Scope != "" {
+		meta.Scope = &wrapperspb.StringValue{Value: r.Scope}
+	}
+	if r.SoftwareID != "" {
+		meta.SoftwareId = &wrapperspb.StringValue{Value: r.SoftwareID}
+	}
+	if r.SoftwareVersion != "" {
+		meta.SoftwareVersion = &wrapperspb.StringValue{Value: r.SoftwareVersion}
+	}
+	if r.SoftwareStatement != "" {
+		meta.SoftwareStatement = &wrapperspb.StringValue{Value: r.SoftwareStatement}
+	}
+	if r.TokenEndpointAuthMethod != "" {
+		meta.TokenEndpointAuthMethod = &wrapperspb.StringValue{Value: r.TokenEndpointAuthMethod}
+	}
+	if r.TosURI != "" {
+		meta.TosUri = &wrapperspb.StringValue{Value: r.TosURI}
+	}
+	if r.TLSBoundAccessTokens != nil {
+		meta.TlsClientCertificateBoundAccessTokens = &wrapperspb.BoolValue{Value: *r.TLSBoundAccessTokens}
+	}
+	if r.DPoPBoundAccessTokens != nil {
+		meta.DpopBoundAccessTokens = &wrapperspb.BoolValue{Value: *r.DPoPBoundAccessTokens}
+	}
+	if r.AuthorizationEncryptedResponseAlg != "" {
+		meta.AuthorizationEncryptedResponseAlg = &wrapperspb.StringValue{Value: r.AuthorizationEncryptedResponseAlg}
+	}
+	if r.AuthorizationEncryptedResponseEnc != "" {
+		meta.AuthorizationEncryptedResponseEnc = &wrapperspb.StringValue{Value: r.AuthorizationEncryptedResponseEnc}
+	}
+	if r.AuthorizationSignedResponseAlg != "" {
+		meta.AuthorizationSignedResponseAlg = &wrapperspb.StringValue{Value: r.AuthorizationSignedResponseAlg}
+	}
+	if r.TokenEndpointAuthSigningAlg != "" {
+		meta.TokenEndpointAuthSigningAlg = &wrapperspb.StringValue{Value: r.TokenEndpointAuthSigningAlg}
+	}
+	if r.RequestObjectSigningAlg != "" {
+		meta.RequestObjectSigningAlg = &wrapperspb.StringValue{Value: r.RequestObjectSigningAlg}
+	}
+
+	// JWKS
+	if r.JWKS != nil {
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(r.JWKS); err != nil {
+			return nil, fmt.Errorf("unable to encode JWK: %w", err)
+		}
+
+		// Set JWKS
+		meta.Jwks = &wrappers.BytesValue{Value: buf.Bytes()}
+	}
+
+	// Merge with default values
+	if err := mergo.Merge(meta, corev1.ClientMeta{
+		GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+		ResponseTypes: []string{oidc.ResponseTypeCode},
+		Scope:         &wrapperspb.StringValue{Value: "openid"},
+	}); err != nil {
+		return nil, fmt.Errorf("unable to merge with default values: %w", err)
+	}
+
+	// No error
+	return meta, nil
+}
+
+// https://tools.ietf.org/html/rfc7592#section-3
+type clientResponse struct {
+	*corev1.Client
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string `json:"registration_client_uri,omitempty"`
+}
+
+func toClientResponse(c *corev1.Client, token, uri string) *clientResponse {
+	// Don't expose server internal attributes
+	c = proto.Clone(c).(*corev1.Client)
+	c.RegistrationAccessTokenHash = nil
+	c.AllowedApplicationTypes = nil
+	c.AllowedGrantTypes = nil
+	c.SoftwareStatementMetadata = nil
+
+	return &clientResponse{
+		Client:                  c,
+		RegistrationAccessToken: token,
+		RegistrationClientURI:   uri,
+	}
+}
@@ -18,6 +18,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -25,12 +26,13 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/dpop"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/authorizationserver"
 )
 
 // DeviceAuthorization handles device authorization HTTP requests.
-func DeviceAuthorization(as authorizationserver.AuthorizationServer) http.Handler {
+func DeviceAuthorization(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifier, dpopNonces dpop.NonceProvider) http.Handler {
 	type response struct {
 		DeviceCode      string `json:"device_code"`
 		UserCode        string `json:"user_code"`
@@ -53,6 +55,7 @@ func DeviceAuthorization(as authorizationserver.AuthorizationServer) http.Handle
 			clientIDRaw = q.Get("client_id")
 			scopeRaw    = q.Get("scope")
 			audienceRaw = q.Get("audience")
+			dpopProof   = r.Header.Get("DPoP")
 		)
 
 		// Prepare request
@@ -70,6 +73,25 @@ func DeviceAuthorization(as authorizationserver.AuthorizationServer) http.Handle
 			}
 		}
 
+		if dpopProof != "" {
+			// Check dpop proof
+			jkt, err := dpopVerifier.Verify(ctx, r.Method, dpop.CleanURL(r), dpopProof)
+			if err != nil {
+				log.Println("unable to validate dpop proof:", err)
+				if errors.Is(err, dpop.ErrUseNonce) {
+					withDPoPNonce(w, r, dpopNonces)
+					return
+				}
+				withError(w, r, http.StatusBadRequest, rfcerrors.InvalidDPoPProof().Build())
+				return
+			}
+
+			// Bind device code to the proof key
+			req.Confirmation = &corev1.TokenConfirmation{
+				Jkt: jkt,
+			}
+		}
+
 		// Send to reactor
 		res, err := as.Do(ctx, req)
 		authRes, ok := res.(*corev1.DeviceAuthorizationResponse)
@@ -18,42 +18,25 @@
 package handlers
 
 import (
-	"fmt"
+	"log"
 	"net/http"
 
-	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
-	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/authorizationserver"
 )
 
 // Metadata handle OIDC Discovery HTTP requests.
 func Metadata(as authorizationserver.AuthorizationServer) http.Handler {
-	issuer := as.Issuer().String()
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		withJSON(w, r, http.StatusOK, &discoveryv1.ServerMetadata{
-			Issuer:                            issuer,
-			JwksUri:                           fmt.Sprintf("%s/.well-known/jwks.json", issuer),
-			SubjectTypesSupported:             []string{"pairwise"},
-			AuthorizationEndpoint:             fmt.Sprintf("%s/authorize", issuer),
-			ResponseTypesSupported:            []string{"code"},
-			ResponseModesSupported:            []string{"query.jwt"},
-			GrantTypesSupported:               []string{oidc.GrantTypeClientCredentials, oidc.GrantTypeAuthorizationCode, oidc.GrantTypeDeviceCode, oidc.GrantTypeRefreshToken},
-			TokenEndpoint:                     fmt.Sprintf("%s/token", issuer),
-			TokenEndpointAuthMethodsSupported: []string{"tls_client_auth", "private_key_jwt"},
-			TokenEndpointAuthSigningAlgValuesSupported:             []string{"ES384"},
-			CodeChallengeMethodsSupported:                          []string{"S256"},
-			PushedAuthorizationRequestEndpoint:                     fmt.Sprintf("%s/par", issuer),
-			PushedAuthorizationRequestEndpointAuthMethodsSupported: []string{"tls_client_auth", "private_key_jwt"},
-			IntrospectionEndpoint:                                  fmt.Sprintf("%s/token/introspect", issuer),
-			IntrospectionEndpointAuthMethodsSupported:              []string{"tls_client_auth", "private_key_jwt"},
-			IntrospectionEndpointAuthSigningAlgValuesSupported:     []string{"ES384"},
-			RevocationEndpoint:                                     fmt.Sprintf("%s/token/revoke", issuer),
-			RevocationEndpointAuthMethodsSupported:                 []string{"tls_client_auth", "private_key_jwt"},
-			RevocationEndpointAuthSigningAlgValuesSupported:        []string{"ES384"},
-			DpopSigningAlgValuesSupported:                          []string{"ES256"},
-			TlsClientCertificateBoundAccessTokens:                  true,
-			DeviceAuthorizationEndpoint:                            fmt.Sprintf("%s/device_authorization", issuer),
-			AuthorizationResponseIssParameterSupported:             true,
-		})
+		// Build server metadata
+		meta, err := as.Metadata(r.Context())
+		if err != nil {
+			log.Println("unable to build server metadata:", err)
+			withError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
+
+		// Send json response
+		withJSON(w, r, http.StatusOK, meta)
 	})
 }
@@ -20,10 +20,14 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	jsoniter "github.com/json-iterator/go"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
 )
 
 func withError(w http.ResponseWriter, r *http.Request, code int, err *corev1.Error) {
@@ -59,3 +63,38 @@ func withJSON(w http.ResponseWriter, r *http.Request, code int, data interface{}
 	// Write response
 	w.Write(body)
 }
+
+// Request a new DPoP proof using the current server-issued nonce
+func withDPoPNonce(w http.ResponseWriter, r *http.Request, nonces dpop.NonceProvider) {
+	// Retrieve current nonce
+	nonce, err := nonces.Nonce(r.Context())
+	if err != nil {
+		withError(w, r, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+		return
+	}
+
+	// Send nonce to client
+	w.Header().Set(dpop.NonceHeader, nonce)
+	withError(w, r, http.StatusBadRequest, rfcerrors.UseDPoPNonce().Build())
+}
+
+// Accepted request object signature algorithms for the given client
+func requestObjectAlgorithms(client *corev1.Client) []string {
+	if client.RequestObjectSigningAlg != "" {
+		return []string{client.RequestObjectSigningAlg}
+	}
+
+	return jwk.SupportedSignatureAlgorithms
+}
+
+// bearerToken extracts the token from the Authorization header.
+func bearerToken(r *http.Request) (string, error) {
+	const prefix = "Bearer "
+
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", fmt.Errorf("authorization header must use bearer scheme")
+	}
+
+	return auth[len(prefix):], nil
+}
@@ -20,6 +20,7 @@ package handlers
 import (
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 
@@ -31,16 +32,28 @@ import (
 
 // TokenIntrospection handles token introspection HTTP requests.
 func TokenIntrospection(as authorizationserver.AuthorizationServer) http.Handler {
+	type confirmation struct {
+		JKT     string `json:"jkt,omitempty"`
+		X5TS256 string `json:"x5t#S256,omitempty"`
+	}
 	type response struct {
-		Active bool `json:"active"`
+		Active       bool          `json:"active"`
+		Issuer       string        `json:"iss,omitempty"`
+		Subject      string        `json:"sub,omitempty"`
+		Audience     []string      `json:"aud,omitempty"`
+		ExpiresAt    uint64        `json:"exp,omitempty"`
+		IssuedAt     uint64        `json:"iat,omitempty"`
+		ClientID     string        `json:"client_id,omitempty"`
+		Scope        string        `json:"scope,omitempty"`
+		JTI          string        `json:"jti,omitempty"`
+		Confirmation *confirmation `json:"cnf,omitempty"`
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var (
-			q             = r.URL.Query()
 			ctx           = r.Context()
-			token         = q.Get("token")
-			tokenTypeHint = q.Get("token_type_hint")
+			token         = r.FormValue("token")
+			tokenTypeHint = r.FormValue("token_type_hint")
 		)
 
 		// Retrieve client front context
@@ -74,9 +87,32 @@ func TokenIntrospection(as authorizationserver.AuthorizationServer) http.Handler
 			return
 		}
 
-		// Send json reponse
-		withJSON(w, r, http.StatusOK, &response{
+		// Prepare response
+		jsonResponse := &response{
 			Active: introRes.Token.Status == corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
-		})
+		}
+
+		// Token attributes are only disclosed for active tokens
+		// https://tools.ietf.org/html/rfc7662#section-2.2
+		if jsonResponse.Active && introRes.Token.Metadata != nil {
+			meta := introRes.Token.Metadata
+			jsonResponse.Issuer = meta.Issuer
+			jsonResponse.Subject = meta.Subject
+			jsonResponse.Audience = strings.Fields(meta.Audience)
+			jsonResponse.ExpiresAt = meta.ExpiresAt
+			jsonResponse.IssuedAt = meta.IssuedAt
+			jsonResponse.ClientID = meta.ClientId
+			jsonResponse.Scope = meta.Scope
+			jsonResponse.JTI = introRes.Token.TokenId
+		}
+		if jsonResponse.Active && introRes.Token.Confirmation != nil {
+			jsonResponse.Confirmation = &confirmation{
+				JKT:     introRes.Token.Confirmation.Jkt,
+				X5TS256: introRes.Token.Confirmation.X5TS256,
+			}
+		}
+
+		// Send json reponse
+		withJSON(w, r, http.StatusOK, jsonResponse)
 	})
 }
@@ -0,0 +1,160 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/examples/server/middleware"
+	"zntr.io/solid/pkg/resourceserver"
+	"zntr.io/solid/pkg/server/authorizationserver"
+	"zntr.io/solid/pkg/server/clientauthentication"
+)
+
+type introspectionServer struct {
+	authorizationserver.AuthorizationServer
+	token *corev1.Token
+}
+
+func (s *introspectionServer) Do(_ context.Context, req interface{}) (interface{}, error) {
+	msg, ok := req.(*corev1.TokenIntrospectionRequest)
+	if !ok || msg.Token != s.token.Value {
+		return &corev1.TokenIntrospectionResponse{
+			Token: &corev1.Token{Status: corev1.TokenStatus_TOKEN_STATUS_INVALID},
+		}, nil
+	}
+
+	return &corev1.TokenIntrospectionResponse{Token: s.token}, nil
+}
+
+func TestTokenIntrospection_ResourceServer(t *testing.T) {
+	now := uint64(time.Now().Unix())
+
+	tests := []struct {
+		name    string
+		token   *corev1.Token
+		wantErr bool
+	}{
+		{
+			name: "inactive",
+			token: &corev1.Token{
+				Value:  "2YotnFZFEjr1zCsicMWpAA",
+				Status: corev1.TokenStatus_TOKEN_STATUS_REVOKED,
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			token: &corev1.Token{
+				TokenId: "123456789",
+				Value:   "2YotnFZFEjr1zCsicMWpAA",
+				Status:  corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+				Metadata: &corev1.TokenMeta{
+					Issuer:    "http://127.0.0.1:8080",
+					Subject:   "user-1",
+					Audience:  "https://api.example.com",
+					IssuedAt:  now - 120,
+					ExpiresAt: now - 60,
+					ClientId:  "6779ef20e75817b79602",
+					Scope:     "openid",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "other audience",
+			token: &corev1.Token{
+				TokenId: "123456789",
+				Value:   "2YotnFZFEjr1zCsicMWpAA",
+				Status:  corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+				Metadata: &corev1.TokenMeta{
+					Issuer:    "http://127.0.0.1:8080",
+					Subject:   "user-1",
+					Audience:  "https://other.example.com",
+					IssuedAt:  now,
+					ExpiresAt: now + 60,
+					ClientId:  "6779ef20e75817b79602",
+					Scope:     "openid",
+				},
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			token: &corev1.Token{
+				TokenId: "123456789",
+				Value:   "2YotnFZFEjr1zCsicMWpAA",
+				Status:  corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+				Metadata: &corev1.TokenMeta{
+					Issuer:    "http://127.0.0.1:8080",
+					Subject:   "user-1",
+					Audience:  "https://api.example.com",
+					IssuedAt:  now,
+					ExpiresAt: now + 60,
+					ClientId:  "6779ef20e75817b79602",
+					Scope:     "openid",
+				},
+				Confirmation: &corev1.TokenConfirmation{
+					Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Resource server authentication
+			clientAuth := clientauthentication.AuthenticationProcessorFunc(func(_ context.Context, req *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error) {
+				if req.ClientAssertion.GetValue() != "fake-assertion" {
+					return &corev1.ClientAuthenticationResponse{}, errors.New("invalid assertion")
+				}
+				return &corev1.ClientAuthenticationResponse{Client: &corev1.Client{ClientId: "resource-server"}}, nil
+			})
+
+			srv := httptest.NewServer(middleware.Adapt(TokenIntrospection(&introspectionServer{token: tt.token}), middleware.ClientAuthentication(clientAuth)))
+			defer srv.Close()
+
+			underTest := resourceserver.RemoteIntrospection(srv.URL, "http://127.0.0.1:8080", "https://api.example.com",
+				resourceserver.HTTPClient(srv.Client()),
+				resourceserver.ClientAssertion(func(_ context.Context) (string, error) { return "fake-assertion", nil }),
+			)
+
+			got, err := underTest.Validate(context.Background(), "2YotnFZFEjr1zCsicMWpAA")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.TokenId != tt.token.TokenId || got.Confirmation.GetJkt() != tt.token.Confirmation.Jkt {
+				t.Errorf("Validate() = %v", got)
+			}
+			if want, gotMeta := fmt.Sprint(tt.token.Metadata), fmt.Sprint(got.Metadata); gotMeta != want {
+				t.Errorf("Validate() metadata = %v, want %v", gotMeta, want)
+			}
+		})
+	}
+}
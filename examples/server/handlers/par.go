@@ -18,25 +18,24 @@
 package handlers
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"log"
 	"net/http"
 
-	"github.com/square/go-jose/v3"
-
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/jwe"
 	"zntr.io/solid/pkg/sdk/jwsreq"
 	"zntr.io/solid/pkg/sdk/jwt"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/authorizationserver"
 	"zntr.io/solid/pkg/server/clientauthentication"
+	"zntr.io/solid/pkg/server/clientkeys"
 )
 
 // PushedAuthorizationRequest handles PAR HTTP requests.
-func PushedAuthorizationRequest(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifier) http.Handler {
+func PushedAuthorizationRequest(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifier, dpopNonces dpop.NonceProvider, clientKeys clientkeys.Resolver, requestDecrypter jwe.Decrypter) http.Handler {
 	type response struct {
 		Issuer     string `json:"iss"`
 		RequestURI string `json:"request_uri"`
@@ -70,20 +69,16 @@ func PushedAuthorizationRequest(as authorizationserver.AuthorizationServer, dpop
 		jkt, err := dpopVerifier.Verify(ctx, r.Method, dpop.CleanURL(r), dpopProof)
 		if err != nil {
 			log.Println("unable to validate dpop proof:", err)
+			if errors.Is(err, dpop.ErrUseNonce) {
+				withDPoPNonce(w, r, dpopNonces)
+				return
+			}
 			withError(w, r, http.StatusBadRequest, rfcerrors.InvalidDPoPProof().Build())
 			return
 		}
 
 		// Prepare client request decoder
-		clientRequestDecoder := jwsreq.JWTAuthorizationDecoder(jwt.DefaultVerifier(func(ctx context.Context) (*jose.JSONWebKeySet, error) {
-			var jwks jose.JSONWebKeySet
-			if err := json.Unmarshal(client.Jwks, &jwks); err != nil {
-				return nil, fmt.Errorf("unable to decode client JWKS")
-			}
-
-			// No error
-			return &jwks, nil
-		}, []string{"ES384"}))
+		clientRequestDecoder := jwsreq.JWEAuthorizationDecoder(requestDecrypter, jwsreq.JWTAuthorizationDecoder(jwt.DefaultVerifier(clientkeys.KeySetProvider(clientKeys, client), requestObjectAlgorithms(client))))
 
 		// Decode request
 		ar, err := clientRequestDecoder.Decode(ctx, requestRaw)
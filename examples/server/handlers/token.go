@@ -18,6 +18,7 @@
 package handlers
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	"time"
@@ -25,13 +26,15 @@ import (
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/mtls"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/authorizationserver"
 	"zntr.io/solid/pkg/server/clientauthentication"
+	"zntr.io/solid/pkg/server/profile"
 )
 
 // Token handles token HTTP requests.
-func Token(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifier) http.Handler {
+func Token(as authorizationserver.AuthorizationServer, serverProfile profile.Server, dpopVerifier dpop.Verifier, dpopNonces dpop.NonceProvider) http.Handler {
 	type response struct {
 		AccessToken  string `json:"access_token"`
 		ExpiresIn    uint64 `json:"expires_in"`
@@ -86,6 +89,20 @@ func Token(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifie
 		return msg
 	}
 
+	certificateBound := func(client *corev1.Client, cnf *corev1.TokenConfirmation) bool {
+		// Binding requested by client metadata
+		if client.TlsClientCertificateBoundAccessTokens {
+			return true
+		}
+
+		// Binding required by server profile and not satisfied by DPoP
+		if clientSettings, ok := serverProfile.ApplicationType(client.ApplicationType); ok && clientSettings.SenderConstrainedAccessTokensRequired() {
+			return cnf == nil || cnf.Jkt == ""
+		}
+
+		return false
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var (
 			ctx       = r.Context()
@@ -107,6 +124,10 @@ func Token(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifie
 			jkt, err := dpopVerifier.Verify(ctx, r.Method, dpop.CleanURL(r), dpopProof)
 			if err != nil {
 				log.Println("unable to validate dpop proof:", err)
+				if errors.Is(err, dpop.ErrUseNonce) {
+					withDPoPNonce(w, r, dpopNonces)
+					return
+				}
 				withError(w, r, http.StatusBadRequest, rfcerrors.InvalidDPoPProof().Build())
 				return
 			}
@@ -117,6 +138,22 @@ func Token(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifie
 			}
 		}
 
+		// Bind token to client certificate
+		if cert, err := mtls.ClientCertificate(r); err == nil && certificateBound(client, msg.TokenConfirmation) {
+			x5t, err := mtls.Thumbprint(cert)
+			if err != nil {
+				log.Println("unable to compute client certificate thumbprint:", err)
+				withError(w, r, http.StatusBadRequest, rfcerrors.InvalidRequest().Build())
+				return
+			}
+
+			// Add confirmation
+			if msg.TokenConfirmation == nil {
+				msg.TokenConfirmation = &corev1.TokenConfirmation{}
+			}
+			msg.TokenConfirmation.X5TS256 = x5t
+		}
+
 		// Send request to reactor
 		res, err := as.Do(r.Context(), msg)
 		tokenRes, ok := res.(*corev1.TokenResponse)
@@ -130,9 +167,9 @@ func Token(as authorizationserver.AuthorizationServer, dpopVerifier dpop.Verifie
 			return
 		}
 
-		// Change token type according to DPoP usage.
+		// Change token type according to DPoP binding.
 		tokenType := "Bearer"
-		if dpopProof != "" {
+		if cnf := tokenRes.AccessToken.Confirmation; cnf != nil && cnf.Jkt != "" {
 			tokenType = "DPoP"
 		}
 
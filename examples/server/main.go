@@ -20,12 +20,15 @@ package main
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"flag"
+	"io/ioutil"
 	"log"
 	"net/http"
 
 	"github.com/square/go-jose/v3"
 
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/examples/server/handlers"
 	"zntr.io/solid/examples/server/middleware"
 	"zntr.io/solid/examples/storage/inmemory"
@@ -33,43 +36,55 @@ import (
 	"zntr.io/solid/pkg/sdk/generator"
 	jwtgen "zntr.io/solid/pkg/sdk/generator/jwt"
 	"zntr.io/solid/pkg/sdk/jarm"
+	"zntr.io/solid/pkg/sdk/jwe"
 	"zntr.io/solid/pkg/sdk/jwk"
-	"zntr.io/solid/pkg/sdk/jwsreq"
 	"zntr.io/solid/pkg/sdk/jwt"
 	"zntr.io/solid/pkg/server/authorizationserver"
+	features "zntr.io/solid/pkg/server/authorizationserver/features/oidc"
+	"zntr.io/solid/pkg/server/clientauthentication"
+	"zntr.io/solid/pkg/server/clientkeys"
+	"zntr.io/solid/pkg/server/keymanager"
+	"zntr.io/solid/pkg/server/profile"
 )
 
-var jwkPrivateKey = []byte(`{
+var jwkEncryptionKey = []byte(`{
 		"kty": "EC",
-		"d": "-3yrGLfHTjuvcpG8gZzwQoz9P6uWgBW6HTmYTb-f6u4HxK05PpTdheKBdQ1nXkV-",
-		"use": "sig",
-		"crv": "P-384",
-		"kid": "123456789",
-		"x": "De4LLFSUCTAAU8O7_ew0VkNR03_kTH9SNCFuhbpi8D1JUbhABRLpNygSDLf2waQt",
-		"y": "cEXPFElY6-qb-5xsFu875_58D3lKZlcOzD99ulje6CAh4D_rJjYU7quxf82xCAUZ",
-		"alg": "ES384"
+		"d": "fRGK_6VIAQGFkOq3_uCAg3SpkltAUIRTD2JLicSoimU",
+		"use": "enc",
+		"crv": "P-256",
+		"kid": "987654321",
+		"x": "ucIzp15CCkjW8s5U-E6RuiaV8ISRXUIhfiPeC097cGk",
+		"y": "RVfbWRDCkCZ9TP2ITV0qfHwP3Ary1OJZ5VjSAtmHo_0",
+		"alg": "ECDH-ES+A256KW"
 	}`)
 
-func keyProvider() jwk.KeyProviderFunc {
-	var privateKey jose.JSONWebKey
+func keySetProvider(keys keymanager.Manager) jwk.KeySetProviderFunc {
+	var encryptionKey jose.JSONWebKey
 
 	// Decode JWK
-	err := json.Unmarshal(jwkPrivateKey, &privateKey)
-	if err != nil {
+	if err := json.Unmarshal(jwkEncryptionKey, &encryptionKey); err != nil {
 		panic(err)
 	}
 
-	return func(_ context.Context) (*jose.JSONWebKey, error) {
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		// Retrieve published signing keys
+		jwks, err := keys.PublicKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		// No error
-		return &privateKey, nil
+		return &jose.JSONWebKeySet{
+			Keys: append(jwks.Keys, encryptionKey.Public()),
+		}, nil
 	}
 }
 
-func keySetProvider() jwk.KeySetProviderFunc {
-	var privateKey jose.JSONWebKey
+func decryptionKeySetProvider() jwk.KeySetProviderFunc {
+	var encryptionKey jose.JSONWebKey
 
 	// Decode JWK
-	err := json.Unmarshal(jwkPrivateKey, &privateKey)
+	err := json.Unmarshal(jwkEncryptionKey, &encryptionKey)
 	if err != nil {
 		panic(err)
 	}
@@ -78,38 +93,81 @@ func keySetProvider() jwk.KeySetProviderFunc {
 		// No error
 		return &jose.JSONWebKeySet{
 			Keys: []jose.JSONWebKey{
-				privateKey.Public(),
+				encryptionKey,
 			},
 		}, nil
 	}
 }
 
-func jarmEncoder(keyProvider jwk.KeyProviderFunc) (jarm.ResponseEncoder, error) {
-	// Retrieve private key
-	pk, err := keyProvider(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve a signing key")
-	}
+func metadataSigner(keyProvider jwk.KeyProviderFunc) jwt.Signer {
+	return jwt.ProviderSigner("", keyProvider, (&jose.SignerOptions{}).WithType("JWT"))
+}
 
+func jarmEncoder(keyProvider jwk.KeyProviderFunc) func(alg string) jarm.ResponseEncoder {
 	// Signer options
 	options := (&jose.SignerOptions{}).WithType(jarm.HeaderType)
-	jarmSigner := jwt.DefaultSigner(jose.SigningKey{
-		Algorithm: jose.ES384,
-		Key:       pk,
-	}, options)
 
-	// Prover
-	return jarm.JWTEncoder(jarmSigner), nil
+	return func(alg string) jarm.ResponseEncoder {
+		// Blank algorithm uses the default signing key
+		jarmSigner := jwt.ProviderSigner(jose.SignatureAlgorithm(alg), keyProvider, options)
+
+		// Prover
+		return jarm.JWTEncoder(jarmSigner)
+	}
 }
 
+var initialAccessTokenFile = flag.String("initial-access-token-file", "", "Write an initial access token for client registration to the given file")
+
 func main() {
+	flag.Parse()
+
 	ctx := context.Background()
+	issuer := "http://127.0.0.1:8080"
+
+	// Prepare signing key manager
+	keys, err := keymanager.DefaultManager(inmemory.Keys(), keymanager.Algorithms(jwk.SupportedSignatureAlgorithms...))
+	if err != nil {
+		panic(err)
+	}
+
+	// Prepare client storage
+	clients := inmemory.Clients()
+
+	// Prepare client key resolver
+	clientKeys := clientkeys.DefaultResolver(jwk.DefaultFetcher())
+
+	// Server profile enforced on registrations and requests
+	serverProfile := profile.Strict()
+
+	// Prepare client authentication processor
+	privateKeyJWT := clientauthentication.PrivateKeyJWT(clients, serverProfile, inmemory.ClientAssertions(), []string{
+		issuer,
+		issuer + features.PushedAuthorizationRequestEndpoint,
+		issuer + features.DeviceAuthorizationEndpoint,
+		issuer + features.TokenEndpoint,
+		issuer + features.IntrospectionEndpoint,
+		issuer + features.RevocationEndpoint,
+	}, clientauthentication.KeyResolver(clientKeys))
+
+	// Prepare client authentication dispatcher
+	clientAuthentication := clientauthentication.DefaultDispatcher(clients, serverProfile)
+	clientAuthentication.Register(oidc.AuthMethodPrivateKeyJWT, privateKeyJWT)
+	clientAuthentication.Register(oidc.AuthMethodNone, clientauthentication.None(clients, serverProfile))
+
+	// Initialize dpop verifier
+	dpopNonces := dpop.DefaultNonceProvider()
+	dpopVerifier, err := dpop.DefaultVerifier(inmemory.DPoPProofs(), jwt.DefaultVerifier(keySetProvider(keys), jwk.SupportedSignatureAlgorithms), dpop.Nonces(dpopNonces))
+	if err != nil {
+		panic(err)
+	}
 
 	// Prepare the authorization server
 	as, err := authorizationserver.New(ctx,
-		"http://127.0.0.1:8080", // Issuer
+		issuer,
 		// Client storage
-		authorizationserver.ClientReader(inmemory.Clients()),
+		authorizationserver.ClientManager(clients),
+		// Server profile
+		authorizationserver.ServerProfile(serverProfile),
 		// Authorization requests
 		authorizationserver.AuthorizationRequestManager(inmemory.AuthorizationRequests()),
 		// Authorization code storage
@@ -117,46 +175,63 @@ func main() {
 		// Token storage
 		authorizationserver.TokenManager(inmemory.Tokens()),
 		// Access token generator
-		authorizationserver.AccessTokenGenerator(jwtgen.AccessToken(jose.ES384, keyProvider())),
+		authorizationserver.AccessTokenGenerator(jwtgen.AccessToken(jose.ES256, keymanager.KeyProvider(keys))),
 		// Device authorization session storage
 		authorizationserver.DeviceCodeSessionManager(inmemory.DeviceCodeSessions(generator.DefaultDeviceUserCode())),
+		// Advertised capabilities
+		authorizationserver.ClientAuthentication(clientAuthentication),
+		authorizationserver.KeySetProvider(keySetProvider(keys)),
+		authorizationserver.DPoPVerifier(dpopVerifier),
+		authorizationserver.MetadataSigner(metadataSigner(keymanager.KeyProvider(keys))),
+		// Client registration
+		authorizationserver.InitialAccessTokenManager(inmemory.InitialAccessTokens()),
 	)
 	if err != nil {
 		panic(err)
 	}
 
+	// Issue an initial access token for client registration
+	if *initialAccessTokenFile != "" {
+		iatRes, err := as.Do(ctx, &corev1.InitialAccessTokenRequest{
+			ExpiresIn:  3600,
+			UsageLimit: 10,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		// Token is a credential, keep it readable by the owner only
+		if err := ioutil.WriteFile(*initialAccessTokenFile, []byte(iatRes.(*corev1.InitialAccessTokenResponse).Token.Value), 0o600); err != nil {
+			panic(err)
+		}
+		log.Printf("Initial access token written to %s", *initialAccessTokenFile)
+	}
+
 	// Create client authentication middleware
-	clientAuth := middleware.ClientAuthentication(inmemory.Clients())
+	clientAuth := middleware.ClientAuthentication(clientAuthentication)
 	secHeaders := middleware.SecurityHaders()
 	basicAuth := middleware.BasicAuthentication()
 
-	// Initialize dpop verifier
-	dpopVerifier, err := dpop.DefaultVerifier(inmemory.DPoPProofs(), jwt.DefaultVerifier(keySetProvider(), []string{"ES384"}))
-	if err != nil {
-		panic(err)
-	}
-
-	// JWSREQ Decoder
-	requestDecoder := jwsreq.JWTAuthorizationDecoder(jwt.DefaultVerifier(keySetProvider(), []string{string(jose.ES384)}))
-
 	// Initialize JARM encoder
-	jarmEncoder, err := jarmEncoder(keyProvider())
-	if err != nil {
-		panic(err)
-	}
+	jarmEncoder := jarmEncoder(keymanager.KeyProvider(keys))
+
+	// Initialize request object decrypter
+	requestDecrypter := jwe.DefaultDecrypter(decryptionKeySetProvider(), jwe.DefaultKeyAlgorithms, jwe.DefaultContentEncryptions)
 
 	// Create router
 	http.Handle("/.well-known/oauth-authorization-server", handlers.Metadata(as))
 	http.Handle("/.well-known/openid-configuration", handlers.Metadata(as))
-	http.Handle("/.well-known/jwks.json", handlers.JWKS(as, keySetProvider()))
-	http.Handle("/par", middleware.Adapt(handlers.PushedAuthorizationRequest(as, dpopVerifier), clientAuth))
-	http.Handle("/authorize", middleware.Adapt(handlers.Authorization(as, inmemory.Clients(), requestDecoder, jarmEncoder), secHeaders, basicAuth))
-	http.Handle("/device_authorization", middleware.Adapt(handlers.DeviceAuthorization(as), clientAuth))
-	http.Handle("/token", middleware.Adapt(handlers.Token(as, dpopVerifier), clientAuth))
-	http.Handle("/token/introspect", middleware.Adapt(handlers.TokenIntrospection(as), clientAuth))
-	http.Handle("/token/revoke", middleware.Adapt(handlers.TokenRevocation(as), clientAuth))
+	http.Handle(features.JWKSEndpoint, handlers.JWKS(as, keySetProvider(keys)))
+	http.Handle(features.PushedAuthorizationRequestEndpoint, middleware.Adapt(handlers.PushedAuthorizationRequest(as, dpopVerifier, dpopNonces, clientKeys, requestDecrypter), clientAuth))
+	http.Handle(features.AuthorizationEndpoint, middleware.Adapt(handlers.Authorization(as, clients, clientKeys, requestDecrypter, jarmEncoder), secHeaders, basicAuth))
+	http.Handle(features.DeviceAuthorizationEndpoint, middleware.Adapt(handlers.DeviceAuthorization(as, dpopVerifier, dpopNonces), clientAuth))
+	http.Handle(features.TokenEndpoint, middleware.Adapt(handlers.Token(as, serverProfile, dpopVerifier, dpopNonces), clientAuth))
+	http.Handle(features.IntrospectionEndpoint, middleware.Adapt(handlers.TokenIntrospection(as), clientAuth))
+	http.Handle(features.RevocationEndpoint, middleware.Adapt(handlers.TokenRevocation(as), clientAuth))
 	http.Handle("/device", middleware.Adapt(handlers.Device(as), secHeaders, basicAuth))
-	http.Handle("/register", handlers.DCR(as))
+	http.Handle(features.RegistrationEndpoint, handlers.DCR(as))
+	http.Handle(features.RegistrationEndpoint+"/", handlers.ClientConfiguration(as, features.RegistrationEndpoint+"/"))
+	http.Handle("/admin/clients/", middleware.Adapt(handlers.ClientStatus(as, "/admin/clients/"), basicAuth))
 
 	log.Fatal(http.ListenAndServe(":8080", nil))
 }
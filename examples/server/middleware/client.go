@@ -27,75 +27,65 @@ import (
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/clientauthentication"
-	"zntr.io/solid/pkg/server/storage"
 )
 
 // ClientAuthentication is a middleware to handle client authentication.
-func ClientAuthentication(clients storage.ClientReader) Adapter {
-	// Prepare client authentication
-	clientAuth := clientauthentication.PrivateKeyJWT(clients)
-
+func ClientAuthentication(clientAuth clientauthentication.AuthenticationProcessor) Adapter {
 	// Return middleware
 	return func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			var (
-				ctx         = r.Context()
-				q           = r.URL.Query()
-				clientIDRaw = q.Get("client_id")
+				ctx = r.Context()
+				msg = &corev1.ClientAuthenticationRequest{}
 			)
 
-			// client_id
-			if clientIDRaw != "" {
-				// Retrieve client details
-				client, err := clients.Get(ctx, clientIDRaw)
-				if err != nil {
-					log.Printf("unable to retrieve client '%s': %v", clientIDRaw, err)
-					json.NewEncoder(w).Encode(rfcerrors.InvalidClient().Build())
+			// Client parameters are read from query and form body
+			if err := r.ParseForm(); err != nil {
+				log.Println("unable to parse request form:", err)
+				json.NewEncoder(w).Encode(rfcerrors.InvalidRequest().Build())
+				return
+			}
+			q := r.Form
+
+			// Client credentials using HTTP Basic scheme
+			if clientID, clientSecret, ok := r.BasicAuth(); ok {
+				// Only one authentication method is allowed
+				if q.Get("client_secret") != "" {
+					log.Println("unable to authenticate client: multiple client secrets")
+					json.NewEncoder(w).Encode(rfcerrors.InvalidRequest().Build())
 					return
 				}
 
-				// Process authentication
-				if client.ClientType == corev1.ClientType_CLIENT_TYPE_CONFIDENTIAL {
-					resAuth, err := clientAuth.Authenticate(ctx, &corev1.ClientAuthenticationRequest{
-						ClientAssertionType: &wrappers.StringValue{
-							Value: q.Get("client_assertion_type"),
-						},
-						ClientAssertion: &wrappers.StringValue{
-							Value: q.Get("client_assertion"),
-						},
-					})
-					if err != nil {
-						log.Println("unable to authenticate client:", err)
-						json.NewEncoder(w).Encode(resAuth.GetError())
-						return
-					}
+				msg.BasicAuthentication = true
+				msg.ClientId = &wrappers.StringValue{Value: clientID}
+				msg.ClientSecret = &wrappers.StringValue{Value: clientSecret}
+			}
 
-					// Assign client to context
-					ctx = clientauthentication.Inject(ctx, resAuth.Client)
-				}
-				if client.ClientType == corev1.ClientType_CLIENT_TYPE_PUBLIC {
-					// Assign client to context
-					ctx = clientauthentication.Inject(ctx, client)
-				}
-			} else {
-				resAuth, err := clientAuth.Authenticate(ctx, &corev1.ClientAuthenticationRequest{
-					ClientAssertionType: &wrappers.StringValue{
-						Value: q.Get("client_assertion_type"),
-					},
-					ClientAssertion: &wrappers.StringValue{
-						Value: q.Get("client_assertion"),
-					},
-				})
-				if err != nil {
-					log.Println("unable to authenticate client:", err)
-					json.NewEncoder(w).Encode(resAuth.GetError())
-					return
-				}
+			// Client parameters
+			if clientID := q.Get("client_id"); clientID != "" && msg.ClientId == nil {
+				msg.ClientId = &wrappers.StringValue{Value: clientID}
+			}
+			if clientSecret := q.Get("client_secret"); clientSecret != "" {
+				msg.ClientSecret = &wrappers.StringValue{Value: clientSecret}
+			}
+			if assertionType := q.Get("client_assertion_type"); assertionType != "" {
+				msg.ClientAssertionType = &wrappers.StringValue{Value: assertionType}
+			}
+			if assertion := q.Get("client_assertion"); assertion != "" {
+				msg.ClientAssertion = &wrappers.StringValue{Value: assertion}
+			}
 
-				// Assign client to context
-				ctx = clientauthentication.Inject(ctx, resAuth.Client)
+			// Process authentication
+			resAuth, err := clientAuth.Authenticate(ctx, msg)
+			if err != nil {
+				log.Println("unable to authenticate client:", err)
+				json.NewEncoder(w).Encode(resAuth.GetError())
+				return
 			}
 
+			// Assign client to context
+			ctx = clientauthentication.Inject(ctx, resAuth.Client)
+
 			// Delegate to next handler
 			h.ServeHTTP(w, r.WithContext(ctx))
 		})
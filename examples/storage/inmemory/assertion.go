@@ -0,0 +1,52 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"zntr.io/solid/pkg/server/storage"
+)
+
+type assertionCache struct {
+	backend *cache.Cache
+}
+
+// ClientAssertions returns a client assertion cache.
+func ClientAssertions() storage.ClientAssertion {
+	// Initialize in-memory caches
+	backendCache := cache.New(1*time.Minute, 10*time.Minute)
+
+	return &assertionCache{
+		backend: backendCache,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *assertionCache) Register(ctx context.Context, id string, expiresIn time.Duration) error {
+	// Insert in cache only if absent
+	if err := s.backend.Add(id, id, expiresIn); err != nil {
+		return storage.ErrAlreadyExists
+	}
+	// No error
+	return nil
+}
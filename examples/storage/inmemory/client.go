@@ -39,13 +39,13 @@ func Clients() storage.Client {
 			"6779ef20e75817b79602": {
 				ClientId:        "6779ef20e75817b79602",
 				ClientType:      corev1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+				Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				ApplicationType: "web",
 				ClientName:      "foo-test-client",
+				// Client authentication method
+				TokenEndpointAuthMethod: oidc.AuthMethodPrivateKeyJWT,
 				GrantTypes: []string{
 					oidc.GrantTypeAuthorizationCode, // User interaction
-					oidc.GrantTypeClientCredentials, // Machine-to-machine
-					oidc.GrantTypeDeviceCode,        // Device-to-service
-					oidc.GrantTypeRefreshToken,      // Act as user
 				},
 				ResponseTypes: []string{
 					"code",
@@ -66,28 +66,22 @@ func Clients() storage.Client {
 							"x": "m2NDaWfRRGlCkUa4FK949uLtMqitX1lYgi8UCIMtsuR60ux3d00XBlsC6j_YDOTe",
 							"y": "6vxuUq3V1aoWi4FQ_h9ZNwUsmcGP8Uuqq_YN5dhP0U8lchdmZJbLF9mPiimo_6p4",
 							"alg": "ES384"
+						},
+						{
+							"kty": "EC",
+							"kid": "client-enc",
+							"use": "enc",
+							"crv": "P-256",
+							"x": "E3BxhdU521YzUdyIWFacHR9zilN-bBMviBwGLJpw4xA",
+							"y": "GX8t2xQ3wzaiTvqYJOLzEfiJgFqRkGFNvhsZEhWSQM4",
+							"alg": "ECDH-ES+A256KW"
 						}
 					]
 				}`),
-				// Pairwise sector identitier
-				SubjectType:      oidc.SubjectTypePairwise,
-				SectorIdentifier: "http://127.0.0.1:8085",
-			},
-			"public-client": {
-				ClientId:        "public-client",
-				ClientType:      corev1.ClientType_CLIENT_TYPE_PUBLIC,
-				ApplicationType: "cli",
-				ClientName:      "cli-public-client",
-				GrantTypes: []string{
-					oidc.GrantTypeDeviceCode,   // Device-to-service
-					oidc.GrantTypeRefreshToken, // Act as user
-				},
-				Contacts: []string{
-					"foo@bar.com",
-				},
-				// Pairwise sector identitier
-				SubjectType:      oidc.SubjectTypePairwise,
-				SectorIdentifier: "http://127.0.0.1:8085",
+				// Encrypted JARM responses
+				AuthorizationEncryptedResponseAlg: "ECDH-ES+A256KW",
+				AuthorizationEncryptedResponseEnc: "A256GCM",
+				SubjectType:                       oidc.SubjectTypePublic,
 			},
 		},
 	}
@@ -130,3 +124,28 @@ func (s *clientStorage) Register(ctx context.Context, c *corev1.Client) (string,
 	// No error
 	return c.ClientId, nil
 }
+
+func (s *clientStorage) Update(ctx context.Context, c *corev1.Client) error {
+	// Check is client exists
+	if _, ok := s.backend[c.ClientId]; !ok {
+		return storage.ErrNotFound
+	}
+
+	// Assign to storage
+	s.backend[c.ClientId] = c
+
+	// No error
+	return nil
+}
+
+func (s *clientStorage) Delete(ctx context.Context, id string) error {
+	// Check is client exists
+	if _, ok := s.backend[id]; !ok {
+		return storage.ErrNotFound
+	}
+
+	delete(s.backend, id)
+
+	// No error
+	return nil
+}
@@ -0,0 +1,152 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+type initialAccessTokenStorage struct {
+	sync.RWMutex
+	idIndex    map[string]*corev1.InitialAccessToken
+	hashIndex  map[string]string
+	valueIndex map[string]string
+}
+
+// InitialAccessTokens returns an initial access token storage.
+//
+// Token values are only kept as SHA-256 hashes, a storage leak doesn't give
+// registration rights.
+func InitialAccessTokens() storage.InitialAccessToken {
+	return &initialAccessTokenStorage{
+		idIndex:    map[string]*corev1.InitialAccessToken{},
+		hashIndex:  map[string]string{},
+		valueIndex: map[string]string{},
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *initialAccessTokenStorage) GetByValue(ctx context.Context, value string) (*corev1.InitialAccessToken, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	// Check if token exists
+	id, ok := s.valueIndex[initialAccessTokenHash(value)]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	// No error
+	return proto.Clone(s.idIndex[id]).(*corev1.InitialAccessToken), nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *initialAccessTokenStorage) Create(ctx context.Context, t *corev1.InitialAccessToken) error {
+	// Check parameters
+	if t == nil {
+		return fmt.Errorf("unable to store nil token")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Keep only the value hash
+	hash := initialAccessTokenHash(t.Value)
+	stored := proto.Clone(t).(*corev1.InitialAccessToken)
+	stored.Value = ""
+
+	// Assign to storage
+	s.idIndex[t.TokenId] = stored
+	s.hashIndex[t.TokenId] = hash
+	s.valueIndex[hash] = t.TokenId
+
+	// No error
+	return nil
+}
+
+func (s *initialAccessTokenStorage) Use(ctx context.Context, id string) (uint64, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Check if token exists
+	t, ok := s.idIndex[id]
+	if !ok {
+		return 0, storage.ErrNotFound
+	}
+
+	// Increment usage
+	t.UsageCount++
+
+	// No error
+	return t.UsageCount, nil
+}
+
+func (s *initialAccessTokenStorage) Release(ctx context.Context, id string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// Check if token exists
+	t, ok := s.idIndex[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+
+	// Decrement usage
+	if t.UsageCount > 0 {
+		t.UsageCount--
+	}
+
+	// No error
+	return nil
+}
+
+func (s *initialAccessTokenStorage) Delete(ctx context.Context, id string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// Check if token exists
+	t, ok := s.idIndex[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+
+	delete(s.valueIndex, s.hashIndex[t.TokenId])
+	delete(s.hashIndex, t.TokenId)
+	delete(s.idIndex, t.TokenId)
+
+	// No error
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+
+func initialAccessTokenHash(value string) string {
+	h := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(h[:])
+}
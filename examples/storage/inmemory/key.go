@@ -0,0 +1,95 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+type keyStorage struct {
+	sync.RWMutex
+	backend map[string]*corev1.Key
+}
+
+// Keys returns a signing key storage.
+func Keys() storage.Key {
+	return &keyStorage{
+		backend: map[string]*corev1.Key{},
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *keyStorage) All(ctx context.Context) ([]*corev1.Key, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	// Copy stored keys
+	res := make([]*corev1.Key, 0, len(s.backend))
+	for _, k := range s.backend {
+		res = append(res, proto.Clone(k).(*corev1.Key))
+	}
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *keyStorage) Register(ctx context.Context, k *corev1.Key) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// Assign to storage
+	s.backend[k.Kid] = proto.Clone(k).(*corev1.Key)
+
+	// No error
+	return nil
+}
+
+func (s *keyStorage) Update(ctx context.Context, k *corev1.Key) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// Check existence
+	if _, ok := s.backend[k.Kid]; !ok {
+		return storage.ErrNotFound
+	}
+
+	// Assign to storage
+	s.backend[k.Kid] = proto.Clone(k).(*corev1.Key)
+
+	// No error
+	return nil
+}
+
+func (s *keyStorage) Delete(ctx context.Context, kid string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.backend, kid)
+
+	// No error
+	return nil
+}
@@ -114,3 +114,16 @@ func (s *tokenStorage) Revoke(ctx context.Context, id string) error {
 	// No error
 	return nil
 }
+
+func (s *tokenStorage) RevokeByClient(ctx context.Context, clientID string) error {
+	s.mutex.Lock()
+	for _, t := range s.idIndex {
+		if t.Metadata.GetClientId() == clientID {
+			t.Status = corev1.TokenStatus_TOKEN_STATUS_REVOKED
+		}
+	}
+	s.mutex.Unlock()
+
+	// No error
+	return nil
+}
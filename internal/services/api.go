@@ -53,4 +53,14 @@ type Device interface {
 type Client interface {
 	// Register process client registration request.
 	Register(ctx context.Context, req *corev1.ClientRegistrationRequest) (*corev1.ClientRegistrationResponse, error)
+	// IssueInitialAccessToken creates a token authorizing client registrations.
+	IssueInitialAccessToken(ctx context.Context, req *corev1.InitialAccessTokenRequest) (*corev1.InitialAccessTokenResponse, error)
+	// Read returns the client registration.
+	Read(ctx context.Context, req *corev1.ClientReadRequest) (*corev1.ClientReadResponse, error)
+	// Update replaces the client registration metadata.
+	Update(ctx context.Context, req *corev1.ClientUpdateRequest) (*corev1.ClientUpdateResponse, error)
+	// Delete removes the client registration.
+	Delete(ctx context.Context, req *corev1.ClientDeleteRequest) (*corev1.ClientDeleteResponse, error)
+	// UpdateStatus changes the client lifecycle status.
+	UpdateStatus(ctx context.Context, req *corev1.ClientStatusRequest) (*corev1.ClientStatusResponse, error)
 }
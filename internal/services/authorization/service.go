@@ -23,12 +23,17 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/internal/services"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/responsemode"
 	"zntr.io/solid/pkg/server/storage"
 )
 
@@ -38,20 +43,25 @@ const (
 	desiredMinNonceValueLength         = 8
 	desiredMinStateValueLength         = 32
 	desiredMinCodeChallengeValueLength = 43
+	desiredDPoPJKTValueLength          = 43
 )
 
+var timeFunc = time.Now
+
 type service struct {
 	clients                   storage.ClientReader
 	authorizationRequests     storage.AuthorizationRequest
 	authorizationCodeSessions storage.AuthorizationCodeSessionWriter
+	serverProfile             profile.Server
 }
 
 // New build and returns an authorization service implementation.
-func New(clients storage.ClientReader, authorizationRequests storage.AuthorizationRequest, authorizationCodeSessions storage.AuthorizationCodeSessionWriter) services.Authorization {
+func New(clients storage.ClientReader, authorizationRequests storage.AuthorizationRequest, authorizationCodeSessions storage.AuthorizationCodeSessionWriter, serverProfile profile.Server) services.Authorization {
 	return &service{
 		clients:                   clients,
 		authorizationRequests:     authorizationRequests,
 		authorizationCodeSessions: authorizationCodeSessions,
+		serverProfile:             serverProfile,
 	}
 }
 
@@ -115,6 +125,10 @@ func (s *service) Authorize(ctx context.Context, req *corev1.AuthorizationCodeRe
 
 		// Override request
 		req.AuthorizationRequest = ar
+	} else if s.serverProfile.PushedAuthorizationRequestsRequired() {
+		// https://www.rfc-editor.org/rfc/rfc9126.html#section-5
+		res.Error = rfcerrors.InvalidRequest().Description("authorization request must be pushed.").Build()
+		return res, fmt.Errorf("server profile requires pushed authorization requests")
 	}
 
 	// Delegate to real authorize process
@@ -124,12 +138,24 @@ func (s *service) Authorize(ctx context.Context, req *corev1.AuthorizationCodeRe
 		return res, err
 	}
 
+	// Prepare authorization session
+	lifetime := s.serverProfile.AuthorizationCodeLifetime()
+	session := &corev1.AuthorizationCodeSession{
+		Issuer:    req.Issuer,
+		Subject:   req.Subject,
+		Request:   req.AuthorizationRequest,
+		ExpiresAt: uint64(timeFunc().Add(lifetime).Unix()),
+	}
+
+	// Bind authorization code to the DPoP key
+	if req.AuthorizationRequest.DpopJkt != "" {
+		session.Confirmation = &corev1.TokenConfirmation{
+			Jkt: req.AuthorizationRequest.DpopJkt,
+		}
+	}
+
 	// Create an authorization session
-	code, expiresIn, err := s.authorizationCodeSessions.Register(ctx, &corev1.AuthorizationCodeSession{
-		Issuer:  req.Issuer,
-		Subject: req.Subject,
-		Request: req.AuthorizationRequest,
-	})
+	code, expiresIn, err := s.authorizationCodeSessions.Register(ctx, session)
 	if err != nil {
 		res.Error = rfcerrors.ServerError().State(req.AuthorizationRequest.State).Build()
 		return res, fmt.Errorf("unable to generate authorization code: %w", err)
@@ -145,8 +171,13 @@ func (s *service) Authorize(ctx context.Context, req *corev1.AuthorizationCodeRe
 	res.ClientId = req.AuthorizationRequest.ClientId
 	// Assign expiration
 	res.ExpiresIn = expiresIn
+	if maxExpiresIn := uint64(lifetime / time.Second); res.ExpiresIn > maxExpiresIn {
+		res.ExpiresIn = maxExpiresIn
+	}
 	// Assign issuer
 	res.Issuer = req.Issuer
+	// Assign response mode
+	res.ResponseMode = responseMode(req.AuthorizationRequest)
 
 	return res, err
 }
@@ -191,6 +222,18 @@ func (s *service) Register(ctx context.Context, req *corev1.RegistrationRequest)
 		return res, fmt.Errorf("unable to register request for another client")
 	}
 
+	// Bind the request to the PAR DPoP proof key
+	if req.Confirmation != nil && req.Confirmation.Jkt != "" {
+		// https://datatracker.ietf.org/doc/html/rfc9449#section-10.1
+		if req.AuthorizationRequest.DpopJkt != "" && !types.SecureCompareString(req.AuthorizationRequest.DpopJkt, req.Confirmation.Jkt) {
+			res.Error = rfcerrors.InvalidRequest().State(req.AuthorizationRequest.State).Build()
+			return res, fmt.Errorf("dpop_jkt doesn't match the DPoP proof key")
+		}
+
+		// Assign proof thumbprint
+		req.AuthorizationRequest.DpopJkt = req.Confirmation.Jkt
+	}
+
 	// Register the authorization request
 	requestURI, expiresIn, err := s.authorizationRequests.Register(ctx, req.Issuer, req.AuthorizationRequest)
 	if err != nil {
@@ -240,6 +283,14 @@ func (s *service) validate(ctx context.Context, req *corev1.AuthorizationRequest
 		return rfcerrors.InvalidRequest().State(req.State).Build(), fmt.Errorf("code_challenge too short")
 	}
 
+	if req.DpopJkt != "" && len(req.DpopJkt) != desiredDPoPJKTValueLength {
+		return rfcerrors.InvalidRequest().State(req.State).Build(), fmt.Errorf("dpop_jkt has an invalid length")
+	}
+
+	if req.ResponseMode != nil && !responsemode.IsSupported(req.ResponseMode.Value) {
+		return rfcerrors.InvalidRequest().State(req.State).Build(), fmt.Errorf("invalid or unsupported response_mode '%s'", req.ResponseMode.Value)
+	}
+
 	// Prepare redirection uri
 	_, err := url.ParseRequestURI(req.RedirectUri)
 	if err != nil {
@@ -255,6 +306,17 @@ func (s *service) validate(ctx context.Context, req *corev1.AuthorizationRequest
 
 		return rfcerrors.InvalidRequest().State(req.State).Build(), fmt.Errorf("unable to retrieve client details: %w", err)
 	}
+	if storage.ClientStatus(client) != corev1.ClientStatus_CLIENT_STATUS_ACTIVE {
+		return rfcerrors.UnauthorizedClient().State(req.State).Build(), fmt.Errorf("client '%s' is not active", client.ClientId)
+	}
+
+	// Check client signature algorithms against server profile
+	allowedAlgs := s.serverProfile.SigningAlgorithmsSupported()
+	for _, alg := range []string{client.RequestObjectSigningAlg, client.AuthorizationSignedResponseAlg} {
+		if alg != "" && !allowedAlgs.Contains(alg) {
+			return rfcerrors.UnauthorizedClient().State(req.State).Build(), fmt.Errorf("client '%s' uses '%s' signature algorithm which is not allowed by server profile", client.ClientId, alg)
+		}
+	}
 
 	// Validate client capabilities
 	if !types.StringArray(client.GrantTypes).Contains(oidc.GrantTypeAuthorizationCode) {
@@ -266,11 +328,32 @@ func (s *service) validate(ctx context.Context, req *corev1.AuthorizationRequest
 		return rfcerrors.InvalidRequest().State(req.State).Build(), fmt.Errorf("client doesn't support `%s` as response type", req.ResponseType)
 	}
 
+	// Validate client against its application type profile
+	if err := profile.CheckGrantType(s.serverProfile, client, oidc.GrantTypeAuthorizationCode); err != nil {
+		return rfcerrors.UnauthorizedClient().State(req.State).Build(), fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
+	if err := profile.CheckResponseType(s.serverProfile, client, req.ResponseType); err != nil {
+		return rfcerrors.UnauthorizedClient().State(req.State).Build(), fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
+
 	// Validate client response_types
 	if !types.StringArray(client.RedirectUris).Contains(req.RedirectUri) {
 		return rfcerrors.InvalidRequest().State(req.State).Build(), fmt.Errorf("client doesn't support `%s` as redirect_uri type", req.RedirectUri)
 	}
 
+	// Validate client response_modes
+	if len(client.ResponseModes) > 0 {
+		// Default to the first allowed response mode
+		if req.ResponseMode == nil {
+			req.ResponseMode = &wrapperspb.StringValue{Value: client.ResponseModes[0]}
+		}
+
+		allowedModes := types.StringArray(client.ResponseModes)
+		if !allowedModes.Contains(req.ResponseMode.Value) && !allowedModes.Contains(responseMode(req)) {
+			return rfcerrors.InvalidRequest().State(req.State).Build(), fmt.Errorf("client doesn't support `%s` as response_mode", req.ResponseMode.Value)
+		}
+	}
+
 	// Check scopes
 	scopes := types.StringArray(strings.Fields(req.Scope))
 
@@ -296,3 +379,19 @@ func (s *service) validate(ctx context.Context, req *corev1.AuthorizationRequest
 	// No error
 	return nil, nil
 }
+
+// responseMode returns the response mode to use for the authorization response.
+func responseMode(req *corev1.AuthorizationRequest) string {
+	mode := req.GetResponseMode().GetValue()
+
+	// Resolve JARM shortcut
+	// https://openid.net/specs/oauth-v2-jarm.html#section-2.3.4
+	if mode == oidc.ResponseModeJWT {
+		if req.ResponseType == oidc.ResponseTypeCode {
+			return oidc.ResponseModeQueryJWT
+		}
+		return oidc.ResponseModeFragmentJWT
+	}
+
+	return mode
+}
@@ -31,6 +31,7 @@ import (
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
@@ -386,7 +387,9 @@ func Test_service_validate(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes: []string{"client_credentials"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{"client_credentials"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
@@ -410,13 +413,71 @@ func Test_service_validate(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"id_token"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"id_token"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
 			want:    rfcerrors.InvalidRequest().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
 		},
+		{
+			name: "application_type not supported by server profile",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: "unknown",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want:    rfcerrors.UnauthorizedClient().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
+		{
+			name: "grant_type not allowed for application_type",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: oidc.ApplicationTypeService,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want:    rfcerrors.UnauthorizedClient().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+		},
 		{
 			name: "client invalid redirect_uri",
 			args: args{
@@ -435,9 +496,11 @@ func Test_service_validate(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"http://foo.com"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"http://foo.com"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
@@ -463,9 +526,11 @@ func Test_service_validate(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"com.example.app:/oauth2redirect"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"com.example.app:/oauth2redirect"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: false,
@@ -490,9 +555,11 @@ func Test_service_validate(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: false,
@@ -518,6 +585,7 @@ func Test_service_validate(t *testing.T) {
 				clients:                   clients,
 				authorizationRequests:     authorizationRequests,
 				authorizationCodeSessions: sessions,
+				serverProfile:             profile.Strict(),
 			}
 			got, err := s.validate(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -543,6 +611,7 @@ func Test_service_validate_Fuzz(t *testing.T) {
 		clients:                   clients,
 		authorizationRequests:     authorizationRequests,
 		authorizationCodeSessions: sessions,
+		serverProfile:             profile.Strict(),
 	}
 
 	// Making sure the function never panics
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -31,6 +32,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/generator"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
@@ -48,6 +50,7 @@ func Test_service_Authorize(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
+		profile profile.Server
 		prepare func(*storagemock.MockAuthorizationRequest, *storagemock.MockClientReader, *storagemock.MockAuthorizationCodeSessionWriter)
 		want    *corev1.AuthorizationCodeResponse
 		wantErr bool
@@ -245,9 +248,11 @@ func Test_service_Authorize(t *testing.T) {
 			},
 			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				sessions.EXPECT().Register(gomock.Any(), gomock.Any()).Return("", uint64(0), fmt.Errorf("foo"))
 			},
@@ -279,9 +284,11 @@ func Test_service_Authorize(t *testing.T) {
 			},
 			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				sessions.EXPECT().Register(gomock.Any(), &corev1.AuthorizationCodeSession{
 					Issuer:  "https://honest.as.example",
@@ -298,6 +305,7 @@ func Test_service_Authorize(t *testing.T) {
 						CodeChallengeMethod: "S256",
 						Prompt:              nil,
 					},
+					ExpiresAt: 601,
 				}).Return("1234567891234567890", uint64(60), nil)
 			},
 			wantErr: false,
@@ -336,9 +344,11 @@ func Test_service_Authorize(t *testing.T) {
 			},
 			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				sessions.EXPECT().Register(gomock.Any(), &corev1.AuthorizationCodeSession{
 					Issuer:  "https://honest.as.example",
@@ -357,6 +367,7 @@ func Test_service_Authorize(t *testing.T) {
 							Value: "login",
 						},
 					},
+					ExpiresAt: 601,
 				}).Return("1234567891234567890", uint64(60), nil)
 			},
 			wantErr: false,
@@ -371,6 +382,30 @@ func Test_service_Authorize(t *testing.T) {
 			},
 		},
 
+		{
+			name:    "without request_uri when pushed requests are required",
+			profile: profile.FAPI2(),
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.AuthorizationCodeRequest{
+					Issuer:  "https://honest.as.example",
+					Subject: "foo",
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallengeMethod: "S256",
+					},
+				},
+			},
+			wantErr: true,
+			want: &corev1.AuthorizationCodeResponse{
+				Error: rfcerrors.InvalidRequest().Description("authorization request must be pushed.").Build(),
+			},
+		},
 		{
 			name: "with invalid request",
 			args: args{
@@ -434,9 +469,11 @@ func Test_service_Authorize(t *testing.T) {
 				}, nil)
 				ar.EXPECT().Delete(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				sessions.EXPECT().Register(gomock.Any(), &corev1.AuthorizationCodeSession{
 					Issuer:  "https://honest.as.example",
@@ -453,6 +490,7 @@ func Test_service_Authorize(t *testing.T) {
 						CodeChallengeMethod: "S256",
 						Prompt:              &wrappers.StringValue{Value: "consent"},
 					},
+					ExpiresAt: 601,
 				}).Return("1234567891234567890", uint64(60), nil)
 			},
 			wantErr: false,
@@ -466,6 +504,142 @@ func Test_service_Authorize(t *testing.T) {
 				Issuer:      "https://honest.as.example",
 			},
 		},
+		{
+			name: "with valid request_uri bound to a dpop key",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.AuthorizationCodeRequest{
+					Issuer:  "https://honest.as.example",
+					Subject: "foo",
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						RequestUri: &wrappers.StringValue{
+							Value: "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac",
+						},
+					},
+				},
+			},
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter) {
+				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(&corev1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              &wrappers.StringValue{Value: "consent"},
+					DpopJkt:             "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+				}, nil)
+				ar.EXPECT().Delete(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				sessions.EXPECT().Register(gomock.Any(), &corev1.AuthorizationCodeSession{
+					Issuer:  "https://honest.as.example",
+					Subject: "foo",
+					Request: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+						DpopJkt:             "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+					ExpiresAt: 601,
+				}).Return("1234567891234567890", uint64(60), nil)
+			},
+			wantErr: false,
+			want: &corev1.AuthorizationCodeResponse{
+				Error:       nil,
+				Code:        "1234567891234567890",
+				State:       "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+				RedirectUri: "https://client.example.org/cb",
+				ClientId:    "s6BhdRkqt3",
+				ExpiresIn:   uint64(60),
+				Issuer:      "https://honest.as.example",
+			},
+		},
+		{
+			name: "with valid request_uri and jwt response mode",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.AuthorizationCodeRequest{
+					Issuer:  "https://honest.as.example",
+					Subject: "foo",
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						RequestUri: &wrappers.StringValue{
+							Value: "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac",
+						},
+					},
+				},
+			},
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, sessions *storagemock.MockAuthorizationCodeSessionWriter) {
+				ar.EXPECT().Get(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(&corev1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              &wrappers.StringValue{Value: "consent"},
+					ResponseMode:        &wrappers.StringValue{Value: "jwt"},
+				}, nil)
+				ar.EXPECT().Delete(gomock.Any(), "https://honest.as.example", "urn:solid:Jny1CLd0EZAD0tNnDsmR56gVPhsKk9ac").Return(nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				sessions.EXPECT().Register(gomock.Any(), &corev1.AuthorizationCodeSession{
+					Issuer:  "https://honest.as.example",
+					Subject: "foo",
+					Request: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+						ResponseMode:        &wrappers.StringValue{Value: "jwt"},
+					},
+					ExpiresAt: 601,
+				}).Return("1234567891234567890", uint64(60), nil)
+			},
+			wantErr: false,
+			want: &corev1.AuthorizationCodeResponse{
+				Error:        nil,
+				Code:         "1234567891234567890",
+				State:        "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+				RedirectUri:  "https://client.example.org/cb",
+				ClientId:     "s6BhdRkqt3",
+				ExpiresIn:    uint64(60),
+				Issuer:       "https://honest.as.example",
+				ResponseMode: "query.jwt",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -483,7 +657,12 @@ func Test_service_Authorize(t *testing.T) {
 			}
 
 			// Prepare service
-			underTest := New(clients, authorizationRequests, authorizationCodeSessions)
+			serverProfile := tt.profile
+			if serverProfile == nil {
+				serverProfile = profile.Strict()
+			}
+			timeFunc = func() time.Time { return time.Unix(1, 0) }
+			underTest := New(clients, authorizationRequests, authorizationCodeSessions, serverProfile)
 
 			// Do the request
 			got, err := underTest.Authorize(tt.args.ctx, tt.args.req)
@@ -506,7 +685,7 @@ func Test_service_Authorize_Fuzz(t *testing.T) {
 	authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSessionWriter(ctrl)
 
 	// Prepare service
-	underTest := New(clients, authorizationRequests, authorizationCodeSessions)
+	underTest := New(clients, authorizationRequests, authorizationCodeSessions, profile.Strict())
 
 	// Making sure the function never panics
 	for i := 0; i < 1000; i++ {
@@ -636,8 +815,10 @@ func Test_service_Register(t *testing.T) {
 			},
 			prepare: func(_ *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					ClientId:   "s6BhdRkqt3",
-					GrantTypes: []string{"client_credentials"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{"client_credentials"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
@@ -670,10 +851,12 @@ func Test_service_Register(t *testing.T) {
 			},
 			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					ClientId:      "s6BhdRkqt3",
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
@@ -681,6 +864,106 @@ func Test_service_Register(t *testing.T) {
 				Error: rfcerrors.InvalidRequest().Build(),
 			},
 		},
+		{
+			name: "dpop_jkt mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.RegistrationRequest{
+					Issuer: "https://honest.as.example",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+						DpopJkt:             "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs",
+					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.RegistrationResponse{
+				Error: rfcerrors.InvalidRequest().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+			},
+		},
+		{
+			name: "invalid dpop_jkt",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.RegistrationRequest{
+					Issuer: "https://honest.as.example",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+						DpopJkt:             "foo",
+					},
+				},
+			},
+			wantErr: true,
+			want: &corev1.RegistrationResponse{
+				Error: rfcerrors.InvalidRequest().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+			},
+		},
+		{
+			name: "invalid response_mode",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.RegistrationRequest{
+					Issuer: "https://honest.as.example",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+						ResponseMode:        &wrappers.StringValue{Value: "foo"},
+					},
+				},
+			},
+			wantErr: true,
+			want: &corev1.RegistrationResponse{
+				Error: rfcerrors.InvalidRequest().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+			},
+		},
 		{
 			name: "error while registering the request",
 			args: args{
@@ -718,10 +1001,12 @@ func Test_service_Register(t *testing.T) {
 					Prompt:              &wrappers.StringValue{Value: "consent"},
 				}).Return("", uint64(90), fmt.Errorf("foo"))
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					ClientId:      "s6BhdRkqt3",
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
@@ -729,6 +1014,100 @@ func Test_service_Register(t *testing.T) {
 				Error: rfcerrors.ServerError().Build(),
 			},
 		},
+		{
+			name: "response_mode not allowed for client",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.RegistrationRequest{
+					Issuer: "https://honest.as.example",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+						ResponseMode:        &wrappers.StringValue{Value: "query.jwt"},
+					},
+				},
+			},
+			prepare: func(_ *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					ResponseModes:   []string{"form_post", "form_post.jwt"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.RegistrationResponse{
+				Error: rfcerrors.InvalidRequest().State("oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU").Build(),
+			},
+		},
+		{
+			name: "valid with client default response_mode",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.RegistrationRequest{
+					Issuer: "https://honest.as.example",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+					},
+				},
+			},
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter) {
+				ar.EXPECT().Register(gomock.Any(), "https://honest.as.example", &corev1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              &wrappers.StringValue{Value: "consent"},
+					ResponseMode:        &wrappers.StringValue{Value: "form_post"},
+				}).Return("123-456-789", uint64(90), nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					ResponseModes:   []string{"form_post", "form_post.jwt"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: false,
+			want: &corev1.RegistrationResponse{
+				Issuer:     "https://honest.as.example",
+				RequestUri: "123-456-789",
+				ExpiresIn:  90,
+			},
+		},
 		{
 			name: "valid",
 			args: args{
@@ -766,10 +1145,69 @@ func Test_service_Register(t *testing.T) {
 					Prompt:              &wrappers.StringValue{Value: "consent"},
 				}).Return("123-456-789", uint64(90), nil)
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					ClientId:      "s6BhdRkqt3",
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: false,
+			want: &corev1.RegistrationResponse{
+				Error:      nil,
+				ExpiresIn:  90,
+				RequestUri: "123-456-789",
+				Issuer:     "https://honest.as.example",
+			},
+		},
+		{
+			name: "valid with dpop proof",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.RegistrationRequest{
+					Issuer: "https://honest.as.example",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					AuthorizationRequest: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+						Nonce:               "XDwbBH4MokU8BmrZ",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+						CodeChallengeMethod: "S256",
+						Prompt:              &wrappers.StringValue{Value: "consent"},
+					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+			prepare: func(ar *storagemock.MockAuthorizationRequest, clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationCodeSessionWriter) {
+				ar.EXPECT().Register(gomock.Any(), "https://honest.as.example", &corev1.AuthorizationRequest{
+					Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+					ResponseType:        "code",
+					Scope:               "openid profile email offline_access",
+					ClientId:            "s6BhdRkqt3",
+					State:               "oESIiuoybVxAJ5fAKmxxM6s2CnVic6zU",
+					Nonce:               "XDwbBH4MokU8BmrZ",
+					RedirectUri:         "https://client.example.org/cb",
+					CodeChallenge:       "K2-ltc83acc4h0c9w6ESC_rEMTJ3bww-uCHaoeK1t8U",
+					CodeChallengeMethod: "S256",
+					Prompt:              &wrappers.StringValue{Value: "consent"},
+					DpopJkt:             "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+				}).Return("123-456-789", uint64(90), nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientId:        "s6BhdRkqt3",
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: false,
@@ -797,7 +1235,7 @@ func Test_service_Register(t *testing.T) {
 			}
 
 			// Prepare service
-			underTest := New(clients, authorizationRequests, authorizationCodeSessions)
+			underTest := New(clients, authorizationRequests, authorizationCodeSessions, profile.Strict())
 
 			// Do the request
 			got, err := underTest.Register(tt.args.ctx, tt.args.req)
@@ -820,7 +1258,7 @@ func Test_service_Register_Fuzz(t *testing.T) {
 	authorizationCodeSessions := storagemock.NewMockAuthorizationCodeSessionWriter(ctrl)
 
 	// Prepare service
-	underTest := New(clients, authorizationRequests, authorizationCodeSessions)
+	underTest := New(clients, authorizationRequests, authorizationCodeSessions, profile.Strict())
 
 	// Making sure the function never panics
 	for i := 0; i < 1000; i++ {
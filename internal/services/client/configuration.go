@@ -0,0 +1,206 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/url"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+const registrationAccessTokenLength = 32
+
+// https://tools.ietf.org/html/rfc7592#section-2.1
+func (s *service) Read(ctx context.Context, req *corev1.ClientReadRequest) (*corev1.ClientReadResponse, error) {
+	res := &corev1.ClientReadResponse{}
+
+	// Check req nullity
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Authenticate request
+	c, publicErr, err := s.authenticateConfiguration(ctx, req.ClientId, req.RegistrationAccessToken)
+	if err != nil {
+		res.Error = publicErr
+		return res, err
+	}
+
+	// Assign client
+	res.Client = c
+	res.RegistrationClientUri = s.registrationClientURI(c.ClientId)
+
+	// No error
+	return res, nil
+}
+
+// https://tools.ietf.org/html/rfc7592#section-2.2
+func (s *service) Update(ctx context.Context, req *corev1.ClientUpdateRequest) (*corev1.ClientUpdateResponse, error) {
+	res := &corev1.ClientUpdateResponse{}
+
+	// Check req nullity
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+	if req.Metadata == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil metadata")
+	}
+
+	// Authenticate request
+	current, publicErr, err := s.authenticateConfiguration(ctx, req.ClientId, req.RegistrationAccessToken)
+	if err != nil {
+		res.Error = publicErr
+		return res, err
+	}
+
+	// Validate metadata as a registration
+	c, publicErr, err := s.prepareClient(ctx, &corev1.ClientRegistrationRequest{
+		Metadata: req.Metadata,
+	}, current.SoftwareStatementMetadata)
+	if err != nil {
+		res.Error = publicErr
+		return res, err
+	}
+
+	// Software identity can't be changed by a new statement
+	if current.SoftwareStatementMetadata != nil && c.SoftwareId != current.SoftwareId {
+		res.Error = rfcerrors.InvalidClientMetadata().Description("software_id can't be changed.").Build()
+		return res, fmt.Errorf("software_id '%s' doesn't match registered '%s'", c.SoftwareId, current.SoftwareId)
+	}
+
+	// Check registration restrictions
+	if publicErr, err := checkInitialAccessTokenRestrictions(c.ApplicationType, c.GrantTypes, current.AllowedApplicationTypes, current.AllowedGrantTypes); err != nil {
+		res.Error = publicErr
+		return res, err
+	}
+
+	// Keep server assigned attributes
+	c.ClientId = current.ClientId
+	c.ClientType = current.ClientType
+	c.ClientSecret = current.ClientSecret
+	c.RegistrationAccessTokenHash = current.RegistrationAccessTokenHash
+	c.Status = current.Status
+	c.AllowedApplicationTypes = current.AllowedApplicationTypes
+	c.AllowedGrantTypes = current.AllowedGrantTypes
+
+	// Save client in persistence
+	if err := s.clients.Update(ctx, c); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to update client in persistence: %w", err)
+	}
+
+	// Assign client
+	res.Client = c
+	res.RegistrationClientUri = s.registrationClientURI(c.ClientId)
+
+	// No error
+	return res, nil
+}
+
+// https://tools.ietf.org/html/rfc7592#section-2.3
+func (s *service) Delete(ctx context.Context, req *corev1.ClientDeleteRequest) (*corev1.ClientDeleteResponse, error) {
+	res := &corev1.ClientDeleteResponse{}
+
+	// Check req nullity
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Authenticate request
+	c, publicErr, err := s.authenticateConfiguration(ctx, req.ClientId, req.RegistrationAccessToken)
+	if err != nil {
+		res.Error = publicErr
+		return res, err
+	}
+
+	// Invalidate issued tokens before the client removal, so that a failed
+	// revocation can be retried with the same request.
+	// https://tools.ietf.org/html/rfc7592#section-2.3
+	if err := s.tokens.RevokeByClient(ctx, c.ClientId); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to revoke client tokens: %w", err)
+	}
+
+	// Remove client from persistence
+	if err := s.clients.Delete(ctx, c.ClientId); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to delete client from persistence: %w", err)
+	}
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *service) authenticateConfiguration(ctx context.Context, clientID, token string) (*corev1.Client, *corev1.Error, error) {
+	// Check arguments
+	if clientID == "" {
+		return nil, rfcerrors.InvalidRequest().Build(), fmt.Errorf("client_id must not be empty")
+	}
+	if token == "" {
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("registration access token must not be empty")
+	}
+
+	// Retrieve client
+	c, err := s.clients.Get(ctx, clientID)
+	if err != nil {
+		// Unknown clients are reported as invalid token to prevent enumeration
+		// https://tools.ietf.org/html/rfc7592#section-2.1
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("client '%s' not found", clientID)
+		}
+		return nil, rfcerrors.ServerError().Build(), fmt.Errorf("unable to retrieve client: %w", err)
+	}
+
+	// Disabled clients can't be managed anymore
+	if c.Status == corev1.ClientStatus_CLIENT_STATUS_DISABLED {
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("client '%s' is disabled", clientID)
+	}
+
+	// Check registration access token
+	if len(c.RegistrationAccessTokenHash) == 0 {
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("client '%s' has no registration access token", clientID)
+	}
+	if subtle.ConstantTimeCompare(c.RegistrationAccessTokenHash, registrationAccessTokenHash(token)) != 1 {
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("registration access token mismatch for client '%s'", clientID)
+	}
+
+	// No error
+	return c, nil, nil
+}
+
+func (s *service) registrationClientURI(clientID string) string {
+	return fmt.Sprintf("%s/%s", s.registrationEndpoint, url.PathEscape(clientID))
+}
+
+func registrationAccessTokenHash(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -0,0 +1,611 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/storage"
+	storagemock "zntr.io/solid/pkg/server/storage/mock"
+)
+
+var registeredClient = func() *corev1.Client {
+	return &corev1.Client{
+		ClientId:                    "s6BhdRkqt3",
+		ClientType:                  corev1.ClientType_CLIENT_TYPE_CONFIDENTIAL,
+		ApplicationType:             oidc.ApplicationTypeServerSideWeb,
+		TokenEndpointAuthMethod:     oidc.AuthMethodPrivateKeyJWT,
+		GrantTypes:                  []string{oidc.GrantTypeAuthorizationCode},
+		ResponseTypes:               []string{oidc.ResponseTypeCode},
+		RedirectUris:                []string{"https://client.example.org/callback"},
+		JwksUri:                     "https://client.example.org/jwks.json",
+		SubjectType:                 oidc.SubjectTypePublic,
+		RegistrationAccessTokenHash: registrationAccessTokenHash("reg-23410913-abewfq.123483"),
+		Status:                      corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+	}
+}
+
+func Test_service_Read(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *corev1.ClientReadRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockClient)
+		want    *corev1.ClientReadResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "missing client_id",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "missing token",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					ClientId: "s6BhdRkqt3",
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "client not found",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "client storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "disabled client",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				c := registeredClient()
+				c.Status = corev1.ClientStatus_CLIENT_STATUS_DISABLED
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(c, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "client without registration access token",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{ClientId: "s6BhdRkqt3"}, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "token mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "foo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientReadResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientReadRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+			},
+			wantErr: false,
+			want: &corev1.ClientReadResponse{
+				Client:                registeredClient(),
+				RegistrationClientUri: "https://as.example.org/register/s6BhdRkqt3",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			clients := storagemock.NewMockClient(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(clients)
+			}
+
+			// Prepare service
+			underTest := New("https://as.example.org/register", clients, nil, nil, nil, profile.Strict(), nil, false, false)
+
+			// Do the request
+			got, err := underTest.Read(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.Read() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("service.Read() res =%s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_Update(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *corev1.ClientUpdateRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockClient)
+		want    *corev1.ClientUpdateResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &corev1.ClientUpdateResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "nil metadata",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientUpdateResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "token mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "foo",
+					Metadata:                &corev1.ClientMeta{},
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientUpdateResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "invalid metadata",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+					Metadata: &corev1.ClientMeta{
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: oidc.AuthMethodClientSecretBasic},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientUpdateResponse{
+				Error: rfcerrors.InvalidClientMetadata().Description("token_endpoint_auth_method contains an invalid or unsupported value.").Build(),
+			},
+		},
+		{
+			name: "pairwise subject type",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+					Metadata: &corev1.ClientMeta{
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: oidc.AuthMethodPrivateKeyJWT},
+						RedirectUris:            []string{"https://client.example.org/callback2"},
+						JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						SubjectType:             &wrapperspb.StringValue{Value: oidc.SubjectTypePairwise},
+						SectorIdentifier:        &wrapperspb.StringValue{Value: "https://client.example.org"},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientUpdateResponse{
+				Error: rfcerrors.InvalidClientMetadata().Description("subject_type 'pairwise' is not supported.").Build(),
+			},
+		},
+		{
+			name: "initial access token restriction",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+					Metadata: &corev1.ClientMeta{
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: oidc.AuthMethodPrivateKeyJWT},
+						GrantTypes:              []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris:            []string{"https://client.example.org/callback"},
+						JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				c := registeredClient()
+				c.AllowedGrantTypes = []string{oidc.GrantTypeClientCredentials}
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(c, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientUpdateResponse{
+				Error: rfcerrors.InvalidClientMetadata().Description("grant_types contains a value not allowed by the initial access token.").Build(),
+			},
+		},
+		{
+			name: "client storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+					Metadata: &corev1.ClientMeta{
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: oidc.AuthMethodPrivateKeyJWT},
+						RedirectUris:            []string{"https://client.example.org/callback2"},
+						JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+				clients.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.ClientUpdateResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "software statement metadata",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+					Metadata: &corev1.ClientMeta{
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: oidc.AuthMethodPrivateKeyJWT},
+						RedirectUris:            []string{"https://client.example.org/callback"},
+						JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						ClientName:              &wrapperspb.StringValue{Value: "Spoofed Client"},
+						SoftwareId:              &wrapperspb.StringValue{Value: "spoofed"},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				c := registeredClient()
+				c.ClientName = "Example Statement-based Client"
+				c.SoftwareId = "4NRB1-0XZABZI9E6-5SM3R"
+				c.SoftwareStatementMetadata = &corev1.ClientMeta{
+					ClientName: &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+					SoftwareId: &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+				}
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(c, nil)
+				clients.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.ClientUpdateResponse{
+				Client: func() *corev1.Client {
+					c := registeredClient()
+					c.ClientName = "Example Statement-based Client"
+					c.SoftwareId = "4NRB1-0XZABZI9E6-5SM3R"
+					c.SoftwareStatementMetadata = &corev1.ClientMeta{
+						ClientName: &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+						SoftwareId: &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+					}
+					return c
+				}(),
+				RegistrationClientUri: "https://as.example.org/register/s6BhdRkqt3",
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientUpdateRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+					Metadata: &corev1.ClientMeta{
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: oidc.AuthMethodPrivateKeyJWT},
+						RedirectUris:            []string{"https://client.example.org/callback2"},
+						JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+				clients.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.ClientUpdateResponse{
+				Client: func() *corev1.Client {
+					c := registeredClient()
+					c.RedirectUris = []string{"https://client.example.org/callback2"}
+					return c
+				}(),
+				RegistrationClientUri: "https://as.example.org/register/s6BhdRkqt3",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			clients := storagemock.NewMockClient(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(clients)
+			}
+
+			// Prepare service
+			underTest := New("https://as.example.org/register", clients, nil, nil, nil, profile.Strict(), nil, false, false)
+
+			// Do the request
+			got, err := underTest.Update(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("service.Update() res =%s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_Delete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *corev1.ClientDeleteRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockClient, *storagemock.MockTokenWriter)
+		want    *corev1.ClientDeleteResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &corev1.ClientDeleteResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "token mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientDeleteRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "foo",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientDeleteResponse{
+				Error: rfcerrors.InvalidToken().Build(),
+			},
+		},
+		{
+			name: "token revocation error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientDeleteRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+				tokens.EXPECT().RevokeByClient(gomock.Any(), "s6BhdRkqt3").Return(errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.ClientDeleteResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "client storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientDeleteRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+				tokens.EXPECT().RevokeByClient(gomock.Any(), "s6BhdRkqt3").Return(nil)
+				clients.EXPECT().Delete(gomock.Any(), "s6BhdRkqt3").Return(errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.ClientDeleteResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientDeleteRequest{
+					ClientId:                "s6BhdRkqt3",
+					RegistrationAccessToken: "reg-23410913-abewfq.123483",
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(registeredClient(), nil)
+				gomock.InOrder(
+					tokens.EXPECT().RevokeByClient(gomock.Any(), "s6BhdRkqt3").Return(nil),
+					clients.EXPECT().Delete(gomock.Any(), "s6BhdRkqt3").Return(nil),
+				)
+			},
+			wantErr: false,
+			want:    &corev1.ClientDeleteResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			clients := storagemock.NewMockClient(ctrl)
+			tokens := storagemock.NewMockTokenWriter(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(clients, tokens)
+			}
+
+			// Prepare service
+			underTest := New("https://as.example.org/register", clients, tokens, nil, nil, profile.Strict(), nil, false, false)
+
+			// Do the request
+			got, err := underTest.Delete(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.Delete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("service.Delete() res =%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dchest/uniuri"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+const (
+	// defaultInitialAccessTokenLifetime is the token lifetime used when the
+	// request doesn't specify one.
+	defaultInitialAccessTokenLifetime = 24 * time.Hour
+	tokenIDLength                     = 8
+)
+
+var timeFunc = time.Now
+
+func (s *service) IssueInitialAccessToken(ctx context.Context, req *corev1.InitialAccessTokenRequest) (*corev1.InitialAccessTokenResponse, error) {
+	res := &corev1.InitialAccessTokenResponse{}
+
+	// Check req nullity
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Check storage
+	if types.IsNil(s.initialAccessTokens) {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("initial access token storage is not configured")
+	}
+
+	// Check application types
+	applicationTypes := types.StringArray(req.ApplicationTypes)
+	if len(applicationTypes) == 0 {
+		applicationTypes = s.serverProfile.ApplicationTypes()
+	}
+	grantTypes := types.StringArray{}
+	for _, name := range applicationTypes {
+		clientSettings, ok := s.serverProfile.ApplicationType(name)
+		if !ok {
+			res.Error = rfcerrors.InvalidRequest().Description("application_types contains an invalid or unsupported value.").Build()
+			return res, fmt.Errorf("server could not handle given application_type '%s'", name)
+		}
+		for _, gt := range clientSettings.GrantTypesSupported() {
+			grantTypes.AddIfNotContains(gt)
+		}
+	}
+
+	// Check grant types
+	for _, gt := range req.GrantTypes {
+		if !grantTypes.Contains(gt) {
+			res.Error = rfcerrors.InvalidRequest().Description("grant_types contains an invalid or unsupported value.").Build()
+			return res, fmt.Errorf("a grant_types element is invalid: '%s', supported '%s'", gt, grantTypes)
+		}
+	}
+
+	// Compute expiration
+	now := timeFunc()
+	lifetime := defaultInitialAccessTokenLifetime
+	if req.ExpiresIn > 0 {
+		lifetime = time.Duration(req.ExpiresIn) * time.Second
+	}
+
+	// Create token
+	t := &corev1.InitialAccessToken{
+		TokenId:          uniuri.NewLen(tokenIDLength),
+		IssuedAt:         uint64(now.Unix()),
+		ExpiresAt:        uint64(now.Add(lifetime).Unix()),
+		ApplicationTypes: req.ApplicationTypes,
+		GrantTypes:       req.GrantTypes,
+		UsageLimit:       req.UsageLimit,
+	}
+
+	// Generate token value
+	var err error
+	t.Value, err = s.initialAccessTokenGen.Generate(ctx)
+	if err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to generate initial access token: %w", err)
+	}
+	if t.Value == "" {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("initial access token generator generated an empty value")
+	}
+
+	// Save token in persistence
+	if err := s.initialAccessTokens.Create(ctx, t); err != nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to register initial access token in persistence: %w", err)
+	}
+
+	// Assign token
+	res.Token = t
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *service) authenticateRegistration(ctx context.Context, req *corev1.ClientRegistrationRequest) (*corev1.InitialAccessToken, *corev1.Error, error) {
+	// Check arguments
+	if req == nil {
+		return nil, rfcerrors.InvalidRequest().Build(), fmt.Errorf("unable to process nil request")
+	}
+
+	// Check token presence
+	if req.InitialAccessToken == nil || req.InitialAccessToken.Value == "" {
+		if s.openRegistration {
+			return nil, nil, nil
+		}
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("initial access token is mandatory")
+	}
+	if types.IsNil(s.initialAccessTokens) {
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("initial access tokens are not supported")
+	}
+
+	// Retrieve token
+	t, err := s.initialAccessTokens.GetByValue(ctx, req.InitialAccessToken.Value)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("initial access token not found")
+		}
+		return nil, rfcerrors.ServerError().Build(), fmt.Errorf("unable to retrieve initial access token: %w", err)
+	}
+
+	// Check expiration
+	if t.ExpiresAt > 0 && t.ExpiresAt < uint64(timeFunc().Unix()) {
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("initial access token is expired")
+	}
+
+	// Check usage limit
+	if t.UsageLimit > 0 && t.UsageCount >= t.UsageLimit {
+		return nil, rfcerrors.InvalidToken().Build(), fmt.Errorf("initial access token usage limit reached")
+	}
+
+	// No error
+	return t, nil, nil
+}
+
+func (s *service) consumeInitialAccessToken(ctx context.Context, req *corev1.ClientRegistrationRequest, t *corev1.InitialAccessToken) (*corev1.Error, error) {
+	// Check arguments
+	if req == nil || req.Metadata == nil {
+		return rfcerrors.InvalidRequest().Build(), fmt.Errorf("unable to process nil request")
+	}
+
+	// Open registration
+	if t == nil {
+		return nil, nil
+	}
+
+	// Check token restrictions
+	if publicErr, err := checkInitialAccessTokenRestrictions(req.Metadata.ApplicationType.GetValue(), req.Metadata.GrantTypes, t.ApplicationTypes, t.GrantTypes); err != nil {
+		return publicErr, err
+	}
+
+	// Increment token usage
+	count, err := s.initialAccessTokens.Use(ctx, t.TokenId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return rfcerrors.InvalidToken().Build(), fmt.Errorf("initial access token not found")
+		}
+		return rfcerrors.ServerError().Build(), fmt.Errorf("unable to update initial access token usage: %w", err)
+	}
+
+	// Concurrent registrations could exceed the limit
+	if t.UsageLimit > 0 && count > t.UsageLimit {
+		if err := s.releaseInitialAccessToken(ctx, t); err != nil {
+			return rfcerrors.ServerError().Build(), err
+		}
+		return rfcerrors.InvalidToken().Build(), fmt.Errorf("initial access token usage limit reached")
+	}
+
+	// No error
+	return nil, nil
+}
+
+// checkInitialAccessTokenRestrictions checks the given client attributes
+// against the application and grant types allowed by an initial access token.
+func checkInitialAccessTokenRestrictions(applicationType string, grantTypes, allowedApplicationTypes, allowedGrantTypes []string) (*corev1.Error, error) {
+	if len(allowedApplicationTypes) > 0 && !types.StringArray(allowedApplicationTypes).Contains(applicationType) {
+		return rfcerrors.InvalidClientMetadata().Description("application_type is not allowed by the initial access token.").Build(), fmt.Errorf("application_type '%s' is not allowed by the initial access token", applicationType)
+	}
+	if len(allowedGrantTypes) > 0 && !types.StringArray(allowedGrantTypes).HasAll(grantTypes...) {
+		return rfcerrors.InvalidClientMetadata().Description("grant_types contains a value not allowed by the initial access token.").Build(), fmt.Errorf("grant_types '%s' are not allowed by the initial access token", grantTypes)
+	}
+
+	// No error
+	return nil, nil
+}
+
+// releaseInitialAccessToken cancels a token usage when the registration failed.
+func (s *service) releaseInitialAccessToken(ctx context.Context, t *corev1.InitialAccessToken) error {
+	// Open registration
+	if t == nil {
+		return nil
+	}
+
+	if err := s.initialAccessTokens.Release(ctx, t.TokenId); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("unable to release initial access token usage: %w", err)
+	}
+
+	// No error
+	return nil
+}
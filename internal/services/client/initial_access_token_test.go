@@ -0,0 +1,642 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
+	generatormock "zntr.io/solid/pkg/sdk/generator/mock"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/storage"
+	storagemock "zntr.io/solid/pkg/server/storage/mock"
+)
+
+func Test_service_IssueInitialAccessToken(t *testing.T) {
+	// Freeze time
+	now := time.Unix(1600000000, 0)
+	timeFunc = func() time.Time { return now }
+	defer func() { timeFunc = time.Now }()
+
+	type args struct {
+		ctx context.Context
+		req *corev1.InitialAccessTokenRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockInitialAccessToken, *generatormock.MockInitialAccessToken)
+		want    *corev1.InitialAccessTokenResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &corev1.InitialAccessTokenResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid application type",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.InitialAccessTokenRequest{
+					ApplicationTypes: []string{"foo"},
+				},
+			},
+			wantErr: true,
+			want: &corev1.InitialAccessTokenResponse{
+				Error: rfcerrors.InvalidRequest().Description("application_types contains an invalid or unsupported value.").Build(),
+			},
+		},
+		{
+			name: "unsupported grant type",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.InitialAccessTokenRequest{
+					ApplicationTypes: []string{oidc.ApplicationTypeService},
+					GrantTypes:       []string{oidc.GrantTypeAuthorizationCode},
+				},
+			},
+			wantErr: true,
+			want: &corev1.InitialAccessTokenResponse{
+				Error: rfcerrors.InvalidRequest().Description("grant_types contains an invalid or unsupported value.").Build(),
+			},
+		},
+		{
+			name: "generator error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.InitialAccessTokenRequest{},
+			},
+			prepare: func(_ *storagemock.MockInitialAccessToken, gen *generatormock.MockInitialAccessToken) {
+				gen.EXPECT().Generate(gomock.Any()).Return("", errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.InitialAccessTokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "generator empty value",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.InitialAccessTokenRequest{},
+			},
+			prepare: func(_ *storagemock.MockInitialAccessToken, gen *generatormock.MockInitialAccessToken) {
+				gen.EXPECT().Generate(gomock.Any()).Return("", nil)
+			},
+			wantErr: true,
+			want: &corev1.InitialAccessTokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.InitialAccessTokenRequest{},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken, gen *generatormock.MockInitialAccessToken) {
+				gen.EXPECT().Generate(gomock.Any()).Return("iat-value", nil)
+				tokens.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.InitialAccessTokenResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.InitialAccessTokenRequest{
+					ApplicationTypes: []string{oidc.ApplicationTypeServerSideWeb},
+					GrantTypes:       []string{oidc.GrantTypeAuthorizationCode},
+					ExpiresIn:        3600,
+					UsageLimit:       1,
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken, gen *generatormock.MockInitialAccessToken) {
+				gen.EXPECT().Generate(gomock.Any()).Return("iat-value", nil)
+				tokens.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.InitialAccessTokenResponse{
+				Token: &corev1.InitialAccessToken{
+					Value:            "iat-value",
+					IssuedAt:         1600000000,
+					ExpiresAt:        1600003600,
+					ApplicationTypes: []string{oidc.ApplicationTypeServerSideWeb},
+					GrantTypes:       []string{oidc.GrantTypeAuthorizationCode},
+					UsageLimit:       1,
+				},
+			},
+		},
+		{
+			name: "valid with default lifetime",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.InitialAccessTokenRequest{},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken, gen *generatormock.MockInitialAccessToken) {
+				gen.EXPECT().Generate(gomock.Any()).Return("iat-value", nil)
+				tokens.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.InitialAccessTokenResponse{
+				Token: &corev1.InitialAccessToken{
+					Value:     "iat-value",
+					IssuedAt:  1600000000,
+					ExpiresAt: 1600086400,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			tokens := storagemock.NewMockInitialAccessToken(ctrl)
+			gen := generatormock.NewMockInitialAccessToken(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(tokens, gen)
+			}
+
+			// Prepare service
+			underTest := New("https://as.example.org/register", nil, nil, tokens, gen, profile.Strict(), nil, false, false)
+
+			// Do the request
+			got, err := underTest.IssueInitialAccessToken(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.IssueInitialAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform(), protocmp.IgnoreFields(&corev1.InitialAccessToken{}, "token_id")); diff != "" {
+				t.Errorf("service.IssueInitialAccessToken() res =%s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_authenticateRegistration(t *testing.T) {
+	// Freeze time
+	now := time.Unix(1600000000, 0)
+	timeFunc = func() time.Time { return now }
+	defer func() { timeFunc = time.Now }()
+
+	type args struct {
+		ctx context.Context
+		req *corev1.ClientRegistrationRequest
+	}
+	tests := []struct {
+		name             string
+		openRegistration bool
+		args             args
+		prepare          func(*storagemock.MockInitialAccessToken)
+		want             *corev1.InitialAccessToken
+		wantPublicErr    *corev1.Error
+		wantErr          bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr:       true,
+			wantPublicErr: rfcerrors.InvalidRequest().Build(),
+		},
+		{
+			name: "missing token",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{},
+			},
+			wantErr:       true,
+			wantPublicErr: rfcerrors.InvalidToken().Build(),
+		},
+		{
+			name: "token not found",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					InitialAccessToken: &wrapperspb.StringValue{Value: "iat-value"},
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "iat-value").Return(nil, storage.ErrNotFound)
+			},
+			wantErr:       true,
+			wantPublicErr: rfcerrors.InvalidToken().Build(),
+		},
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					InitialAccessToken: &wrapperspb.StringValue{Value: "iat-value"},
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "iat-value").Return(nil, errors.New("test"))
+			},
+			wantErr:       true,
+			wantPublicErr: rfcerrors.ServerError().Build(),
+		},
+		{
+			name: "expired token",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					InitialAccessToken: &wrapperspb.StringValue{Value: "iat-value"},
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "iat-value").Return(&corev1.InitialAccessToken{
+					TokenId:   "123456",
+					ExpiresAt: 1599999999,
+				}, nil)
+			},
+			wantErr:       true,
+			wantPublicErr: rfcerrors.InvalidToken().Build(),
+		},
+		{
+			name: "usage limit reached",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					InitialAccessToken: &wrapperspb.StringValue{Value: "iat-value"},
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "iat-value").Return(&corev1.InitialAccessToken{
+					TokenId:    "123456",
+					UsageLimit: 2,
+					UsageCount: 2,
+				}, nil)
+			},
+			wantErr:       true,
+			wantPublicErr: rfcerrors.InvalidToken().Build(),
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:             "open registration",
+			openRegistration: true,
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					InitialAccessToken: &wrapperspb.StringValue{Value: "iat-value"},
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().GetByValue(gomock.Any(), "iat-value").Return(&corev1.InitialAccessToken{
+					TokenId:    "123456",
+					ExpiresAt:  1600003600,
+					UsageLimit: 2,
+					UsageCount: 1,
+				}, nil)
+			},
+			wantErr: false,
+			want: &corev1.InitialAccessToken{
+				TokenId:    "123456",
+				ExpiresAt:  1600003600,
+				UsageLimit: 2,
+				UsageCount: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			tokens := storagemock.NewMockInitialAccessToken(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(tokens)
+			}
+
+			// Prepare service
+			underTest := &service{
+				initialAccessTokens: tokens,
+				serverProfile:       profile.Strict(),
+				openRegistration:    tt.openRegistration,
+			}
+
+			// Do the request
+			got, publicErr, err := underTest.authenticateRegistration(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.authenticateRegistration() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(publicErr, tt.wantPublicErr, cmpOpts...); diff != "" {
+				t.Errorf("service.authenticateRegistration() err =%s", diff)
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("service.authenticateRegistration() res =%s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_consumeInitialAccessToken(t *testing.T) {
+	defaultRequest := func() *corev1.ClientRegistrationRequest {
+		return &corev1.ClientRegistrationRequest{
+			Metadata: &corev1.ClientMeta{
+				ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+				GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+			},
+		}
+	}
+
+	type args struct {
+		ctx   context.Context
+		req   *corev1.ClientRegistrationRequest
+		token *corev1.InitialAccessToken
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockInitialAccessToken)
+		want    *corev1.Error
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidRequest().Build(),
+		},
+		{
+			name: "application type not allowed",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+				token: &corev1.InitialAccessToken{
+					TokenId:          "123456",
+					ApplicationTypes: []string{oidc.ApplicationTypeNative},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("application_type is not allowed by the initial access token.").Build(),
+		},
+		{
+			name: "grant type not allowed",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+				token: &corev1.InitialAccessToken{
+					TokenId:    "123456",
+					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("grant_types contains a value not allowed by the initial access token.").Build(),
+		},
+		{
+			name: "token deleted",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+				token: &corev1.InitialAccessToken{
+					TokenId: "123456",
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Use(gomock.Any(), "123456").Return(uint64(0), storage.ErrNotFound)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidToken().Build(),
+		},
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+				token: &corev1.InitialAccessToken{
+					TokenId: "123456",
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Use(gomock.Any(), "123456").Return(uint64(0), errors.New("test"))
+			},
+			wantErr: true,
+			want:    rfcerrors.ServerError().Build(),
+		},
+		{
+			name: "concurrent usage exceeds limit",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+				token: &corev1.InitialAccessToken{
+					TokenId:    "123456",
+					UsageLimit: 1,
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Use(gomock.Any(), "123456").Return(uint64(2), nil)
+				tokens.EXPECT().Release(gomock.Any(), "123456").Return(nil)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidToken().Build(),
+		},
+		{
+			name: "concurrent usage exceeds limit: release error",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+				token: &corev1.InitialAccessToken{
+					TokenId:    "123456",
+					UsageLimit: 1,
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Use(gomock.Any(), "123456").Return(uint64(2), nil)
+				tokens.EXPECT().Release(gomock.Any(), "123456").Return(errors.New("test"))
+			},
+			wantErr: true,
+			want:    rfcerrors.ServerError().Build(),
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "open registration",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: defaultRequest(),
+				token: &corev1.InitialAccessToken{
+					TokenId:          "123456",
+					ApplicationTypes: []string{oidc.ApplicationTypeServerSideWeb},
+					GrantTypes:       []string{oidc.GrantTypeAuthorizationCode},
+					UsageLimit:       1,
+				},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Use(gomock.Any(), "123456").Return(uint64(1), nil)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			tokens := storagemock.NewMockInitialAccessToken(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(tokens)
+			}
+
+			// Prepare service
+			underTest := &service{
+				initialAccessTokens: tokens,
+				serverProfile:       profile.Strict(),
+			}
+
+			// Do the request
+			got, err := underTest.consumeInitialAccessToken(tt.args.ctx, tt.args.req, tt.args.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.consumeInitialAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.consumeInitialAccessToken() res =%s", diff)
+			}
+		})
+	}
+}
+
+func Test_service_releaseInitialAccessToken(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		token *corev1.InitialAccessToken
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockInitialAccessToken)
+		wantErr bool
+	}{
+		{
+			name: "storage error",
+			args: args{
+				ctx:   context.Background(),
+				token: &corev1.InitialAccessToken{TokenId: "123456"},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Release(gomock.Any(), "123456").Return(errors.New("test"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "open registration",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "token deleted",
+			args: args{
+				ctx:   context.Background(),
+				token: &corev1.InitialAccessToken{TokenId: "123456"},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Release(gomock.Any(), "123456").Return(storage.ErrNotFound)
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			args: args{
+				ctx:   context.Background(),
+				token: &corev1.InitialAccessToken{TokenId: "123456"},
+			},
+			prepare: func(tokens *storagemock.MockInitialAccessToken) {
+				tokens.EXPECT().Release(gomock.Any(), "123456").Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			tokens := storagemock.NewMockInitialAccessToken(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(tokens)
+			}
+
+			// Prepare service
+			underTest := &service{
+				initialAccessTokens: tokens,
+			}
+
+			// Do the request
+			err := underTest.releaseInitialAccessToken(tt.args.ctx, tt.args.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.releaseInitialAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
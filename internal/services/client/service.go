@@ -21,32 +21,67 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
 
+	"github.com/dchest/uniuri"
 	"github.com/square/go-jose/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/internal/services"
+	"zntr.io/solid/pkg/sdk/generator"
+	"zntr.io/solid/pkg/sdk/jwe"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/locale"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/sdk/types"
 	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/responsemode"
+	"zntr.io/solid/pkg/server/softwarestatement"
 	"zntr.io/solid/pkg/server/storage"
 )
 
+// defaultAuthorizationEncryptedResponseEnc is the content encryption used when
+// only the key management algorithm is registered.
+const defaultAuthorizationEncryptedResponseEnc = "A128CBC-HS256"
+
 type service struct {
-	clients       storage.ClientWriter
-	serverProfile profile.Server
+	registrationEndpoint  string
+	clients               storage.Client
+	tokens                storage.TokenWriter
+	initialAccessTokens   storage.InitialAccessToken
+	initialAccessTokenGen generator.InitialAccessToken
+	serverProfile         profile.Server
+	softwareStatements    softwarestatement.Verifier
+	openRegistration      bool
+	autoApproval          bool
 }
 
 // New build and returns a client service implementation.
-func New(clients storage.ClientWriter, serverProfile profile.Server) services.Client {
+//
+// Client configuration URIs are built from the given registration endpoint.
+// Software statements are ignored when the given verifier is nil, otherwise a
+// software_id is only accepted when asserted by a statement. Registration
+// requires an initial access token unless openRegistration is enabled.
+// Registered clients are pending an administrator approval unless autoApproval
+// is enabled.
+func New(registrationEndpoint string, clients storage.Client, tokens storage.TokenWriter, initialAccessTokens storage.InitialAccessToken, initialAccessTokenGen generator.InitialAccessToken, serverProfile profile.Server, softwareStatements softwarestatement.Verifier, openRegistration, autoApproval bool) services.Client {
 	return &service{
-		clients:       clients,
-		serverProfile: serverProfile,
+		registrationEndpoint:  registrationEndpoint,
+		clients:               clients,
+		tokens:                tokens,
+		initialAccessTokens:   initialAccessTokens,
+		initialAccessTokenGen: initialAccessTokenGen,
+		serverProfile:         serverProfile,
+		softwareStatements:    softwareStatements,
+		openRegistration:      openRegistration,
+		autoApproval:          autoApproval,
 	}
 }
 
@@ -65,41 +100,57 @@ func (s *service) Register(ctx context.Context, req *corev1.ClientRegistrationRe
 		return res, fmt.Errorf("unable to process nil metadata")
 	}
 
-	// Check application_type value
-	if req.Metadata.ApplicationType == nil {
-		// Default to web
-		req.Metadata.ApplicationType = &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb}
+	// Authenticate registration
+	iat, publicErr, err := s.authenticateRegistration(ctx, req)
+	if err != nil {
+		res.Error = publicErr
+		return res, err
 	}
 
-	// Validate authorization request
-	publicErr, err := s.validateRegistration(ctx, req)
+	// Prepare client from metadata
+	c, publicErr, err := s.prepareClient(ctx, req, nil)
 	if err != nil {
 		res.Error = publicErr
 		return res, err
 	}
 
-	// Create client
-	c := &corev1.Client{
-		ApplicationType:         req.Metadata.ApplicationType.Value,
-		TokenEndpointAuthMethod: req.Metadata.TokenEndpointAuthMethod.Value,
-		Contacts:                req.Metadata.Contacts,
-		GrantTypes:              req.Metadata.GrantTypes,
-		ResponseTypes:           req.Metadata.ResponseTypes,
-		RedirectUris:            req.Metadata.RedirectUris,
+	// Check initial access token restrictions and usage
+	publicErr, err = s.consumeInitialAccessToken(ctx, req, iat)
+	if err != nil {
+		res.Error = publicErr
+		return res, err
 	}
 
-	// Assign attributes
-	if err := s.applyRegistrationRequest(req, c, res); err != nil {
-		return res, err
+	// Keep token restrictions for configuration updates
+	c.AllowedApplicationTypes = iat.GetApplicationTypes()
+	c.AllowedGrantTypes = iat.GetGrantTypes()
+
+	// Generate registration access token
+	rat := uniuri.NewLen(registrationAccessTokenLength)
+	c.RegistrationAccessTokenHash = registrationAccessTokenHash(rat)
+
+	// Assign initial status
+	c.Status = corev1.ClientStatus_CLIENT_STATUS_PENDING
+	if s.autoApproval {
+		c.Status = corev1.ClientStatus_CLIENT_STATUS_ACTIVE
 	}
 
 	// Save client in persistence
 	c.ClientId, err = s.clients.Register(ctx, c)
 	if err != nil {
 		res.Error = rfcerrors.ServerError().Build()
+
+		// Failed registrations don't count as token usage
+		if releaseErr := s.releaseInitialAccessToken(ctx, iat); releaseErr != nil {
+			return res, fmt.Errorf("unable to register client in persistence: %v, %w", err, releaseErr)
+		}
 		return res, fmt.Errorf("unable to register client in persistence: %w", err)
 	}
 
+	// Assign client configuration
+	res.RegistrationAccessToken = rat
+	res.RegistrationClientUri = s.registrationClientURI(c.ClientId)
+
 	// Assign client
 	res.Client = c
 
@@ -109,19 +160,56 @@ func (s *service) Register(ctx context.Context, req *corev1.ClientRegistrationRe
 
 // -----------------------------------------------------------------------------
 
-func (s *service) applyRegistrationRequest(req *corev1.ClientRegistrationRequest, c *corev1.Client, res *corev1.ClientRegistrationResponse) error {
+// prepareClient validates the given metadata and builds the matching client.
+// Metadata asserted by a previous software statement are applied when given.
+func (s *service) prepareClient(ctx context.Context, req *corev1.ClientRegistrationRequest, asserted *corev1.ClientMeta) (*corev1.Client, *corev1.Error, error) {
+	// Apply software statement claims
+	asserted, publicErr, err := s.applySoftwareStatement(ctx, req, asserted)
+	if err != nil {
+		return nil, publicErr, err
+	}
+
+	// Check application_type value
+	if req.Metadata.ApplicationType == nil {
+		// Default to web
+		req.Metadata.ApplicationType = &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb}
+	}
+
+	// Validate registration request
+	publicErr, err = s.validateRegistration(ctx, req)
+	if err != nil {
+		return nil, publicErr, err
+	}
+
+	// Create client
+	c := &corev1.Client{
+		ApplicationType:           req.Metadata.ApplicationType.Value,
+		TokenEndpointAuthMethod:   req.Metadata.TokenEndpointAuthMethod.Value,
+		Contacts:                  req.Metadata.Contacts,
+		GrantTypes:                req.Metadata.GrantTypes,
+		ResponseTypes:             req.Metadata.ResponseTypes,
+		ResponseModes:             req.Metadata.ResponseModes,
+		RedirectUris:              req.Metadata.RedirectUris,
+		SoftwareStatementMetadata: asserted,
+	}
+
+	// Assign attributes
+	publicErr, err = s.applyRegistrationRequest(req, c)
+	if err != nil {
+		return nil, publicErr, err
+	}
+
+	// No error
+	return c, nil, nil
+}
+
+func (s *service) applyRegistrationRequest(req *corev1.ClientRegistrationRequest, c *corev1.Client) (*corev1.Error, error) {
 	// Check arguments
 	if req == nil {
-		res.Error = rfcerrors.InvalidRequest().Build()
-		return fmt.Errorf("unable to process nil request")
+		return rfcerrors.InvalidRequest().Build(), fmt.Errorf("unable to process nil request")
 	}
 	if c == nil {
-		res.Error = rfcerrors.InvalidRequest().Build()
-		return fmt.Errorf("unable to process nil request")
-	}
-	if res == nil {
-		res.Error = rfcerrors.InvalidRequest().Build()
-		return fmt.Errorf("unable to process nil response")
+		return rfcerrors.InvalidRequest().Build(), fmt.Errorf("unable to process nil client")
 	}
 
 	if req.Metadata.ClientName != nil {
@@ -149,34 +237,74 @@ func (s *service) applyRegistrationRequest(req *corev1.ClientRegistrationRequest
 		c.LogoUri = req.Metadata.LogoUri.Value
 	}
 
+	// Localized metadata
+	c.ClientNameI18N = req.Metadata.ClientNameI18N
+	c.LogoUriI18N = req.Metadata.LogoUriI18N
+	c.TosUriI18N = req.Metadata.TosUriI18N
+	c.PolicyUriI18N = req.Metadata.PolicyUriI18N
+
 	// JWKS
 	if req.Metadata.Jwks != nil {
 		// Assign to client
 		c.Jwks = req.Metadata.Jwks.Value
 	}
 
+	// Software
+	if req.Metadata.SoftwareId != nil {
+		// Assign to client
+		c.SoftwareId = req.Metadata.SoftwareId.Value
+	}
+	if req.Metadata.SoftwareVersion != nil {
+		// Assign to client
+		c.SoftwareVersion = req.Metadata.SoftwareVersion.Value
+	}
+
+	// Authorization response encryption
+	if req.Metadata.AuthorizationEncryptedResponseAlg != nil {
+		// Assign to client
+		c.AuthorizationEncryptedResponseAlg = req.Metadata.AuthorizationEncryptedResponseAlg.Value
+	}
+	if req.Metadata.AuthorizationEncryptedResponseEnc != nil {
+		// Assign to client
+		c.AuthorizationEncryptedResponseEnc = req.Metadata.AuthorizationEncryptedResponseEnc.Value
+	}
+
+	// Signature algorithms
+	if req.Metadata.TokenEndpointAuthSigningAlg != nil {
+		// Assign to client
+		c.TokenEndpointAuthSigningAlg = req.Metadata.TokenEndpointAuthSigningAlg.Value
+	}
+	if req.Metadata.RequestObjectSigningAlg != nil {
+		// Assign to client
+		c.RequestObjectSigningAlg = req.Metadata.RequestObjectSigningAlg.Value
+	}
+	if req.Metadata.AuthorizationSignedResponseAlg != nil {
+		// Assign to client
+		c.AuthorizationSignedResponseAlg = req.Metadata.AuthorizationSignedResponseAlg.Value
+	}
+
+	// Sender-constrained access tokens
+	if req.Metadata.DpopBoundAccessTokens != nil {
+		// Assign to client
+		c.DpopBoundAccessTokens = req.Metadata.DpopBoundAccessTokens.Value
+	}
+	if req.Metadata.TlsClientCertificateBoundAccessTokens != nil {
+		// Assign to client
+		c.TlsClientCertificateBoundAccessTokens = req.Metadata.TlsClientCertificateBoundAccessTokens.Value
+	}
+
 	// Subject type
 	if req.Metadata.SubjectType != nil {
 		subjectType := req.Metadata.SubjectType.Value
 
-		// Check enumeration
+		// Check enumeration, pairwise subject identifiers are not supported
 		switch subjectType {
-		case oidc.SubjectTypePublic, oidc.SubjectTypePairwise:
+		case oidc.SubjectTypePublic:
 			c.SubjectType = subjectType
+		case oidc.SubjectTypePairwise:
+			return rfcerrors.InvalidClientMetadata().Description("subject_type 'pairwise' is not supported.").Build(), fmt.Errorf("subject_type '%s' is not supported", subjectType)
 		default:
-			res.Error = rfcerrors.InvalidClientMetadata().Build()
-			return fmt.Errorf("subject_type contains invalid value")
-		}
-
-		// Sector identifier is mandatory with pairwise subject type
-		if subjectType == oidc.SubjectTypePairwise {
-			if req.Metadata.SectorIdentifier != nil {
-				// Assign to client
-				c.SectorIdentifier = req.Metadata.SectorIdentifier.Value
-			} else {
-				res.Error = rfcerrors.InvalidClientMetadata().Build()
-				return fmt.Errorf("sector_identifier is mandatory with subject_type")
-			}
+			return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("subject_type contains invalid value")
 		}
 	} else {
 		// Default to public
@@ -184,7 +312,62 @@ func (s *service) applyRegistrationRequest(req *corev1.ClientRegistrationRequest
 	}
 
 	// No error
-	return nil
+	return nil, nil
+}
+
+// applySoftwareStatement verifies the request software statement and applies
+// its claims to the request metadata. Metadata asserted by a previously
+// verified statement are applied when the request doesn't contain one. It
+// returns the asserted metadata to be kept with the client.
+func (s *service) applySoftwareStatement(ctx context.Context, req *corev1.ClientRegistrationRequest, asserted *corev1.ClientMeta) (*corev1.ClientMeta, *corev1.Error, error) {
+	// Check arguments
+	if req == nil {
+		return nil, rfcerrors.InvalidRequest().Build(), fmt.Errorf("unable to process nil request")
+	}
+	if req.Metadata == nil {
+		return nil, rfcerrors.InvalidRequest().Build(), fmt.Errorf("unable to process nil metadata")
+	}
+
+	// Statement is ignored when not supported
+	// https://tools.ietf.org/html/rfc7591#section-2.3
+	if req.Metadata.SoftwareStatement != nil && !types.IsNil(s.softwareStatements) {
+		// Verify statement
+		statement, err := s.softwareStatements.Verify(ctx, req.Metadata.SoftwareStatement.Value)
+		switch {
+		case err == nil:
+		case errors.Is(err, softwarestatement.ErrUnapprovedSoftware):
+			return nil, rfcerrors.UnapprovedSoftwareStatement().Build(), fmt.Errorf("unable to verify software statement: %w", err)
+		case errors.Is(err, softwarestatement.ErrInvalidStatement), errors.Is(err, softwarestatement.ErrUntrustedIssuer):
+			return nil, rfcerrors.InvalidSoftwareStatement().Build(), fmt.Errorf("unable to verify software statement: %w", err)
+		default:
+			return nil, rfcerrors.ServerError().Build(), fmt.Errorf("unable to verify software statement: %w", err)
+		}
+		if statement == nil || statement.Metadata == nil {
+			return nil, rfcerrors.ServerError().Build(), fmt.Errorf("software statement verifier returned a nil statement")
+		}
+
+		// Software identifier is asserted by the statement
+		asserted = proto.Clone(statement.Metadata).(*corev1.ClientMeta)
+		asserted.SoftwareId = &wrapperspb.StringValue{Value: statement.SoftwareId}
+	}
+	if asserted == nil {
+		// Software identifier can't be self-asserted when statements are
+		// verified, it would bypass the approved software list.
+		if !types.IsNil(s.softwareStatements) && req.Metadata.SoftwareId != nil {
+			return nil, rfcerrors.InvalidClientMetadata().Description("software_id must be asserted by a software statement.").Build(), fmt.Errorf("software_id is not asserted by a software statement")
+		}
+		return nil, nil, nil
+	}
+
+	// Statement claims take precedence over client supplied metadata
+	meta := req.Metadata.ProtoReflect()
+	asserted.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		meta.Set(fd, v)
+		return true
+	})
+
+	// No error
+	return asserted, nil, nil
 }
 
 func (s *service) validateRegistration(ctx context.Context, req *corev1.ClientRegistrationRequest) (*corev1.Error, error) {
@@ -224,6 +407,13 @@ func (s *service) validateRegistration(ctx context.Context, req *corev1.ClientRe
 		req.Metadata.ResponseTypes = clientSettings.ResponseTypesSupported()
 	}
 
+	// Response modes
+	for _, mode := range req.Metadata.ResponseModes {
+		if !responsemode.IsSupported(mode) {
+			return rfcerrors.InvalidClientMetadata().Description("response_modes contains an invalid or unsupported value.").Build(), fmt.Errorf("a response_modes element is invalid: '%s'", mode)
+		}
+	}
+
 	// Grant types
 	if len(req.Metadata.GrantTypes) > 0 {
 		grantTypes := types.StringArray(req.Metadata.GrantTypes)
@@ -268,21 +458,123 @@ func (s *service) validateRegistration(ctx context.Context, req *corev1.ClientRe
 	}
 
 	// JWKS
-	if req.Metadata.Jwks != nil {
+	if req.Metadata.Jwks != nil && req.Metadata.JwkUri != nil {
+		return rfcerrors.InvalidClientMetadata().Description("jwks and jwks_uri must not be used together.").Build(), fmt.Errorf("jwks and jwks_uri are mutually exclusive")
+	}
+	var jwks *jose.JSONWebKeySet
+	switch {
+	case req.Metadata.Jwks != nil:
 		// Try to decode JWKS
-		var jwks jose.JSONWebKeySet
 		if err := json.NewDecoder(bytes.NewBuffer(req.Metadata.Jwks.Value)).Decode(&jwks); err != nil {
 			return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("jwks is invalid: %w", err)
 		}
 
 		// JWKS should contain keys
-		if len(jwks.Keys) == 0 {
+		if jwks == nil || len(jwks.Keys) == 0 {
 			return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("jwks is empty")
 		}
-	} else {
+	case req.Metadata.JwkUri != nil:
+		// Check jwks_uri syntax
+		u, err := url.ParseRequestURI(req.Metadata.JwkUri.Value)
+		if err != nil {
+			return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("jwks_uri has an invalid syntax: %w", err)
+		}
+		if u.Scheme != "https" {
+			return rfcerrors.InvalidClientMetadata().Description("jwks_uri must use https scheme.").Build(), fmt.Errorf("jwks_uri must use https scheme")
+		}
+	default:
 		// Check auth method
 		if req.Metadata.TokenEndpointAuthMethod.Value == oidc.AuthMethodPrivateKeyJWT {
-			return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("jwks is mandatory for `private_key_jwt` authentication")
+			return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("jwks or jwks_uri is mandatory for `private_key_jwt` authentication")
+		}
+	}
+
+	// Signature algorithms
+	// https://openid.net/specs/openid-connect-registration-1_0.html#ClientMetadata
+	for _, m := range []struct {
+		name      string
+		value     *wrapperspb.StringValue
+		clientKey bool
+	}{
+		{name: "token_endpoint_auth_signing_alg", value: req.Metadata.TokenEndpointAuthSigningAlg, clientKey: true},
+		{name: "request_object_signing_alg", value: req.Metadata.RequestObjectSigningAlg, clientKey: true},
+		{name: "authorization_signed_response_alg", value: req.Metadata.AuthorizationSignedResponseAlg},
+	} {
+		if m.value == nil {
+			continue
+		}
+		if !s.serverProfile.SigningAlgorithmsSupported().Contains(m.value.Value) {
+			return rfcerrors.InvalidClientMetadata().Description(fmt.Sprintf("%s contains an invalid or unsupported value.", m.name)).Build(), fmt.Errorf("%s is invalid: '%s', supported '%s'", m.name, m.value.Value, s.serverProfile.SigningAlgorithmsSupported())
+		}
+
+		// Client keys must be usable with the algorithm
+		if m.clientKey && jwks != nil && !hasSigningKey(jwks, m.value.Value) {
+			return rfcerrors.InvalidClientMetadata().Description(fmt.Sprintf("jwks doesn't contain a key usable with %s.", m.name)).Build(), fmt.Errorf("jwks doesn't contain a signing key for '%s' algorithm", m.value.Value)
+		}
+	}
+
+	// Sender-constrained access tokens
+	if clientSettings.SenderConstrainedAccessTokensRequired() {
+		var (
+			dpopBound = req.Metadata.DpopBoundAccessTokens
+			tlsBound  = req.Metadata.TlsClientCertificateBoundAccessTokens
+		)
+
+		switch {
+		case dpopBound.GetValue(), tlsBound.GetValue():
+			// Binding explicitly requested
+		case dpopBound == nil:
+			// Default to DPoP binding
+			req.Metadata.DpopBoundAccessTokens = &wrapperspb.BoolValue{Value: true}
+		default:
+			return rfcerrors.InvalidClientMetadata().Description("sender-constrained access tokens are mandatory for this application_type.").Build(), fmt.Errorf("dpop_bound_access_tokens or tls_client_certificate_bound_access_tokens must be enabled for '%s' application type", req.Metadata.ApplicationType.Value)
+		}
+	}
+
+	// Authorization response encryption
+	// https://openid.net/specs/oauth-v2-jarm.html#section-3
+	if req.Metadata.AuthorizationEncryptedResponseAlg != nil {
+		if !types.StringArray(jwe.DefaultKeyAlgorithms).Contains(req.Metadata.AuthorizationEncryptedResponseAlg.Value) {
+			return rfcerrors.InvalidClientMetadata().Description("authorization_encrypted_response_alg contains an invalid or unsupported value.").Build(), fmt.Errorf("authorization_encrypted_response_alg is invalid: '%s'", req.Metadata.AuthorizationEncryptedResponseAlg.Value)
+		}
+		if req.Metadata.AuthorizationEncryptedResponseEnc == nil {
+			// Assign default content encryption
+			req.Metadata.AuthorizationEncryptedResponseEnc = &wrapperspb.StringValue{Value: defaultAuthorizationEncryptedResponseEnc}
+		}
+		if !types.StringArray(jwe.DefaultContentEncryptions).Contains(req.Metadata.AuthorizationEncryptedResponseEnc.Value) {
+			return rfcerrors.InvalidClientMetadata().Description("authorization_encrypted_response_enc contains an invalid or unsupported value.").Build(), fmt.Errorf("authorization_encrypted_response_enc is invalid: '%s'", req.Metadata.AuthorizationEncryptedResponseEnc.Value)
+		}
+		if req.Metadata.Jwks == nil && req.Metadata.JwkUri == nil {
+			return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("jwks or jwks_uri is mandatory for authorization response encryption")
+		}
+	} else if req.Metadata.AuthorizationEncryptedResponseEnc != nil {
+		return rfcerrors.InvalidClientMetadata().Description("authorization_encrypted_response_enc requires authorization_encrypted_response_alg.").Build(), fmt.Errorf("authorization_encrypted_response_alg is mandatory with authorization_encrypted_response_enc")
+	}
+
+	// Localized metadata
+	// https://openid.net/specs/openid-connect-registration-1_0.html#LanguagesAndScripts
+	for _, m := range []struct {
+		name   string
+		values map[string]string
+		uri    bool
+	}{
+		{name: "client_name", values: req.Metadata.ClientNameI18N},
+		{name: "logo_uri", values: req.Metadata.LogoUriI18N, uri: true},
+		{name: "tos_uri", values: req.Metadata.TosUriI18N, uri: true},
+		{name: "policy_uri", values: req.Metadata.PolicyUriI18N, uri: true},
+	} {
+		for tag, value := range m.values {
+			if !locale.IsValid(tag) {
+				return rfcerrors.InvalidClientMetadata().Description(fmt.Sprintf("%s contains an invalid language tag.", m.name)).Build(), fmt.Errorf("%s language tag is invalid: '%s'", m.name, tag)
+			}
+			if value == "" {
+				return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("%s#%s should not be empty", m.name, tag)
+			}
+			if m.uri {
+				if _, err := url.ParseRequestURI(value); err != nil {
+					return rfcerrors.InvalidClientMetadata().Build(), fmt.Errorf("%s#%s has an invalid syntax: %w", m.name, tag, err)
+				}
+			}
 		}
 	}
 
@@ -294,3 +586,24 @@ func (s *service) validateRegistration(ctx context.Context, req *corev1.ClientRe
 	// No error
 	return nil, nil
 }
+
+// -----------------------------------------------------------------------------
+
+// hasSigningKey returns true if the key set contains a signature key usable
+// with the given algorithm.
+func hasSigningKey(jwks *jose.JSONWebKeySet, alg string) bool {
+	for i := range jwks.Keys {
+		k := &jwks.Keys[i]
+
+		// Ignore encryption keys
+		if k.Use == "enc" {
+			continue
+		}
+
+		if err := jwk.CheckAlgorithm(k, alg); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -19,17 +19,21 @@ package client
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/softwarestatement"
+	softwarestatementmock "zntr.io/solid/pkg/server/softwarestatement/mock"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
 
@@ -297,6 +301,412 @@ func Test_service_validateRegistration(t *testing.T) {
 			wantErr: true,
 			want:    rfcerrors.InvalidClientMetadata().Description("response_types contains an invalid or unsupported value for authorization code flow").Build(),
 		},
+		{
+			name: "all: jwks and jwks_uri",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						Jwks:   &wrapperspb.BytesValue{Value: []byte(`{"keys":[]}`)},
+						JwkUri: &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("jwks and jwks_uri must not be used together.").Build(),
+		},
+		{
+			name: "all: invalid jwks_uri",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri: &wrapperspb.StringValue{Value: "client.example.org/jwks.json"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Build(),
+		},
+		{
+			name: "all: jwks_uri without https",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri: &wrapperspb.StringValue{Value: "http://client.example.org/jwks.json"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("jwks_uri must use https scheme.").Build(),
+		},
+		{
+			name: "device: sender-constrained access tokens disabled",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeDevice},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						GrantTypes:            []string{oidc.GrantTypeDeviceCode},
+						JwkUri:                &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						DpopBoundAccessTokens: &wrapperspb.BoolValue{Value: false},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("sender-constrained access tokens are mandatory for this application_type.").Build(),
+		},
+		{
+			name: "all: authorization_encrypted_response_enc without alg",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri:                            &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						AuthorizationEncryptedResponseEnc: &wrapperspb.StringValue{Value: "A256GCM"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("authorization_encrypted_response_enc requires authorization_encrypted_response_alg.").Build(),
+		},
+		{
+			name: "all: unsupported authorization_encrypted_response_alg",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri:                            &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						AuthorizationEncryptedResponseAlg: &wrapperspb.StringValue{Value: "RSA1_5"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("authorization_encrypted_response_alg contains an invalid or unsupported value.").Build(),
+		},
+		{
+			name: "all: unsupported authorization_encrypted_response_enc",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri:                            &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						AuthorizationEncryptedResponseAlg: &wrapperspb.StringValue{Value: "ECDH-ES+A256KW"},
+						AuthorizationEncryptedResponseEnc: &wrapperspb.StringValue{Value: "A128GCM"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("authorization_encrypted_response_enc contains an invalid or unsupported value.").Build(),
+		},
+		{
+			name: "all: unsupported request_object_signing_alg",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						RequestObjectSigningAlg: &wrapperspb.StringValue{Value: "HS256"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("request_object_signing_alg contains an invalid or unsupported value.").Build(),
+		},
+		{
+			name: "all: token_endpoint_auth_signing_alg / jwks mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						Jwks:                        &wrapperspb.BytesValue{Value: []byte(`{"keys":[{"kty": "EC","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk"}]}`)},
+						TokenEndpointAuthSigningAlg: &wrapperspb.StringValue{Value: "ES384"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("jwks doesn't contain a key usable with token_endpoint_auth_signing_alg.").Build(),
+		},
+		{
+			name: "all: unsupported authorization_signed_response_alg",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri:                         &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						AuthorizationSignedResponseAlg: &wrapperspb.StringValue{Value: "none"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("authorization_signed_response_alg contains an invalid or unsupported value.").Build(),
+		},
+		{
+			name: "all: invalid response_modes value",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						ResponseModes: []string{"form_post", "web_message"},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("response_modes contains an invalid or unsupported value.").Build(),
+		},
+		{
+			name: "all: invalid client_name language tag",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri: &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						ClientNameI18N: map[string]string{
+							"en_US": "My Client",
+						},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("client_name contains an invalid language tag.").Build(),
+		},
+		{
+			name: "all: empty localized client_name",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri: &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						ClientNameI18N: map[string]string{
+							"fr": "",
+						},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Build(),
+		},
+		{
+			name: "all: invalid localized logo_uri",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri: &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						LogoUriI18N: map[string]string{
+							"ja-Jpan-JP": "logo.png",
+						},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Build(),
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "device: default to dpop bound access tokens",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeDevice},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						GrantTypes: []string{oidc.GrantTypeDeviceCode},
+						JwkUri:     &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "device: tls bound access tokens",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeDevice},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						GrantTypes:                            []string{oidc.GrantTypeDeviceCode},
+						JwkUri:                                &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						DpopBoundAccessTokens:                 &wrapperspb.BoolValue{Value: false},
+						TlsClientCertificateBoundAccessTokens: &wrapperspb.BoolValue{Value: true},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "web: encrypted authorization response",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri:                            &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						AuthorizationEncryptedResponseAlg: &wrapperspb.StringValue{Value: "ECDH-ES+A256KW"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "web: localized metadata",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ApplicationType: &wrapperspb.StringValue{Value: oidc.ApplicationTypeServerSideWeb},
+						TokenEndpointAuthMethod: &wrapperspb.StringValue{
+							Value: oidc.AuthMethodPrivateKeyJWT,
+						},
+						ResponseTypes: []string{oidc.ResponseTypeCode},
+						GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
+						RedirectUris: []string{
+							"http://127.0.0.1:8085/as/127.0.0.1/cb",
+						},
+						JwkUri: &wrapperspb.StringValue{Value: "https://client.example.org/jwks.json"},
+						ClientNameI18N: map[string]string{
+							"fr":         "Mon client",
+							"ja-Jpan-JP": "クライアント名",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		/*
 			{
 				name: "client_credentials: invalid response_type",
@@ -451,3 +861,252 @@ func Test_service_validateRegistration(t *testing.T) {
 		})
 	}
 }
+
+func Test_service_applySoftwareStatement(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		req      *corev1.ClientRegistrationRequest
+		asserted *corev1.ClientMeta
+	}
+	tests := []struct {
+		name         string
+		args         args
+		prepare      func(*softwarestatementmock.MockVerifier)
+		want         *corev1.Error
+		wantMeta     *corev1.ClientMeta
+		wantAsserted *corev1.ClientMeta
+		wantErr      bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidRequest().Build(),
+		},
+		{
+			name: "nil metadata",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidRequest().Build(),
+		},
+		{
+			name: "invalid statement",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						SoftwareStatement: &wrapperspb.StringValue{Value: "eyJ..."},
+					},
+				},
+			},
+			prepare: func(statements *softwarestatementmock.MockVerifier) {
+				statements.EXPECT().Verify(gomock.Any(), "eyJ...").Return(nil, softwarestatement.ErrInvalidStatement)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidSoftwareStatement().Build(),
+		},
+		{
+			name: "untrusted issuer",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						SoftwareStatement: &wrapperspb.StringValue{Value: "eyJ..."},
+					},
+				},
+			},
+			prepare: func(statements *softwarestatementmock.MockVerifier) {
+				statements.EXPECT().Verify(gomock.Any(), "eyJ...").Return(nil, softwarestatement.ErrUntrustedIssuer)
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidSoftwareStatement().Build(),
+		},
+		{
+			name: "unapproved software",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						SoftwareStatement: &wrapperspb.StringValue{Value: "eyJ..."},
+					},
+				},
+			},
+			prepare: func(statements *softwarestatementmock.MockVerifier) {
+				statements.EXPECT().Verify(gomock.Any(), "eyJ...").Return(nil, softwarestatement.ErrUnapprovedSoftware)
+			},
+			wantErr: true,
+			want:    rfcerrors.UnapprovedSoftwareStatement().Build(),
+		},
+		{
+			name: "verifier error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						SoftwareStatement: &wrapperspb.StringValue{Value: "eyJ..."},
+					},
+				},
+			},
+			prepare: func(statements *softwarestatementmock.MockVerifier) {
+				statements.EXPECT().Verify(gomock.Any(), "eyJ...").Return(nil, errors.New("test"))
+			},
+			wantErr: true,
+			want:    rfcerrors.ServerError().Build(),
+		},
+		{
+			name: "nil statement",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						SoftwareStatement: &wrapperspb.StringValue{Value: "eyJ..."},
+					},
+				},
+			},
+			prepare: func(statements *softwarestatementmock.MockVerifier) {
+				statements.EXPECT().Verify(gomock.Any(), "eyJ...").Return(nil, nil)
+			},
+			wantErr: true,
+			want:    rfcerrors.ServerError().Build(),
+		},
+		{
+			name: "self-asserted software_id",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ClientName: &wrapperspb.StringValue{Value: "My Example Client"},
+						SoftwareId: &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+					},
+				},
+			},
+			wantErr: true,
+			want:    rfcerrors.InvalidClientMetadata().Description("software_id must be asserted by a software statement.").Build(),
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "no statement",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ClientName: &wrapperspb.StringValue{Value: "My Example Client"},
+					},
+				},
+			},
+			wantErr: false,
+			wantMeta: &corev1.ClientMeta{
+				ClientName: &wrapperspb.StringValue{Value: "My Example Client"},
+			},
+		},
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ClientName:        &wrapperspb.StringValue{Value: "My Example Client"},
+						RedirectUris:      []string{"https://client.example.org/callback"},
+						SoftwareId:        &wrapperspb.StringValue{Value: "spoofed"},
+						Scope:             &wrapperspb.StringValue{Value: "openid"},
+						SoftwareStatement: &wrapperspb.StringValue{Value: "eyJ..."},
+					},
+				},
+			},
+			prepare: func(statements *softwarestatementmock.MockVerifier) {
+				statements.EXPECT().Verify(gomock.Any(), "eyJ...").Return(&corev1.SoftwareStatement{
+					SoftwareId: "4NRB1-0XZABZI9E6-5SM3R",
+					Issuer:     "https://statements.example.org",
+					Metadata: &corev1.ClientMeta{
+						ClientName:   &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+						RedirectUris: []string{"https://client.example.net/callback"},
+					},
+				}, nil)
+			},
+			wantErr: false,
+			wantMeta: &corev1.ClientMeta{
+				ClientName:        &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+				RedirectUris:      []string{"https://client.example.net/callback"},
+				SoftwareId:        &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+				Scope:             &wrapperspb.StringValue{Value: "openid"},
+				SoftwareStatement: &wrapperspb.StringValue{Value: "eyJ..."},
+			},
+			wantAsserted: &corev1.ClientMeta{
+				ClientName:   &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+				RedirectUris: []string{"https://client.example.net/callback"},
+				SoftwareId:   &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+			},
+		},
+		{
+			name: "previously asserted metadata",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientRegistrationRequest{
+					Metadata: &corev1.ClientMeta{
+						ClientName: &wrapperspb.StringValue{Value: "Spoofed Client"},
+						SoftwareId: &wrapperspb.StringValue{Value: "spoofed"},
+						Scope:      &wrapperspb.StringValue{Value: "openid"},
+					},
+				},
+				asserted: &corev1.ClientMeta{
+					ClientName: &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+					SoftwareId: &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+				},
+			},
+			wantErr: false,
+			wantMeta: &corev1.ClientMeta{
+				ClientName: &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+				SoftwareId: &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+				Scope:      &wrapperspb.StringValue{Value: "openid"},
+			},
+			wantAsserted: &corev1.ClientMeta{
+				ClientName: &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+				SoftwareId: &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			statements := softwarestatementmock.NewMockVerifier(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(statements)
+			}
+
+			// Prepare service
+			underTest := &service{
+				serverProfile:      profile.Strict(),
+				softwareStatements: statements,
+			}
+
+			// Do the request
+			asserted, got, err := underTest.applySoftwareStatement(tt.args.ctx, tt.args.req, tt.args.asserted)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.applySoftwareStatement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("service.applySoftwareStatement() res =%s", diff)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.args.req.Metadata, tt.wantMeta, protocmp.Transform()); diff != "" {
+				t.Errorf("service.applySoftwareStatement() meta =%s", diff)
+			}
+			if diff := cmp.Diff(asserted, tt.wantAsserted, protocmp.Transform()); diff != "" {
+				t.Errorf("service.applySoftwareStatement() asserted =%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+// statusTransitions lists allowed client status changes.
+var statusTransitions = map[corev1.ClientStatus][]corev1.ClientStatus{
+	corev1.ClientStatus_CLIENT_STATUS_PENDING: {
+		corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+		corev1.ClientStatus_CLIENT_STATUS_DISABLED,
+	},
+	corev1.ClientStatus_CLIENT_STATUS_ACTIVE: {
+		corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+		corev1.ClientStatus_CLIENT_STATUS_DISABLED,
+	},
+	corev1.ClientStatus_CLIENT_STATUS_SUSPENDED: {
+		corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+		corev1.ClientStatus_CLIENT_STATUS_DISABLED,
+	},
+}
+
+func (s *service) UpdateStatus(ctx context.Context, req *corev1.ClientStatusRequest) (*corev1.ClientStatusResponse, error) {
+	res := &corev1.ClientStatusResponse{}
+
+	// Check req nullity
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Check arguments
+	if req.ClientId == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("client_id must not be empty")
+	}
+
+	// Retrieve client
+	c, err := s.clients.Get(ctx, req.ClientId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("client '%s' not found", req.ClientId)
+		}
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("unable to retrieve client: %w", err)
+	}
+
+	// Check status transition
+	current := storage.ClientStatus(c)
+	retry := current == req.Status && revokedStatus(current)
+	if !retry && !statusTransitionAllowed(current, req.Status) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("client '%s' can't transition from '%s' to '%s'", c.ClientId, current, req.Status)
+	}
+
+	// Save client in persistence before revocation so that no token can be
+	// issued in between.
+	if !retry {
+		c.Status = req.Status
+		if err := s.clients.Update(ctx, c); err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to update client in persistence: %w", err)
+		}
+	}
+
+	// Revoke issued tokens when the client is no longer usable, the request
+	// can be replayed to retry a failed revocation.
+	if current == corev1.ClientStatus_CLIENT_STATUS_ACTIVE || retry {
+		if err := s.tokens.RevokeByClient(ctx, c.ClientId); err != nil {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("unable to revoke client tokens: %w", err)
+		}
+	}
+
+	// Assign client
+	res.Client = c
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func revokedStatus(status corev1.ClientStatus) bool {
+	return status == corev1.ClientStatus_CLIENT_STATUS_SUSPENDED || status == corev1.ClientStatus_CLIENT_STATUS_DISABLED
+}
+
+func statusTransitionAllowed(from, to corev1.ClientStatus) bool {
+	for _, s := range statusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
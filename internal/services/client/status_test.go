@@ -0,0 +1,307 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/storage"
+	storagemock "zntr.io/solid/pkg/server/storage/mock"
+)
+
+func Test_service_UpdateStatus(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *corev1.ClientStatusRequest
+	}
+	withStatus := func(status corev1.ClientStatus) *corev1.Client {
+		c := registeredClient()
+		c.Status = status
+		return c
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockClient, *storagemock.MockTokenWriter)
+		want    *corev1.ClientStatusResponse
+		wantErr bool
+	}{
+		{
+			name: "nil request",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty client_id",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					Status: corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "client not found",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, _ *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "client storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, _ *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "disabled client reactivation",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, _ *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_DISABLED), nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "pending client suspension",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, _ *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_PENDING), nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "token revocation error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_ACTIVE), nil)
+				gomock.InOrder(
+					clients.EXPECT().Update(gomock.Any(), withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED)).Return(nil),
+					tokens.EXPECT().RevokeByClient(gomock.Any(), "s6BhdRkqt3").Return(errors.New("test")),
+				)
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "client update error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, _ *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_PENDING), nil)
+				clients.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("test"))
+			},
+			wantErr: true,
+			want: &corev1.ClientStatusResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid: approval",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, _ *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_PENDING), nil)
+				clients.EXPECT().Update(gomock.Any(), withStatus(corev1.ClientStatus_CLIENT_STATUS_ACTIVE)).Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.ClientStatusResponse{
+				Client: withStatus(corev1.ClientStatus_CLIENT_STATUS_ACTIVE),
+			},
+		},
+		{
+			name: "valid: suspension",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_ACTIVE), nil)
+				gomock.InOrder(
+					clients.EXPECT().Update(gomock.Any(), withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED)).Return(nil),
+					tokens.EXPECT().RevokeByClient(gomock.Any(), "s6BhdRkqt3").Return(nil),
+				)
+			},
+			wantErr: false,
+			want: &corev1.ClientStatusResponse{
+				Client: withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED),
+			},
+		},
+		{
+			name: "valid: legacy client suspension",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_INVALID), nil)
+				clients.EXPECT().Update(gomock.Any(), withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED)).Return(nil)
+				tokens.EXPECT().RevokeByClient(gomock.Any(), "s6BhdRkqt3").Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.ClientStatusResponse{
+				Client: withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED),
+			},
+		},
+		{
+			name: "valid: revocation retry",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, tokens *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED), nil)
+				tokens.EXPECT().RevokeByClient(gomock.Any(), "s6BhdRkqt3").Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.ClientStatusResponse{
+				Client: withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED),
+			},
+		},
+		{
+			name: "valid: suspended client disabling",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientStatusRequest{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_DISABLED,
+				},
+			},
+			prepare: func(clients *storagemock.MockClient, _ *storagemock.MockTokenWriter) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(withStatus(corev1.ClientStatus_CLIENT_STATUS_SUSPENDED), nil)
+				clients.EXPECT().Update(gomock.Any(), withStatus(corev1.ClientStatus_CLIENT_STATUS_DISABLED)).Return(nil)
+			},
+			wantErr: false,
+			want: &corev1.ClientStatusResponse{
+				Client: withStatus(corev1.ClientStatus_CLIENT_STATUS_DISABLED),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			clients := storagemock.NewMockClient(ctrl)
+			tokens := storagemock.NewMockTokenWriter(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(clients, tokens)
+			}
+
+			// Prepare service
+			underTest := New("https://as.example.org/register", clients, tokens, nil, nil, profile.Strict(), nil, false, false)
+
+			// Do the request
+			got, err := underTest.UpdateStatus(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.UpdateStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("service.UpdateStatus() res =%s", diff)
+			}
+		})
+	}
+}
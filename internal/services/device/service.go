@@ -27,19 +27,22 @@ import (
 	"zntr.io/solid/internal/services"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 )
 
 type service struct {
 	clients            storage.ClientReader
 	deviceCodeSessions storage.DeviceCodeSession
+	serverProfile      profile.Server
 }
 
 // New build and returns an authorization service implementation.
-func New(clients storage.ClientReader, deviceCodeSessions storage.DeviceCodeSession) services.Device {
+func New(clients storage.ClientReader, deviceCodeSessions storage.DeviceCodeSession, serverProfile profile.Server) services.Device {
 	return &service{
 		clients:            clients,
 		deviceCodeSessions: deviceCodeSessions,
+		serverProfile:      serverProfile,
 	}
 }
 
@@ -72,18 +75,31 @@ func (s *service) Authorize(ctx context.Context, req *corev1.DeviceAuthorization
 		res.Error = rfcerrors.InvalidClient().Build()
 		return res, fmt.Errorf("unable to process with nil client")
 	}
+	if storage.ClientStatus(client) != corev1.ClientStatus_CLIENT_STATUS_ACTIVE {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("client '%s' is not active", client.ClientId)
+	}
 
 	// Validate client capabilities
 	if !types.StringArray(client.GrantTypes).Contains(oidc.GrantTypeDeviceCode) {
 		res.Error = rfcerrors.UnsupportedGrantType().Build()
 		return res, fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeDeviceCode)
 	}
+	if err := profile.CheckGrantType(s.serverProfile, client, oidc.GrantTypeDeviceCode); err != nil {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
 
 	// Prepare session
 	session := &corev1.DeviceCodeSession{
 		Client:  client,
 		Request: req,
 	}
+	if req.Confirmation != nil && req.Confirmation.Jkt != "" {
+		session.Confirmation = &corev1.TokenConfirmation{
+			Jkt: req.Confirmation.Jkt,
+		}
+	}
 	if req.Scope != nil {
 		session.Scope = req.Scope.Value
 	}
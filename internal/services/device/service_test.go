@@ -32,6 +32,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/generator"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
@@ -151,6 +152,7 @@ func Test_service_Device(t *testing.T) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
 					ClientId:   "s6BhdRkqt3",
 					GrantTypes: []string{oidc.GrantTypeAuthorizationCode},
+					Status:     corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
@@ -158,6 +160,27 @@ func Test_service_Device(t *testing.T) {
 				Error: rfcerrors.UnsupportedGrantType().Build(),
 			},
 		},
+		{
+			name: "grant type not allowed for application_type",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.DeviceAuthorizationRequest{
+					ClientId: "s6BhdRkqt3",
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockDeviceCodeSession) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:        "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.DeviceAuthorizationResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
 		{
 			name: "device code session registration error",
 			args: args{
@@ -168,8 +191,10 @@ func Test_service_Device(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					ClientId:   "s6BhdRkqt3",
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				deviceCodes.EXPECT().Register(gomock.Any(), gomock.Any()).Return("", "", uint64(60), fmt.Errorf("foo"))
 			},
@@ -192,8 +217,10 @@ func Test_service_Device(t *testing.T) {
 			},
 			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					ClientId:   "s6BhdRkqt3",
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				deviceCodes.EXPECT().Register(gomock.Any(), gomock.Any()).Return("GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", "WDJB-MJHT", uint64(120), nil)
 			},
@@ -205,6 +232,39 @@ func Test_service_Device(t *testing.T) {
 				Interval:   5,
 			},
 		},
+		{
+			name: "valid with dpop proof",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.DeviceAuthorizationRequest{
+					ClientId: "s6BhdRkqt3",
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, deviceCodes *storagemock.MockDeviceCodeSession) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:        "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				deviceCodes.EXPECT().Register(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, session *corev1.DeviceCodeSession) (string, string, uint64, error) {
+					if session.Confirmation == nil || session.Confirmation.Jkt != "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I" {
+						return "", "", 0, fmt.Errorf("device code session is not bound")
+					}
+					return "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS", "WDJB-MJHT", uint64(120), nil
+				})
+			},
+			wantErr: false,
+			want: &corev1.DeviceAuthorizationResponse{
+				DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+				UserCode:   "WDJB-MJHT",
+				ExpiresIn:  120,
+				Interval:   5,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -221,7 +281,7 @@ func Test_service_Device(t *testing.T) {
 			}
 
 			// Prepare service
-			underTest := New(clients, deviceCodeSessions)
+			underTest := New(clients, deviceCodeSessions, profile.Strict())
 
 			// Do the request
 			got, err := underTest.Authorize(tt.args.ctx, tt.args.req)
@@ -46,7 +46,7 @@ func (s *service) generateAccessToken(ctx context.Context, client *corev1.Client
 			Subject:   meta.Subject,
 			ClientId:  client.ClientId,
 			IssuedAt:  uint64(now.Unix()),
-			ExpiresAt: uint64(now.Add(1 * time.Hour).Unix()),
+			ExpiresAt: uint64(now.Add(s.serverProfile.AccessTokenLifetime()).Unix()),
 			Scope:     meta.Scope,
 			Audience:  meta.Audience,
 		},
@@ -87,7 +87,7 @@ func (s *service) generateRefreshToken(ctx context.Context, client *corev1.Clien
 			Subject:   meta.Subject,
 			ClientId:  client.ClientId,
 			IssuedAt:  uint64(now.Unix()),
-			ExpiresAt: uint64(now.AddDate(0, 0, 7).Unix()),
+			ExpiresAt: uint64(now.Add(s.serverProfile.RefreshTokenLifetime()).Unix()),
 			Scope:     meta.Scope,
 			Audience:  meta.Audience,
 		},
@@ -29,6 +29,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 )
 
@@ -74,6 +75,10 @@ func (s *service) authorizationCode(ctx context.Context, client *corev1.Client,
 		res.Error = rfcerrors.UnsupportedGrantType().Build()
 		return res, fmt.Errorf("client doesn't support 'authorization_code' as grant type")
 	}
+	if err := profile.CheckGrantType(s.serverProfile, client, oidc.GrantTypeAuthorizationCode); err != nil {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
 
 	// Validate request
 	if grant.Code == "" || grant.CodeVerifier == "" || grant.RedirectUri == "" {
@@ -121,6 +126,12 @@ func (s *service) authorizationCode(ctx context.Context, client *corev1.Client,
 		return res, fmt.Errorf("unable to remove authorization session from code '%s': %w", grant.Code, err)
 	}
 
+	// Check code expiration
+	if ar.ExpiresAt > 0 && ar.ExpiresAt < uint64(timeFunc().Unix()) {
+		res.Error = rfcerrors.InvalidGrant().State(ar.Request.State).Build()
+		return res, fmt.Errorf("authorization code '%s' is expired", grant.Code)
+	}
+
 	// Validate redirectUri
 	if ar.Request.RedirectUri != grant.RedirectUri {
 		res.Error = rfcerrors.InvalidGrant().State(ar.Request.State).Build()
@@ -131,6 +142,12 @@ func (s *service) authorizationCode(ctx context.Context, client *corev1.Client,
 		return res, fmt.Errorf("invalid authorization request: request_uri from request '%s' and client '%s' must be validated", grant.RedirectUri, client.RedirectUris)
 	}
 
+	// Check DPoP key binding
+	if errBinding := checkKeyBinding(ar.Confirmation, req.TokenConfirmation); errBinding != nil {
+		res.Error = rfcerrors.InvalidGrant().State(ar.Request.State).Build()
+		return res, fmt.Errorf("invalid authorization request: %w", errBinding)
+	}
+
 	// Check PKCE verifier
 	// https://www.rfc-editor.org/rfc/rfc7636.txt
 	switch ar.Request.CodeChallengeMethod {
@@ -151,6 +168,14 @@ func (s *service) authorizationCode(ctx context.Context, client *corev1.Client,
 
 	// Generate OpenID tokens (AT / RT / IDT)
 	if scopes.Contains(oidc.ScopeOpenID) {
+		// Refresh tokens issued to public clients must be sender-constrained
+		if scopes.Contains(oidc.ScopeOfflineAccess) {
+			if err := checkRefreshTokenBinding(client, req.TokenConfirmation); err != nil {
+				res.Error = rfcerrors.InvalidRequest().State(ar.Request.State).Build()
+				return res, err
+			}
+		}
+
 		// Generate access token
 		at, err := s.generateAccessToken(ctx, client, &corev1.TokenMeta{
 			Issuer:   req.Issuer,
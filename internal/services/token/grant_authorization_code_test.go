@@ -31,6 +31,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	generatormock "zntr.io/solid/pkg/sdk/generator/mock"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
@@ -130,9 +131,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeClientCredentials},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -158,9 +160,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -186,9 +189,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -215,9 +219,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -243,9 +248,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -272,9 +278,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -301,9 +308,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -329,9 +337,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -361,9 +370,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -393,9 +403,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -427,9 +438,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -466,14 +478,62 @@ func Test_service_authorizationCode(t *testing.T) {
 				Error: rfcerrors.ServerError().Build(),
 			},
 		},
+		{
+			name: "expired authorization code",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeAuthorizationCode,
+					Grant: &corev1.TokenRequest_AuthorizationCode{
+						AuthorizationCode: &corev1.GrantAuthorizationCode{
+							Code:         "1234567891234567890",
+							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+							RedirectUri:  "https://client.example.org/cb",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *generatormock.MockToken) {
+				timeFunc = func() time.Time { return time.Unix(61, 0) }
+				sessions.EXPECT().Get(gomock.Any(), "1234567891234567890").Return(&corev1.AuthorizationCodeSession{
+					ExpiresAt: 60,
+					Request: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email",
+						ClientId:            "s6BhdRkqt3",
+						State:               "af0ifjsldkj",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+						CodeChallengeMethod: "S256",
+					},
+				}, nil)
+				sessions.EXPECT().Delete(gomock.Any(), "1234567891234567890").Return(nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().State("af0ifjsldkj").Build(),
+			},
+		},
 		{
 			name: "redirect_uri mismatch",
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -515,9 +575,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -559,9 +620,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -603,9 +665,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -642,15 +705,163 @@ func Test_service_authorizationCode(t *testing.T) {
 				Error: rfcerrors.InvalidGrant().State("af0ifjsldkj").Build(),
 			},
 		},
+		{
+			name: "public client unbound offline access",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					ClientType:      corev1.ClientType_CLIENT_TYPE_PUBLIC,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeAuthorizationCode,
+					Grant: &corev1.TokenRequest_AuthorizationCode{
+						AuthorizationCode: &corev1.GrantAuthorizationCode{
+							Code:         "1234567891234567890",
+							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+							RedirectUri:  "https://client.example.org/cb",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *generatormock.MockToken) {
+				sessions.EXPECT().Get(gomock.Any(), "1234567891234567890").Return(&corev1.AuthorizationCodeSession{
+					Request: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email offline_access",
+						ClientId:            "s6BhdRkqt3",
+						State:               "af0ifjsldkj",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+						CodeChallengeMethod: "S256",
+					},
+				}, nil)
+				sessions.EXPECT().Delete(gomock.Any(), "1234567891234567890").Return(nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().State("af0ifjsldkj").Build(),
+			},
+		},
+		{
+			name: "dpop bound code without proof",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeAuthorizationCode,
+					Grant: &corev1.TokenRequest_AuthorizationCode{
+						AuthorizationCode: &corev1.GrantAuthorizationCode{
+							Code:         "1234567891234567890",
+							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+							RedirectUri:  "https://client.example.org/cb",
+						},
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *generatormock.MockToken) {
+				sessions.EXPECT().Get(gomock.Any(), "1234567891234567890").Return(&corev1.AuthorizationCodeSession{
+					Request: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email",
+						ClientId:            "s6BhdRkqt3",
+						State:               "af0ifjsldkj",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+						CodeChallengeMethod: "S256",
+						DpopJkt:             "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+				sessions.EXPECT().Delete(gomock.Any(), "1234567891234567890").Return(nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().State("af0ifjsldkj").Build(),
+			},
+		},
+		{
+			name: "dpop bound code with another key",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeAuthorizationCode,
+					Grant: &corev1.TokenRequest_AuthorizationCode{
+						AuthorizationCode: &corev1.GrantAuthorizationCode{
+							Code:         "1234567891234567890",
+							CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+							RedirectUri:  "https://client.example.org/cb",
+						},
+					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						Jkt: "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs",
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockAuthorizationCodeSession, tokens *storagemock.MockToken, at *generatormock.MockToken) {
+				sessions.EXPECT().Get(gomock.Any(), "1234567891234567890").Return(&corev1.AuthorizationCodeSession{
+					Request: &corev1.AuthorizationRequest{
+						Audience:            "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						ResponseType:        "code",
+						Scope:               "openid profile email",
+						ClientId:            "s6BhdRkqt3",
+						State:               "af0ifjsldkj",
+						RedirectUri:         "https://client.example.org/cb",
+						CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+						CodeChallengeMethod: "S256",
+						DpopJkt:             "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+				sessions.EXPECT().Delete(gomock.Any(), "1234567891234567890").Return(nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().State("af0ifjsldkj").Build(),
+			},
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "openid: generate access token error",
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -693,9 +904,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -738,9 +950,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -784,9 +997,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -831,9 +1045,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -878,9 +1093,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -927,9 +1143,10 @@ func Test_service_authorizationCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:    []string{oidc.GrantTypeAuthorizationCode},
-					ResponseTypes: []string{"code"},
-					RedirectUris:  []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					ResponseTypes:   []string{"code"},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -1017,6 +1234,7 @@ func Test_service_authorizationCode(t *testing.T) {
 				tokenGen:                  accessTokens,
 				idGen:                     idTokens,
 				tokens:                    tokens,
+				serverProfile:             profile.Strict(),
 			}
 			got, err := s.authorizationCode(tt.args.ctx, tt.args.client, tt.args.req)
 			if (err != nil) != tt.wantErr {
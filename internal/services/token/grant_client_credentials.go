@@ -26,6 +26,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/profile"
 )
 
 func (s *service) clientCredentials(ctx context.Context, client *corev1.Client, req *corev1.TokenRequest) (*corev1.TokenResponse, error) {
@@ -63,6 +64,10 @@ func (s *service) clientCredentials(ctx context.Context, client *corev1.Client,
 		res.Error = rfcerrors.UnsupportedGrantType().Build()
 		return res, fmt.Errorf("client doesn't support 'client_credentials' as grant type")
 	}
+	if err := profile.CheckGrantType(s.serverProfile, client, oidc.GrantTypeClientCredentials); err != nil {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
 
 	// Generate access token
 	at, err := s.generateAccessToken(ctx, client, &corev1.TokenMeta{
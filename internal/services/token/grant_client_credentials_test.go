@@ -30,6 +30,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	generatormock "zntr.io/solid/pkg/sdk/generator/mock"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
 
@@ -124,8 +125,9 @@ func Test_service_clientCredentials(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes:   []string{oidc.GrantTypeAuthorizationCode},
-					RedirectUris: []string{"https://client.example.org/cb"},
+					ApplicationType: oidc.ApplicationTypeService,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
+					RedirectUris:    []string{"https://client.example.org/cb"},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -143,13 +145,38 @@ func Test_service_clientCredentials(t *testing.T) {
 				Error: rfcerrors.UnsupportedGrantType().Build(),
 			},
 		},
+		{
+			name: "grant_type not allowed for application_type",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeServerSideWeb,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &corev1.TokenRequest_ClientCredentials{
+						ClientCredentials: &corev1.GrantClientCredentials{},
+					},
+				},
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.UnauthorizedClient().Build(),
+			},
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "openid: access token generation error",
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					ApplicationType: oidc.ApplicationTypeService,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -175,7 +202,8 @@ func Test_service_clientCredentials(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					ApplicationType: oidc.ApplicationTypeService,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -201,7 +229,8 @@ func Test_service_clientCredentials(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					ApplicationType: oidc.ApplicationTypeService,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -229,7 +258,8 @@ func Test_service_clientCredentials(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					ApplicationType: oidc.ApplicationTypeService,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -279,9 +309,10 @@ func Test_service_clientCredentials(t *testing.T) {
 			}
 
 			s := &service{
-				tokens:   tokens,
-				tokenGen: accessTokens,
-				idGen:    idTokens,
+				tokens:        tokens,
+				tokenGen:      accessTokens,
+				idGen:         idTokens,
+				serverProfile: profile.Strict(),
 			}
 			got, err := s.clientCredentials(tt.args.ctx, tt.args.client, tt.args.req)
 			if (err != nil) != tt.wantErr {
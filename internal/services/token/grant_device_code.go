@@ -27,6 +27,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 )
 
@@ -66,6 +67,10 @@ func (s *service) deviceCode(ctx context.Context, client *corev1.Client, req *co
 		res.Error = rfcerrors.UnsupportedGrantType().Build()
 		return res, fmt.Errorf("client doesn't support '%s' as grant type", oidc.GrantTypeDeviceCode)
 	}
+	if err := profile.CheckGrantType(s.serverProfile, client, oidc.GrantTypeDeviceCode); err != nil {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
 
 	// Validate device_code
 	if grant.DeviceCode == "" {
@@ -104,6 +109,12 @@ func (s *service) deviceCode(ctx context.Context, client *corev1.Client, req *co
 		return res, fmt.Errorf("client does not match")
 	}
 
+	// Check DPoP key binding
+	if errBinding := checkKeyBinding(session.Confirmation, req.TokenConfirmation); errBinding != nil {
+		res.Error = rfcerrors.InvalidGrant().Build()
+		return res, fmt.Errorf("invalid device code request: %w", errBinding)
+	}
+
 	// Check expiration
 	if session.ExpiresAt < uint64(timeFunc().Unix()) {
 		res.Error = rfcerrors.TokenExpired().Build()
@@ -128,6 +139,17 @@ func (s *service) deviceCode(ctx context.Context, client *corev1.Client, req *co
 		return res, fmt.Errorf("session has no subject for '%s'", grant.DeviceCode)
 	}
 
+	// Validate scopes
+	scopes := types.StringArray(strings.Fields(session.Scope))
+
+	// Refresh tokens issued to public clients must be sender-constrained
+	if scopes.Contains(oidc.ScopeOfflineAccess) {
+		if err := checkRefreshTokenBinding(client, req.TokenConfirmation); err != nil {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, err
+		}
+	}
+
 	// Generate access token
 	at, err := s.generateAccessToken(ctx, client, &corev1.TokenMeta{
 		Issuer:   req.Issuer,
@@ -143,9 +165,6 @@ func (s *service) deviceCode(ctx context.Context, client *corev1.Client, req *co
 	// Assign response
 	res.AccessToken = at
 
-	// Validate scopes
-	scopes := types.StringArray(strings.Fields(session.Scope))
-
 	// Check if request has offline_access to generate refresh_token
 	if scopes.Contains(oidc.ScopeOfflineAccess) {
 		// Generate refresh token
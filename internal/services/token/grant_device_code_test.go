@@ -31,6 +31,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	generatormock "zntr.io/solid/pkg/sdk/generator/mock"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
@@ -130,7 +131,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeAuthorizationCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -153,7 +155,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -179,7 +182,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -208,7 +212,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -237,7 +242,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -266,7 +272,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -295,7 +302,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -326,7 +334,8 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -362,8 +371,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -398,8 +408,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -430,13 +441,59 @@ func Test_service_deviceCode(t *testing.T) {
 				Error: rfcerrors.AuthorizationPending().Build(),
 			},
 		},
+		{
+			name: "dpop bound device code with another key",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
+				},
+				req: &corev1.TokenRequest{
+					Issuer:    "http://127.0.0.1:8080",
+					GrantType: oidc.GrantTypeDeviceCode,
+					Grant: &corev1.TokenRequest_DeviceCode{
+						DeviceCode: &corev1.GrantDeviceCode{
+							ClientId:   "s6BhdRkqt3",
+							DeviceCode: "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS",
+						},
+					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						Jkt: "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs",
+					},
+				},
+			},
+			prepare: func(sessions *storagemock.MockDeviceCodeSession, _ *storagemock.MockToken, _ *generatormock.MockToken) {
+				timeFunc = func() time.Time { return time.Unix(10, 0) }
+				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&corev1.DeviceCodeSession{
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					Request: &corev1.DeviceAuthorizationRequest{
+						ClientId: "s6BhdRkqt3",
+					},
+					ExpiresAt: 200,
+					Status:    corev1.DeviceCodeStatus_DEVICE_CODE_STATUS_VALIDATED,
+					Subject:   "foo",
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
 		{
 			name: "session invalid status",
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -472,8 +529,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -510,8 +568,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -549,8 +608,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -589,8 +649,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -639,8 +700,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -691,8 +753,9 @@ func Test_service_deviceCode(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
-					ClientId:   "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
 				},
 				req: &corev1.TokenRequest{
 					Issuer:    "http://127.0.0.1:8080",
@@ -781,6 +844,7 @@ func Test_service_deviceCode(t *testing.T) {
 				deviceCodeSessions: sessions,
 				tokens:             tokens,
 				tokenGen:           accessTokens,
+				serverProfile:      profile.Strict(),
 			}
 			got, err := s.deviceCode(tt.args.ctx, tt.args.client, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -26,6 +26,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
 	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 )
 
@@ -70,6 +71,10 @@ func (s *service) refreshToken(ctx context.Context, client *corev1.Client, req *
 		res.Error = rfcerrors.UnsupportedGrantType().Build()
 		return res, fmt.Errorf("client doesn't support 'refresh_token' as grant type")
 	}
+	if err := profile.CheckGrantType(s.serverProfile, client, oidc.GrantTypeRefreshToken); err != nil {
+		res.Error = rfcerrors.UnauthorizedClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
 
 	// Check given token
 	rt, err := s.tokens.GetByValue(ctx, grant.RefreshToken)
@@ -108,6 +113,28 @@ func (s *service) refreshToken(ctx context.Context, client *corev1.Client, req *
 		return res, fmt.Errorf("only requestor client must use the refresh_token")
 	}
 
+	// Check certificate binding
+	// https://tools.ietf.org/html/rfc8705#section-3
+	if rt.Confirmation != nil && rt.Confirmation.X5TS256 != "" {
+		if req.TokenConfirmation == nil || !types.SecureCompareString(req.TokenConfirmation.X5TS256, rt.Confirmation.X5TS256) {
+			res.Error = rfcerrors.InvalidGrant().Build()
+			return res, fmt.Errorf("refresh_token is bound to another client certificate")
+		}
+	}
+
+	// Check public client key binding
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5
+	if client.ClientType == corev1.ClientType_CLIENT_TYPE_PUBLIC {
+		if err := checkRefreshTokenBinding(client, rt.Confirmation); err != nil {
+			res.Error = rfcerrors.InvalidGrant().Build()
+			return res, err
+		}
+		if err := checkKeyBinding(rt.Confirmation, req.TokenConfirmation); err != nil {
+			res.Error = rfcerrors.InvalidGrant().Build()
+			return res, fmt.Errorf("unable to validate refresh_token key binding: %w", err)
+		}
+	}
+
 	// Generate access token
 	at, err := s.generateAccessToken(ctx, client, rt.Metadata, rt.Confirmation)
 	if err != nil {
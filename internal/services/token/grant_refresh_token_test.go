@@ -30,6 +30,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	generatormock "zntr.io/solid/pkg/sdk/generator/mock"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
@@ -154,7 +155,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeAuthorizationCode},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeAuthorizationCode},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -179,7 +181,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -207,7 +210,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -235,7 +239,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -268,7 +273,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -301,7 +307,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -334,7 +341,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -375,7 +383,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -418,7 +427,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -460,7 +470,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -502,7 +513,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -545,7 +557,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -590,7 +603,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -631,13 +645,157 @@ func Test_service_refreshToken(t *testing.T) {
 				Error: rfcerrors.ServerError().Build(),
 			},
 		},
+		{
+			name: "certificate mismatch",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &corev1.TokenRequest_RefreshToken{
+						RefreshToken: &corev1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						X5TS256: "A4DtL2JmUMhAsvJj5tKyn64SqzmuXbMrJa0n761y5v0",
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *generatormock.MockToken) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&corev1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: corev1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &corev1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						ExpiresAt: 604801,
+					},
+					Confirmation: &corev1.TokenConfirmation{
+						X5TS256: "bwcK0esc3ACC3DB2Y5_lESsXE8o9ltc05O89jdN-dg2",
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "public client with unbound refresh token",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeNative,
+					ClientType:      corev1.ClientType_CLIENT_TYPE_PUBLIC,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &corev1.TokenRequest_RefreshToken{
+						RefreshToken: &corev1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *generatormock.MockToken) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&corev1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: corev1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &corev1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						ExpiresAt: 604801,
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
+		{
+			name: "public client with refresh token bound to another key",
+			args: args{
+				ctx: context.Background(),
+				client: &corev1.Client{
+					ApplicationType: oidc.ApplicationTypeNative,
+					ClientType:      corev1.ClientType_CLIENT_TYPE_PUBLIC,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
+				},
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeRefreshToken,
+					Grant: &corev1.TokenRequest_RefreshToken{
+						RefreshToken: &corev1.GrantRefreshToken{
+							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+						},
+					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+			prepare: func(tokens *storagemock.MockToken, at *generatormock.MockToken) {
+				timeFunc = func() time.Time { return time.Unix(1, 0) }
+				tokens.EXPECT().GetByValue(gomock.Any(), "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&corev1.Token{
+					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
+					TokenId:   "0123456789",
+					TokenType: corev1.TokenType_TOKEN_TYPE_REFRESH_TOKEN,
+					Status:    corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+					Metadata: &corev1.TokenMeta{
+						Issuer:    "http://127.0.0.1:8080",
+						Audience:  "mDuGcLjmamjNpLmYZMLIshFcXUDCNDcH",
+						Scope:     "openid profile email offline_access",
+						IssuedAt:  1,
+						ExpiresAt: 604801,
+					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs",
+					},
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidGrant().Build(),
+			},
+		},
 		// ---------------------------------------------------------------------
 		{
 			name: "valid",
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -692,7 +850,8 @@ func Test_service_refreshToken(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				client: &corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
 				},
 				req: &corev1.TokenRequest{
 					Issuer: "http://127.0.0.1:8080",
@@ -774,8 +933,9 @@ func Test_service_refreshToken(t *testing.T) {
 			}
 
 			s := &service{
-				tokens:   tokens,
-				tokenGen: accessTokens,
+				tokens:        tokens,
+				tokenGen:      accessTokens,
+				serverProfile: profile.Strict(),
 			}
 			got, err := s.refreshToken(tt.args.ctx, tt.args.client, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -44,7 +44,7 @@ func (s *service) Introspect(ctx context.Context, req *corev1.TokenIntrospection
 	}
 
 	// Retrieve client information
-	_, err := s.clients.Get(ctx, req.Client.ClientId)
+	client, err := s.clients.Get(ctx, req.Client.ClientId)
 	if err != nil {
 		if err != storage.ErrNotFound {
 			res.Error = rfcerrors.ServerError().Build()
@@ -53,6 +53,10 @@ func (s *service) Introspect(ctx context.Context, req *corev1.TokenIntrospection
 		}
 		return res, fmt.Errorf("unable to retrieve client details: %w", err)
 	}
+	if storage.ClientStatus(client) != corev1.ClientStatus_CLIENT_STATUS_ACTIVE {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client '%s' is not active", client.ClientId)
+	}
 
 	// Retrieve token by value
 	t, err := s.tokens.GetByValue(ctx, req.Token)
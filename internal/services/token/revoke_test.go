@@ -29,6 +29,7 @@ import (
 	"zntr.io/solid/api/oidc"
 	generatormock "zntr.io/solid/pkg/sdk/generator/mock"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
@@ -147,6 +148,7 @@ func Test_service_Revoke(t *testing.T) {
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					Status:     corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, storage.ErrNotFound)
 			},
@@ -167,6 +169,7 @@ func Test_service_Revoke(t *testing.T) {
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					Status:     corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(nil, fmt.Errorf("foo"))
 			},
@@ -185,7 +188,7 @@ func Test_service_Revoke(t *testing.T) {
 				},
 			},
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
-				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{}, nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{Status: corev1.ClientStatus_CLIENT_STATUS_ACTIVE}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&corev1.Token{
 					Status:  corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
 					TokenId: "123456789",
@@ -209,7 +212,7 @@ func Test_service_Revoke(t *testing.T) {
 				},
 			},
 			prepare: func(clients *storagemock.MockClientReader, tokens *storagemock.MockToken) {
-				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{}, nil)
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{Status: corev1.ClientStatus_CLIENT_STATUS_ACTIVE}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo").Return(&corev1.Token{
 					Status:  corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
 					TokenId: "123456789",
@@ -241,7 +244,7 @@ func Test_service_Revoke(t *testing.T) {
 			}
 
 			// instantiate service
-			underTest := New(accessTokens, idTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens)
+			underTest := New(accessTokens, idTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, profile.Strict())
 
 			got, err := underTest.Revoke(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
@@ -26,6 +26,7 @@ import (
 	"zntr.io/solid/internal/services"
 	"zntr.io/solid/pkg/sdk/generator"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 )
 
@@ -37,10 +38,11 @@ type service struct {
 	authorizationCodeSessions storage.AuthorizationCodeSession
 	deviceCodeSessions        storage.DeviceCodeSession
 	tokens                    storage.Token
+	serverProfile             profile.Server
 }
 
 // New build and returns an authorization service implementation.
-func New(tokenGen generator.Token, idGen generator.Identity, clients storage.ClientReader, authorizationRequests storage.AuthorizationRequestReader, authorizationCodeSessions storage.AuthorizationCodeSession, deviceCodeSessions storage.DeviceCodeSession, tokens storage.Token) services.Token {
+func New(tokenGen generator.Token, idGen generator.Identity, clients storage.ClientReader, authorizationRequests storage.AuthorizationRequestReader, authorizationCodeSessions storage.AuthorizationCodeSession, deviceCodeSessions storage.DeviceCodeSession, tokens storage.Token, serverProfile profile.Server) services.Token {
 	return &service{
 		tokenGen:                  tokenGen,
 		idGen:                     idGen,
@@ -49,6 +51,7 @@ func New(tokenGen generator.Token, idGen generator.Identity, clients storage.Cli
 		authorizationCodeSessions: authorizationCodeSessions,
 		deviceCodeSessions:        deviceCodeSessions,
 		tokens:                    tokens,
+		serverProfile:             serverProfile,
 	}
 }
 
@@ -73,6 +76,22 @@ func (s *service) Token(ctx context.Context, req *corev1.TokenRequest) (*corev1.
 		}
 		return res, fmt.Errorf("unable to retrieve client details: %w", err)
 	}
+	if storage.ClientStatus(client) != corev1.ClientStatus_CLIENT_STATUS_ACTIVE {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client '%s' is not active", client.ClientId)
+	}
+
+	// Check client against server profile
+	if publicErr, err := s.checkServerProfile(client, req.TokenConfirmation); err != nil {
+		res.Error = publicErr
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
+
+	// Check sender-constrained access token requirements
+	if publicErr, err := checkClientConfirmation(client, req.TokenConfirmation); err != nil {
+		res.Error = publicErr
+		return res, fmt.Errorf("unable to validate token confirmation: %w", err)
+	}
 
 	// Dispatch request according to grant_type
 	switch req.GrantType {
@@ -33,11 +33,12 @@ import (
 	"zntr.io/solid/pkg/sdk/generator"
 	generatormock "zntr.io/solid/pkg/sdk/generator/mock"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
 
-var cmpOpts = []cmp.Option{cmpopts.IgnoreFields(corev1.Token{}, "TokenId"), cmpopts.IgnoreUnexported(wrappers.StringValue{}), cmpopts.IgnoreUnexported(corev1.TokenRequest{}), cmpopts.IgnoreUnexported(corev1.TokenIntrospectionRequest{}), cmpopts.IgnoreUnexported(corev1.TokenRevocationRequest{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_AuthorizationCode{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_ClientCredentials{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_DeviceCode{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_RefreshToken{}), cmpopts.IgnoreUnexported(corev1.TokenResponse{}), cmpopts.IgnoreUnexported(corev1.TokenIntrospectionResponse{}), cmpopts.IgnoreUnexported(corev1.TokenRevocationResponse{}), cmpopts.IgnoreUnexported(corev1.Error{}), cmpopts.IgnoreUnexported(corev1.Token{}), cmpopts.IgnoreUnexported(corev1.TokenMeta{}), cmpopts.IgnoreUnexported(corev1.AuthorizationCodeSession{}), cmpopts.IgnoreUnexported(corev1.DeviceCodeSession{})}
+var cmpOpts = []cmp.Option{cmpopts.IgnoreFields(corev1.Token{}, "TokenId"), cmpopts.IgnoreUnexported(wrappers.StringValue{}), cmpopts.IgnoreUnexported(corev1.TokenRequest{}), cmpopts.IgnoreUnexported(corev1.TokenIntrospectionRequest{}), cmpopts.IgnoreUnexported(corev1.TokenRevocationRequest{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_AuthorizationCode{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_ClientCredentials{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_DeviceCode{}), cmpopts.IgnoreUnexported(corev1.TokenRequest_RefreshToken{}), cmpopts.IgnoreUnexported(corev1.TokenResponse{}), cmpopts.IgnoreUnexported(corev1.TokenIntrospectionResponse{}), cmpopts.IgnoreUnexported(corev1.TokenRevocationResponse{}), cmpopts.IgnoreUnexported(corev1.Error{}), cmpopts.IgnoreUnexported(corev1.Token{}), cmpopts.IgnoreUnexported(corev1.TokenMeta{}), cmpopts.IgnoreUnexported(corev1.TokenConfirmation{}), cmpopts.IgnoreUnexported(corev1.AuthorizationCodeSession{}), cmpopts.IgnoreUnexported(corev1.DeviceCodeSession{})}
 
 func Test_service_Token(t *testing.T) {
 	type fields struct {
@@ -271,6 +272,145 @@ func Test_service_Token(t *testing.T) {
 				Error: rfcerrors.ServerError().Build(),
 			},
 		},
+		{
+			name: "client pending approval",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &corev1.TokenRequest_ClientCredentials{
+						ClientCredentials: &corev1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *generatormock.MockToken, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_PENDING,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "client requires dpop bound access tokens",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &corev1.TokenRequest_ClientCredentials{
+						ClientCredentials: &corev1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *generatormock.MockToken, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:              "s6BhdRkqt3",
+					DpopBoundAccessTokens: true,
+					Status:                corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidDPoPProof().Description("client requires DPoP-bound access tokens.").Build(),
+			},
+		},
+		{
+			name: "client requires certificate bound access tokens",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &corev1.TokenRequest_ClientCredentials{
+						ClientCredentials: &corev1.GrantClientCredentials{},
+					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *generatormock.MockToken, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                              "s6BhdRkqt3",
+					TlsClientCertificateBoundAccessTokens: true,
+					Status:                                corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Description("client requires certificate-bound access tokens.").Build(),
+			},
+		},
+		{
+			name: "client assertion signature algorithm not allowed",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &corev1.TokenRequest_ClientCredentials{
+						ClientCredentials: &corev1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *generatormock.MockToken, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                    "s6BhdRkqt3",
+					TokenEndpointAuthSigningAlg: "HS256",
+					GrantTypes:                  []string{oidc.GrantTypeClientCredentials},
+					Status:                      corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "application_type requires sender-constrained access tokens",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.TokenRequest{
+					Issuer: "http://127.0.0.1:8080",
+					Client: &corev1.Client{
+						ClientId: "s6BhdRkqt3",
+					},
+					GrantType: oidc.GrantTypeClientCredentials,
+					Grant: &corev1.TokenRequest_ClientCredentials{
+						ClientCredentials: &corev1.GrantClientCredentials{},
+					},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, _ *generatormock.MockToken, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:        "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.TokenResponse{
+				Error: rfcerrors.InvalidRequest().Description("sender-constrained access tokens are mandatory for this application_type.").Build(),
+			},
+		},
 		{
 			name: "unknown grant type",
 			args: args{
@@ -293,6 +433,7 @@ func Test_service_Token(t *testing.T) {
 				}
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
 					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					Status:     corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 			},
 			wantErr: true,
@@ -319,7 +460,9 @@ func Test_service_Token(t *testing.T) {
 			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *generatormock.MockToken, _ *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeClientCredentials},
+					ApplicationType: oidc.ApplicationTypeService,
+					GrantTypes:      []string{oidc.GrantTypeClientCredentials},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo", nil)
 				tokens.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
@@ -362,11 +505,13 @@ func Test_service_Token(t *testing.T) {
 			prepare: func(clients *storagemock.MockClientReader, ar *storagemock.MockAuthorizationRequestReader, at *generatormock.MockToken, sessions *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType:  oidc.ApplicationTypeServerSideWeb,
 					GrantTypes:       []string{oidc.GrantTypeAuthorizationCode},
 					ResponseTypes:    []string{"code"},
 					RedirectUris:     []string{"https://client.example.org/cb"},
 					SubjectType:      oidc.SubjectTypePublic,
 					SectorIdentifier: "https://client.example.org",
+					Status:           corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				sessions.EXPECT().Get(gomock.Any(), "1234567891234567890").Return(&corev1.AuthorizationCodeSession{
 					Request: &corev1.AuthorizationRequest{
@@ -437,13 +582,18 @@ func Test_service_Token(t *testing.T) {
 					Scope: &wrappers.StringValue{
 						Value: "openid admin",
 					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
 				},
 			},
 			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *generatormock.MockToken, _ *storagemock.MockAuthorizationCodeSession, sessions *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					ClientId:   "s6BhdRkqt3",
-					GrantTypes: []string{oidc.GrantTypeDeviceCode},
+					ClientId:        "s6BhdRkqt3",
+					ApplicationType: oidc.ApplicationTypeDevice,
+					GrantTypes:      []string{oidc.GrantTypeDeviceCode},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				sessions.EXPECT().GetByDeviceCode(gomock.Any(), "GmRhmhcxhwAzkoEqiMEg_DnyEysNkuNhszIySk9eS").Return(&corev1.DeviceCodeSession{
 					Client: &corev1.Client{
@@ -472,6 +622,9 @@ func Test_service_Token(t *testing.T) {
 						ClientId:  "s6BhdRkqt3",
 						Subject:   "user1",
 					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
 					Value: "cwE.HcbVtkyQCyCUfjxYvjHNODfTbVpSlmyo",
 				},
 			},
@@ -492,12 +645,17 @@ func Test_service_Token(t *testing.T) {
 							RefreshToken: "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
 						},
 					},
+					TokenConfirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
 				},
 			},
 			prepare: func(clients *storagemock.MockClientReader, _ *storagemock.MockAuthorizationRequestReader, at *generatormock.MockToken, sessions *storagemock.MockAuthorizationCodeSession, _ *storagemock.MockDeviceCodeSession, tokens *storagemock.MockToken) {
 				timeFunc = func() time.Time { return time.Unix(1, 0) }
 				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
-					GrantTypes: []string{oidc.GrantTypeRefreshToken},
+					ApplicationType: oidc.ApplicationTypeNative,
+					GrantTypes:      []string{oidc.GrantTypeRefreshToken},
+					Status:          corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
 				}, nil)
 				tokens.EXPECT().GetByValue(gomock.Any(), "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi").Return(&corev1.Token{
 					Value:     "LHT.djeMMoErRAsLuXLlDYZDGdodfVLOduDi",
@@ -511,6 +669,9 @@ func Test_service_Token(t *testing.T) {
 						IssuedAt:  1,
 						ExpiresAt: 604801,
 					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
 				}, nil)
 				at.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("xtU.GvmXVrPVNqSnHjpZbEarIqOPAlfXfQpM", nil)
 				tokens.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
@@ -529,6 +690,9 @@ func Test_service_Token(t *testing.T) {
 						IssuedAt:  1,
 						ExpiresAt: 3601,
 					},
+					Confirmation: &corev1.TokenConfirmation{
+						Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+					},
 				},
 			},
 		},
@@ -553,7 +717,7 @@ func Test_service_Token(t *testing.T) {
 			}
 
 			// instantiate service
-			underTest := New(accessTokens, idTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens)
+			underTest := New(accessTokens, idTokens, clients, authorizationRequests, authorizationCodeSessions, deviceCodeSessions, tokens, profile.Strict())
 
 			// Under test
 			got, err := underTest.Token(tt.args.ctx, tt.args.req)
@@ -19,10 +19,12 @@ package token
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
 )
 
 // ValidateRequest validates token request.
@@ -70,3 +72,80 @@ var validateRequest = func(ctx context.Context, req *corev1.TokenRequest) *corev
 	// Return result
 	return nil
 }
+
+// checkKeyBinding validates that the token request proof key matches the key
+// bound to the grant.
+func checkKeyBinding(bound, presented *corev1.TokenConfirmation) error {
+	// Grant is not bound
+	if bound == nil || bound.Jkt == "" {
+		return nil
+	}
+
+	// Check DPoP proof presence
+	if presented == nil || presented.Jkt == "" {
+		return fmt.Errorf("grant is bound to a DPoP key, a DPoP proof is required")
+	}
+
+	// Compare key thumbprints
+	if !types.SecureCompareString(bound.Jkt, presented.Jkt) {
+		return fmt.Errorf("grant is bound to another DPoP key")
+	}
+
+	// No error
+	return nil
+}
+
+// checkClientConfirmation validates that the token request satisfies the
+// sender-constrained access token requirements of the client.
+func checkClientConfirmation(client *corev1.Client, presented *corev1.TokenConfirmation) (*corev1.Error, error) {
+	// DPoP-bound access tokens
+	// https://www.rfc-editor.org/rfc/rfc9449.html#section-5.2
+	if client.DpopBoundAccessTokens && (presented == nil || presented.Jkt == "") {
+		return rfcerrors.InvalidDPoPProof().Description("client requires DPoP-bound access tokens.").Build(), fmt.Errorf("client '%s' requires a DPoP proof", client.ClientId)
+	}
+
+	// Certificate-bound access tokens
+	// https://tools.ietf.org/html/rfc8705#section-3
+	if client.TlsClientCertificateBoundAccessTokens && (presented == nil || presented.X5TS256 == "") {
+		return rfcerrors.InvalidRequest().Description("client requires certificate-bound access tokens.").Build(), fmt.Errorf("client '%s' requires a client certificate", client.ClientId)
+	}
+
+	// No error
+	return nil, nil
+}
+
+// checkRefreshTokenBinding validates that refresh tokens issued to public
+// clients are sender-constrained.
+func checkRefreshTokenBinding(client *corev1.Client, cnf *corev1.TokenConfirmation) error {
+	// Confidential clients are constrained by their authentication
+	if client.ClientType != corev1.ClientType_CLIENT_TYPE_PUBLIC {
+		return nil
+	}
+
+	// Check confirmation
+	if cnf == nil || (cnf.Jkt == "" && cnf.X5TS256 == "") {
+		return fmt.Errorf("refresh token for public client must be sender-constrained")
+	}
+
+	// No error
+	return nil
+}
+
+// checkServerProfile validates the client registration and the presented
+// confirmation against the server profile requirements.
+func (s *service) checkServerProfile(client *corev1.Client, presented *corev1.TokenConfirmation) (*corev1.Error, error) {
+	// Client assertion signature algorithm
+	if alg := client.TokenEndpointAuthSigningAlg; alg != "" && !s.serverProfile.SigningAlgorithmsSupported().Contains(alg) {
+		return rfcerrors.InvalidClient().Build(), fmt.Errorf("client '%s' uses '%s' signature algorithm which is not allowed by server profile", client.ClientId, alg)
+	}
+
+	// Sender-constrained access tokens
+	if clientSettings, ok := s.serverProfile.ApplicationType(client.ApplicationType); ok && clientSettings.SenderConstrainedAccessTokensRequired() {
+		if presented == nil || (presented.Jkt == "" && presented.X5TS256 == "") {
+			return rfcerrors.InvalidRequest().Description("sender-constrained access tokens are mandatory for this application_type.").Build(), fmt.Errorf("client '%s' must present a DPoP proof or a client certificate", client.ClientId)
+		}
+	}
+
+	// No error
+	return nil, nil
+}
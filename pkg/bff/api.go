@@ -0,0 +1,56 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package bff provides a backend-for-frontend for browser based applications.
+//
+// Browser applications can't keep secrets nor hold sender-constrained
+// credentials safely. The backend-for-frontend acts as a confidential client
+// on their behalf: it performs the pushed authorization request, PKCE and JARM
+// flow server-side, keeps the tokens in an encrypted HTTP-only cookie and
+// proxies API calls with DPoP proofs. The browser only receives a CSRF token
+// to attach to its API calls.
+package bff
+
+import (
+	"net/http"
+)
+
+const (
+	// CSRFHeader defines the request header name used to transmit the CSRF
+	// token issued by the session endpoint.
+	CSRFHeader = "X-CSRF-Token"
+)
+
+// BackendForFrontend describes backend-for-frontend contract.
+//
+// The handler itself serves all endpoints using the configured paths.
+type BackendForFrontend interface {
+	http.Handler
+
+	// Login starts the authorization flow and redirects the user-agent to the
+	// authorization server.
+	Login() http.Handler
+	// Callback handles the authorization response and stores the tokens in
+	// the session.
+	Callback() http.Handler
+	// Session returns the authentication status and the CSRF token.
+	Session() http.Handler
+	// Proxy forwards API calls to the upstream resource server.
+	Proxy() http.Handler
+	// Logout destroys the session and revokes the access token.
+	Logout() http.Handler
+}
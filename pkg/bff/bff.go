@@ -0,0 +1,533 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bff
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/kr/session"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/client"
+	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/jarm"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+var (
+	// ErrNoSessionKeys is raised when no session encryption key is configured.
+	ErrNoSessionKeys = errors.New("bff: at least one session key is required")
+	// ErrInsecureHostCookie is raised when a `__Host-` prefixed cookie is
+	// configured without the Secure attribute.
+	ErrInsecureHostCookie = errors.New("bff: __Host- prefixed cookie requires the Secure attribute")
+)
+
+var timeFunc = time.Now
+
+// forwardedHeaders lists the request headers sent to the upstream API.
+var forwardedHeaders = []string{"Accept", "Accept-Language", "Content-Type"}
+
+// droppedHeaders lists the upstream response headers not sent to the browser.
+var droppedHeaders = types.StringArray{"Connection", "Transfer-Encoding", "Set-Cookie", "DPoP-Nonce"}
+
+type service struct {
+	client          client.Client
+	prover          dpop.Prover
+	responseDecoder jarm.ResponseDecoder
+	upstream        *url.URL
+	cookie          *session.Config
+	opts            *options
+	mux             *http.ServeMux
+
+	nonceMutex sync.RWMutex
+	nonce      string
+}
+
+type sessionObject struct {
+	// Pending authorization request
+	State        string `json:"state,omitempty"`
+	CodeVerifier string `json:"code_verifier,omitempty"`
+
+	// Authenticated session, refresh tokens are not kept so that the session
+	// ends with the access token.
+	AccessToken string `json:"access_token,omitempty"`
+	ExpiresAt   int64  `json:"expires_at,omitempty"`
+	CSRFToken   string `json:"csrf_token,omitempty"`
+}
+
+type sessionInfo struct {
+	Authenticated bool   `json:"authenticated"`
+	CSRFToken     string `json:"csrf_token,omitempty"`
+	ExpiresAt     int64  `json:"expires_at,omitempty"`
+}
+
+// New returns a backend-for-frontend instance using the given client to
+// interact with the authorization server and forwarding API calls to the
+// upstream resource server.
+//
+// The client redirect URI must target the callback endpoint. API calls are
+// signed with the client DPoP prover, so that proofs match the key access
+// tokens are bound to.
+func New(solidClient client.Client, responseDecoder jarm.ResponseDecoder, upstream *url.URL, opts ...Option) (BackendForFrontend, error) {
+	// Check arguments
+	if types.IsNil(solidClient) {
+		return nil, errors.New("unable to initialize bff with nil client")
+	}
+	prover := solidClient.Prover()
+	if types.IsNil(prover) {
+		return nil, errors.New("unable to initialize bff with a client without prover")
+	}
+	if types.IsNil(responseDecoder) {
+		return nil, errors.New("unable to initialize bff with nil response decoder")
+	}
+	if upstream == nil || upstream.Scheme == "" || upstream.Host == "" {
+		return nil, errors.New("unable to initialize bff with an invalid upstream url")
+	}
+
+	// Default options
+	defaultOptions := &options{
+		cookieName:         DefaultCookieName,
+		sessionMaxAge:      DefaultSessionMaxAge,
+		httpClient:         http.DefaultClient,
+		pathPrefix:         "/bff",
+		postLoginRedirect:  "/",
+		postLogoutRedirect: "/",
+		maxBodySize:        DefaultMaxBodySize,
+	}
+
+	// Apply param functions
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	// Check options
+	if len(defaultOptions.sessionKeys) == 0 {
+		return nil, ErrNoSessionKeys
+	}
+	if defaultOptions.insecureCookie && strings.HasPrefix(defaultOptions.cookieName, "__Host-") {
+		return nil, ErrInsecureHostCookie
+	}
+
+	s := &service{
+		client:          solidClient,
+		prover:          prover,
+		responseDecoder: responseDecoder,
+		upstream:        upstream,
+		opts:            defaultOptions,
+		cookie: &session.Config{
+			Name:     defaultOptions.cookieName,
+			Path:     "/",
+			Domain:   defaultOptions.cookieDomain,
+			Secure:   !defaultOptions.insecureCookie,
+			HTTPOnly: true,
+			// Lax is required to receive the cookie on the authorization
+			// server redirection.
+			SameSite: http.SameSiteLaxMode,
+			MaxAge:   defaultOptions.sessionMaxAge,
+			Keys:     defaultOptions.sessionKeys,
+		},
+	}
+
+	// Register endpoints
+	prefix := strings.TrimSuffix(defaultOptions.pathPrefix, "/")
+	s.mux = http.NewServeMux()
+	s.mux.Handle(prefix+"/login", s.Login())
+	s.mux.Handle(prefix+"/callback", s.Callback())
+	s.mux.Handle(prefix+"/session", s.Session())
+	s.mux.Handle(prefix+"/logout", s.Logout())
+	s.mux.Handle(prefix+"/api/", http.StripPrefix(prefix+"/api", s.Proxy()))
+
+	// No error
+	return s, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *service) Login() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// Check method
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Prepare client assertion
+		assertion, err := s.client.Assertion()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
+
+		// Generate state
+		state := uniuri.NewLen(32)
+
+		// Push the authorization request
+		res, err := s.client.CreateRequestURI(ctx, assertion, state)
+		if err != nil {
+			writeError(w, http.StatusBadGateway, rfcerrors.ServerError().Description("unable to push the authorization request.").Build())
+			return
+		}
+
+		// Generate authentication url
+		authURL, err := s.client.AuthenticationURL(ctx, res.RequestURI)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
+
+		// Save pending request in session
+		if err := session.Set(w, &sessionObject{
+			State:        state,
+			CodeVerifier: res.CodeVerifier,
+		}, s.cookie); err != nil {
+			writeError(w, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
+
+		// Redirect to authentication URL
+		http.Redirect(w, r, authURL, http.StatusFound)
+	})
+}
+
+func (s *service) Callback() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// Retrieve pending request
+		var sess sessionObject
+		if err := session.Get(r, &sess, s.cookie); err != nil || sess.State == "" || sess.CodeVerifier == "" {
+			writeError(w, http.StatusBadRequest, rfcerrors.InvalidRequest().Description("no pending authorization request.").Build())
+			return
+		}
+
+		// Decode response
+		response, err := s.responseDecoder.Decode(ctx, s.client.ClientID(), r.FormValue("response"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, rfcerrors.InvalidRequest().Description("unable to decode authorization response.").Build())
+			return
+		}
+
+		// Check issuer
+		if response.Issuer != s.client.Issuer() {
+			writeError(w, http.StatusBadRequest, rfcerrors.InvalidRequest().Description("issuer doesn't match.").Build())
+			return
+		}
+
+		// Check state
+		if subtle.ConstantTimeCompare([]byte(sess.State), []byte(response.State)) != 1 {
+			writeError(w, http.StatusBadRequest, rfcerrors.InvalidRequest().Description("state doesn't match.").Build())
+			return
+		}
+
+		// Check authorization error
+		if response.Error != nil {
+			writeError(w, http.StatusForbidden, response.Error)
+			return
+		}
+
+		// Prepare client assertion
+		assertion, err := s.client.Assertion()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
+
+		// Exchange code with token
+		t, err := s.client.ExchangeCode(ctx, assertion, response.Code, sess.CodeVerifier)
+		if err != nil || t == nil || t.AccessToken == "" {
+			writeError(w, http.StatusBadGateway, rfcerrors.ServerError().Description("unable to exchange authorization code.").Build())
+			return
+		}
+
+		// Compute expiration
+		expiresAt := timeFunc().Add(s.opts.sessionMaxAge)
+		if !t.Expiry.IsZero() {
+			expiresAt = t.Expiry
+		}
+
+		// Replace the pending request by the authenticated session
+		if err := session.Set(w, &sessionObject{
+			AccessToken: t.AccessToken,
+			ExpiresAt:   expiresAt.Unix(),
+			CSRFToken:   uniuri.NewLen(32),
+		}, s.cookie); err != nil {
+			writeError(w, http.StatusInternalServerError, rfcerrors.ServerError().Build())
+			return
+		}
+
+		// Redirect to application
+		http.Redirect(w, r, s.opts.postLoginRedirect, http.StatusFound)
+	})
+}
+
+func (s *service) Session() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Check method
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Prevent caching
+		w.Header().Set("Cache-Control", "no-store")
+
+		// Retrieve session
+		info := &sessionInfo{}
+		if sess, ok := s.authenticated(r); ok {
+			info.Authenticated = true
+			info.CSRFToken = sess.CSRFToken
+			info.ExpiresAt = sess.ExpiresAt
+		}
+
+		// Write response
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(info)
+	})
+}
+
+func (s *service) Logout() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Check method
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Check CSRF token for authenticated sessions
+		sess, authenticated := s.authenticated(r)
+		if authenticated && !checkCSRF(sess, r) {
+			writeError(w, http.StatusForbidden, rfcerrors.InvalidRequest().Description("csrf token is missing or invalid.").Build())
+			return
+		}
+
+		// Expire the session cookie
+		http.SetCookie(w, &http.Cookie{
+			Name:     s.cookie.Name,
+			Value:    "",
+			Path:     s.cookie.Path,
+			Domain:   s.cookie.Domain,
+			Expires:  time.Unix(0, 0),
+			MaxAge:   -1,
+			Secure:   s.cookie.Secure,
+			HttpOnly: s.cookie.HTTPOnly,
+			SameSite: s.cookie.SameSite,
+		})
+
+		// Revoke the access token
+		if authenticated {
+			if err := s.revoke(r.Context(), sess.AccessToken); err != nil {
+				writeError(w, http.StatusBadGateway, rfcerrors.ServerError().Description("unable to revoke the access token.").Build())
+				return
+			}
+		}
+
+		// Redirect to application
+		http.Redirect(w, r, s.opts.postLogoutRedirect, http.StatusSeeOther)
+	})
+}
+
+func (s *service) Proxy() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Retrieve session
+		sess, ok := s.authenticated(r)
+		if !ok {
+			writeError(w, http.StatusUnauthorized, rfcerrors.InvalidToken().Description("session is missing or expired.").Build())
+			return
+		}
+
+		// Check CSRF token
+		if !checkCSRF(sess, r) {
+			writeError(w, http.StatusForbidden, rfcerrors.InvalidRequest().Description("csrf token is missing or invalid.").Build())
+			return
+		}
+
+		// Read request body to be able to replay it
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, s.opts.maxBodySize))
+		if err != nil {
+			writeError(w, http.StatusRequestEntityTooLarge, rfcerrors.InvalidRequest().Description("request body is too large.").Build())
+			return
+		}
+
+		// Prepare upstream url
+		target := *s.upstream
+		target.Path = path.Join("/", s.upstream.Path, r.URL.Path)
+		if strings.HasSuffix(r.URL.Path, "/") && !strings.HasSuffix(target.Path, "/") {
+			target.Path += "/"
+		}
+		target.RawPath = ""
+		target.RawQuery = r.URL.RawQuery
+		target.Fragment = ""
+
+		// Forward the request
+		response, err := s.doWithProof(r, &target, sess.AccessToken, body)
+		if err != nil {
+			writeError(w, http.StatusBadGateway, rfcerrors.ServerError().Description("unable to reach upstream api.").Build())
+			return
+		}
+		defer response.Body.Close()
+
+		// Copy upstream response
+		for k, values := range response.Header {
+			if droppedHeaders.Contains(k) {
+				continue
+			}
+			for _, v := range values {
+				w.Header().Add(k, v)
+			}
+		}
+		w.WriteHeader(response.StatusCode)
+		io.Copy(w, response.Body)
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+func (s *service) authenticated(r *http.Request) (*sessionObject, bool) {
+	var sess sessionObject
+	if err := session.Get(r, &sess, s.cookie); err != nil {
+		return nil, false
+	}
+
+	// Check session state
+	if sess.AccessToken == "" || sess.CSRFToken == "" {
+		return nil, false
+	}
+
+	// Check expiration
+	if timeFunc().Unix() >= sess.ExpiresAt {
+		return nil, false
+	}
+
+	return &sess, true
+}
+
+func (s *service) revoke(ctx context.Context, accessToken string) error {
+	// Prepare client assertion
+	assertion, err := s.client.Assertion()
+	if err != nil {
+		return fmt.Errorf("unable to prepare client assertion: %w", err)
+	}
+
+	// Revoke token
+	if err := s.client.RevokeToken(ctx, assertion, accessToken, "access_token"); err != nil {
+		return fmt.Errorf("unable to revoke access token: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+func (s *service) doWithProof(r *http.Request, target *url.URL, accessToken string, body []byte) (*http.Response, error) {
+	htu := fmt.Sprintf("%s://%s%s", target.Scheme, target.Host, target.EscapedPath())
+
+	for retry := 0; ; retry++ {
+		// Prepare request
+		req, err := http.NewRequestWithContext(r.Context(), r.Method, target.String(), bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("unable to prepare request: %w", err)
+		}
+
+		// Copy allowed headers
+		for _, h := range forwardedHeaders {
+			if v := r.Header.Get(h); v != "" {
+				req.Header.Set(h, v)
+			}
+		}
+
+		// Prepare DPoP
+		proof, err := s.prover.Prove(r.Method, htu, dpop.Nonce(s.dpopNonce()), dpop.AccessToken(accessToken))
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute proof of possession: %w", err)
+		}
+
+		// Attach credentials
+		req.Header.Set("Authorization", "DPoP "+accessToken)
+		req.Header.Set("DPoP", proof)
+
+		// Do the query
+		response, err := s.opts.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep the last server-issued nonce
+		nonce := response.Header.Get(dpop.NonceHeader)
+		if nonce == "" {
+			return response, nil
+		}
+		s.setDPoPNonce(nonce)
+
+		// Check if the server rejected the proof nonce
+		if retry > 0 || response.StatusCode != http.StatusUnauthorized || !strings.Contains(response.Header.Get("WWW-Authenticate"), `error="use_dpop_nonce"`) {
+			return response, nil
+		}
+
+		// Discard the response and retry with the fresh nonce
+		response.Body.Close()
+	}
+}
+
+func (s *service) dpopNonce() string {
+	s.nonceMutex.RLock()
+	defer s.nonceMutex.RUnlock()
+
+	return s.nonce
+}
+
+func (s *service) setDPoPNonce(nonce string) {
+	s.nonceMutex.Lock()
+	defer s.nonceMutex.Unlock()
+
+	s.nonce = nonce
+}
+
+func checkCSRF(sess *sessionObject, r *http.Request) bool {
+	token := r.Header.Get(CSRFHeader)
+	if token == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(sess.CSRFToken), []byte(token)) == 1
+}
+
+func writeError(w http.ResponseWriter, statusCode int, err *corev1.Error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(err)
+}
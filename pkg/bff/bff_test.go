@@ -0,0 +1,681 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bff
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kr/session"
+	"golang.org/x/oauth2"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/client"
+	clientmock "zntr.io/solid/pkg/client/mock"
+	"zntr.io/solid/pkg/sdk/dpop"
+	jarmmock "zntr.io/solid/pkg/sdk/jarm/mock"
+)
+
+var sessionKey = &[32]byte{1, 2, 3}
+
+type proverFunc func(htm, htu string, opts ...dpop.ProofOption) (string, error)
+
+func (f proverFunc) Prove(htm, htu string, opts ...dpop.ProofOption) (string, error) {
+	return f(htm, htu, opts...)
+}
+
+func staticProver(htm, htu string, opts ...dpop.ProofOption) (string, error) {
+	return "proof-" + htm + "-" + htu, nil
+}
+
+func mustURL(raw string) *url.URL {
+	u, err := url.Parse(raw)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func withSession(t *testing.T, r *http.Request, sess *sessionObject) *http.Request {
+	rec := httptest.NewRecorder()
+	if err := session.Set(rec, sess, &session.Config{Name: DefaultCookieName, Keys: []*[32]byte{sessionKey}}); err != nil {
+		t.Fatalf("unable to prepare session: %v", err)
+	}
+	for _, c := range rec.Result().Cookies() {
+		r.AddCookie(c)
+	}
+	return r
+}
+
+func authenticatedSession() *sessionObject {
+	return &sessionObject{
+		AccessToken: "at",
+		ExpiresAt:   2,
+		CSRFToken:   "csrf",
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func TestNew(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	withProver := func(prover dpop.Prover) client.Client {
+		c := clientmock.NewMockClient(ctrl)
+		c.EXPECT().Prover().Return(prover).AnyTimes()
+		return c
+	}
+
+	type args struct {
+		solidClient client.Client
+		decoder     *jarmmock.MockResponseDecoder
+		upstream    *url.URL
+		opts        []Option
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name: "nil prover",
+			args: args{
+				solidClient: withProver(nil),
+				decoder:     jarmmock.NewMockResponseDecoder(ctrl),
+				upstream:    mustURL("https://api.example.com"),
+				opts:        []Option{SessionKeys(sessionKey)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil decoder",
+			args: args{
+				solidClient: withProver(proverFunc(staticProver)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid upstream",
+			args: args{
+				solidClient: withProver(proverFunc(staticProver)),
+				decoder:     jarmmock.NewMockResponseDecoder(ctrl),
+				upstream:    mustURL("/api"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "no session keys",
+			args: args{
+				solidClient: withProver(proverFunc(staticProver)),
+				decoder:     jarmmock.NewMockResponseDecoder(ctrl),
+				upstream:    mustURL("https://api.example.com"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "insecure host cookie",
+			args: args{
+				solidClient: withProver(proverFunc(staticProver)),
+				decoder:     jarmmock.NewMockResponseDecoder(ctrl),
+				upstream:    mustURL("https://api.example.com"),
+				opts:        []Option{SessionKeys(sessionKey), InsecureCookie()},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			args: args{
+				solidClient: withProver(proverFunc(staticProver)),
+				decoder:     jarmmock.NewMockResponseDecoder(ctrl),
+				upstream:    mustURL("https://api.example.com"),
+				opts:        []Option{SessionKeys(sessionKey)},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.args.solidClient, tt.args.decoder, tt.args.upstream, tt.args.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func testService(t *testing.T, solidClient *clientmock.MockClient, decoder *jarmmock.MockResponseDecoder, prover dpop.Prover, upstream string) *service {
+	solidClient.EXPECT().Prover().Return(prover).AnyTimes()
+
+	b, err := New(solidClient, decoder, mustURL(upstream), SessionKeys(sessionKey))
+	if err != nil {
+		t.Fatalf("unable to initialize bff: %v", err)
+	}
+	return b.(*service)
+}
+
+func Test_service_Login(t *testing.T) {
+	tests := []struct {
+		name       string
+		prepare    func(*clientmock.MockClient)
+		wantStatus int
+		wantCookie bool
+	}{
+		{
+			name: "assertion error",
+			prepare: func(c *clientmock.MockClient) {
+				c.EXPECT().Assertion().Return("", errors.New("test"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "pushed authorization request error",
+			prepare: func(c *clientmock.MockClient) {
+				c.EXPECT().Assertion().Return("assertion", nil)
+				c.EXPECT().CreateRequestURI(gomock.Any(), "assertion", gomock.Any()).Return(nil, errors.New("test"))
+			},
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name: "authentication url error",
+			prepare: func(c *clientmock.MockClient) {
+				c.EXPECT().Assertion().Return("assertion", nil)
+				c.EXPECT().CreateRequestURI(gomock.Any(), "assertion", gomock.Any()).Return(&client.RequestURIResponse{
+					RequestURI:   "urn:solid:request",
+					CodeVerifier: "verifier",
+				}, nil)
+				c.EXPECT().AuthenticationURL(gomock.Any(), "urn:solid:request").Return("", errors.New("test"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "valid",
+			prepare: func(c *clientmock.MockClient) {
+				c.EXPECT().Assertion().Return("assertion", nil)
+				c.EXPECT().CreateRequestURI(gomock.Any(), "assertion", gomock.Any()).Return(&client.RequestURIResponse{
+					RequestURI:   "urn:solid:request",
+					CodeVerifier: "verifier",
+				}, nil)
+				c.EXPECT().AuthenticationURL(gomock.Any(), "urn:solid:request").Return("https://as.example.com/authorize?request_uri=urn:solid:request", nil)
+			},
+			wantStatus: http.StatusFound,
+			wantCookie: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			solidClient := clientmock.NewMockClient(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(solidClient)
+			}
+
+			s := testService(t, solidClient, jarmmock.NewMockResponseDecoder(ctrl), proverFunc(staticProver), "https://api.example.com")
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/bff/login", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Login() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if gotCookie := len(rec.Result().Cookies()) > 0; gotCookie != tt.wantCookie {
+				t.Errorf("Login() cookie = %v, want %v", gotCookie, tt.wantCookie)
+			}
+		})
+	}
+}
+
+func Test_service_Callback(t *testing.T) {
+	pending := &sessionObject{
+		State:        "state",
+		CodeVerifier: "verifier",
+	}
+
+	tests := []struct {
+		name       string
+		session    *sessionObject
+		prepare    func(*clientmock.MockClient, *jarmmock.MockResponseDecoder)
+		wantStatus int
+		wantCookie bool
+	}{
+		{
+			name:       "no pending request",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "decoder error",
+			session: pending,
+			prepare: func(c *clientmock.MockClient, d *jarmmock.MockResponseDecoder) {
+				c.EXPECT().ClientID().Return("s6BhdRkqt3")
+				d.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "jarm").Return(nil, errors.New("test"))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "issuer mismatch",
+			session: pending,
+			prepare: func(c *clientmock.MockClient, d *jarmmock.MockResponseDecoder) {
+				c.EXPECT().ClientID().Return("s6BhdRkqt3")
+				c.EXPECT().Issuer().Return("https://as.example.com")
+				d.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "jarm").Return(&corev1.AuthorizationCodeResponse{
+					Issuer: "https://evil.example.com",
+					State:  "state",
+					Code:   "code",
+				}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "state mismatch",
+			session: pending,
+			prepare: func(c *clientmock.MockClient, d *jarmmock.MockResponseDecoder) {
+				c.EXPECT().ClientID().Return("s6BhdRkqt3")
+				c.EXPECT().Issuer().Return("https://as.example.com")
+				d.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "jarm").Return(&corev1.AuthorizationCodeResponse{
+					Issuer: "https://as.example.com",
+					State:  "other",
+					Code:   "code",
+				}, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "authorization error",
+			session: pending,
+			prepare: func(c *clientmock.MockClient, d *jarmmock.MockResponseDecoder) {
+				c.EXPECT().ClientID().Return("s6BhdRkqt3")
+				c.EXPECT().Issuer().Return("https://as.example.com")
+				d.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "jarm").Return(&corev1.AuthorizationCodeResponse{
+					Issuer: "https://as.example.com",
+					State:  "state",
+					Error: &corev1.Error{
+						Err: "access_denied",
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:    "exchange error",
+			session: pending,
+			prepare: func(c *clientmock.MockClient, d *jarmmock.MockResponseDecoder) {
+				c.EXPECT().ClientID().Return("s6BhdRkqt3")
+				c.EXPECT().Issuer().Return("https://as.example.com")
+				d.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "jarm").Return(&corev1.AuthorizationCodeResponse{
+					Issuer: "https://as.example.com",
+					State:  "state",
+					Code:   "code",
+				}, nil)
+				c.EXPECT().Assertion().Return("assertion", nil)
+				c.EXPECT().ExchangeCode(gomock.Any(), "assertion", "code", "verifier").Return(nil, errors.New("test"))
+			},
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:    "valid",
+			session: pending,
+			prepare: func(c *clientmock.MockClient, d *jarmmock.MockResponseDecoder) {
+				c.EXPECT().ClientID().Return("s6BhdRkqt3")
+				c.EXPECT().Issuer().Return("https://as.example.com")
+				d.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "jarm").Return(&corev1.AuthorizationCodeResponse{
+					Issuer: "https://as.example.com",
+					State:  "state",
+					Code:   "code",
+				}, nil)
+				c.EXPECT().Assertion().Return("assertion", nil)
+				c.EXPECT().ExchangeCode(gomock.Any(), "assertion", "code", "verifier").Return(&oauth2.Token{
+					AccessToken: "at",
+					TokenType:   "DPoP",
+					Expiry:      time.Unix(3600, 0),
+				}, nil)
+			},
+			wantStatus: http.StatusFound,
+			wantCookie: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			solidClient := clientmock.NewMockClient(ctrl)
+			decoder := jarmmock.NewMockResponseDecoder(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(solidClient, decoder)
+			}
+
+			s := testService(t, solidClient, decoder, proverFunc(staticProver), "https://api.example.com")
+			req := httptest.NewRequest(http.MethodGet, "/bff/callback?response=jarm", nil)
+			if tt.session != nil {
+				req = withSession(t, req, tt.session)
+			}
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Callback() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if gotCookie := len(rec.Result().Cookies()) > 0; gotCookie != tt.wantCookie {
+				t.Errorf("Callback() cookie = %v, want %v", gotCookie, tt.wantCookie)
+			}
+		})
+	}
+}
+
+func Test_service_Proxy(t *testing.T) {
+	// Time function
+	timeFunc = func() time.Time { return time.Unix(1, 0) }
+	defer func() { timeFunc = time.Now }()
+
+	tests := []struct {
+		name        string
+		session     *sessionObject
+		csrfToken   string
+		upstream    http.HandlerFunc
+		wantStatus  int
+		wantProofs  int
+		wantHeaders map[string]string
+	}{
+		{
+			name:       "no session",
+			csrfToken:  "csrf",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired session",
+			session: &sessionObject{
+				AccessToken: "at",
+				ExpiresAt:   1,
+				CSRFToken:   "csrf",
+			},
+			csrfToken:  "csrf",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing csrf token",
+			session:    authenticatedSession(),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid csrf token",
+			session:    authenticatedSession(),
+			csrfToken:  "other",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:      "valid",
+			session:   authenticatedSession(),
+			csrfToken: "csrf",
+			upstream: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Authorization", r.Header.Get("Authorization"))
+				w.Header().Set("X-DPoP", r.Header.Get("DPoP"))
+				w.Header().Set("X-CSRF", r.Header.Get(CSRFHeader))
+				w.Header().Set("X-Query", r.URL.RawQuery)
+				w.WriteHeader(http.StatusOK)
+			},
+			wantStatus: http.StatusOK,
+			wantProofs: 1,
+			wantHeaders: map[string]string{
+				"X-Authorization": "DPoP at",
+				"X-DPoP":          "proof-GET-{upstream}/v1/users/me",
+				"X-CSRF":          "",
+				"X-Query":         "fields=name",
+			},
+		},
+		{
+			name:      "nonce required",
+			session:   authenticatedSession(),
+			csrfToken: "csrf",
+			upstream: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(dpop.NonceHeader, "nonce")
+				if r.Header.Get("X-Nonce") != "nonce" {
+					w.Header().Set("WWW-Authenticate", `DPoP error="use_dpop_nonce"`)
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+			wantStatus: http.StatusOK,
+			wantProofs: 2,
+			wantHeaders: map[string]string{
+				dpop.NonceHeader: "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Prepare upstream
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.upstream != nil {
+					tt.upstream(w, r)
+				}
+			}))
+			defer upstream.Close()
+
+			// Prepare prover
+			proofs := 0
+			prover := proverFunc(func(htm, htu string, opts ...dpop.ProofOption) (string, error) {
+				proofs++
+				return staticProver(htm, htu, opts...)
+			})
+
+			// Propagate the nonce to the upstream through the http client
+			s := testService(t, clientmock.NewMockClient(ctrl), jarmmock.NewMockResponseDecoder(ctrl), prover, upstream.URL+"/v1")
+			s.opts.httpClient = &http.Client{
+				Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					r.Header.Set("X-Nonce", s.dpopNonce())
+					return http.DefaultTransport.RoundTrip(r)
+				}),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/bff/api/users/me?fields=name", nil)
+			if tt.session != nil {
+				req = withSession(t, req, tt.session)
+			}
+			if tt.csrfToken != "" {
+				req.Header.Set(CSRFHeader, tt.csrfToken)
+			}
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Proxy() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if proofs != tt.wantProofs {
+				t.Errorf("Proxy() proofs = %v, want %v", proofs, tt.wantProofs)
+			}
+			for k, want := range tt.wantHeaders {
+				want = strings.ReplaceAll(want, "{upstream}", upstream.URL)
+				if got := rec.Header().Get(k); got != want {
+					t.Errorf("Proxy() header %s = %v, want %v", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_service_Session(t *testing.T) {
+	// Time function
+	timeFunc = func() time.Time { return time.Unix(1, 0) }
+	defer func() { timeFunc = time.Now }()
+
+	tests := []struct {
+		name    string
+		session *sessionObject
+		want    string
+	}{
+		{
+			name: "anonymous",
+			want: `{"authenticated":false}`,
+		},
+		{
+			name: "pending request",
+			session: &sessionObject{
+				State:        "state",
+				CodeVerifier: "verifier",
+			},
+			want: `{"authenticated":false}`,
+		},
+		{
+			name:    "authenticated",
+			session: authenticatedSession(),
+			want:    `{"authenticated":true,"csrf_token":"csrf","expires_at":2}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := testService(t, clientmock.NewMockClient(ctrl), jarmmock.NewMockResponseDecoder(ctrl), proverFunc(staticProver), "https://api.example.com")
+			req := httptest.NewRequest(http.MethodGet, "/bff/session", nil)
+			if tt.session != nil {
+				req = withSession(t, req, tt.session)
+			}
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+
+			if got := strings.TrimSpace(rec.Body.String()); got != tt.want {
+				t.Errorf("Session() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_Logout(t *testing.T) {
+	// Time function
+	timeFunc = func() time.Time { return time.Unix(1, 0) }
+	defer func() { timeFunc = time.Now }()
+
+	tests := []struct {
+		name       string
+		method     string
+		session    *sessionObject
+		csrfToken  string
+		prepare    func(*clientmock.MockClient)
+		wantStatus int
+		wantExpire bool
+	}{
+		{
+			name:       "invalid method",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "missing csrf token",
+			method:     http.MethodPost,
+			session:    authenticatedSession(),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "anonymous",
+			method:     http.MethodPost,
+			wantStatus: http.StatusSeeOther,
+			wantExpire: true,
+		},
+		{
+			name:      "assertion error",
+			method:    http.MethodPost,
+			session:   authenticatedSession(),
+			csrfToken: "csrf",
+			prepare: func(c *clientmock.MockClient) {
+				c.EXPECT().Assertion().Return("", errors.New("test"))
+			},
+			wantStatus: http.StatusBadGateway,
+			wantExpire: true,
+		},
+		{
+			name:      "revocation error",
+			method:    http.MethodPost,
+			session:   authenticatedSession(),
+			csrfToken: "csrf",
+			prepare: func(c *clientmock.MockClient) {
+				c.EXPECT().Assertion().Return("assertion", nil)
+				c.EXPECT().RevokeToken(gomock.Any(), "assertion", "at", "access_token").Return(errors.New("test"))
+			},
+			wantStatus: http.StatusBadGateway,
+			wantExpire: true,
+		},
+		{
+			name:      "valid",
+			method:    http.MethodPost,
+			session:   authenticatedSession(),
+			csrfToken: "csrf",
+			prepare: func(c *clientmock.MockClient) {
+				c.EXPECT().Assertion().Return("assertion", nil)
+				c.EXPECT().RevokeToken(gomock.Any(), "assertion", "at", "access_token").Return(nil)
+			},
+			wantStatus: http.StatusSeeOther,
+			wantExpire: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			solidClient := clientmock.NewMockClient(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(solidClient)
+			}
+
+			s := testService(t, solidClient, jarmmock.NewMockResponseDecoder(ctrl), proverFunc(staticProver), "https://api.example.com")
+			req := httptest.NewRequest(tt.method, "/bff/logout", nil)
+			if tt.session != nil {
+				req = withSession(t, req, tt.session)
+			}
+			if tt.csrfToken != "" {
+				req.Header.Set(CSRFHeader, tt.csrfToken)
+			}
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Logout() status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			cookies := rec.Result().Cookies()
+			if gotExpire := len(cookies) == 1 && cookies[0].Name == DefaultCookieName && cookies[0].MaxAge < 0; gotExpire != tt.wantExpire {
+				t.Errorf("Logout() expired cookie = %v, want %v", gotExpire, tt.wantExpire)
+			}
+		})
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,122 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bff
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultCookieName defines the default session cookie name. The
+	// `__Host-` prefix binds the cookie to the host and requires Secure.
+	DefaultCookieName = "__Host-solid-bff"
+	// DefaultSessionMaxAge defines the default session idle time.
+	DefaultSessionMaxAge = 8 * time.Hour
+	// DefaultMaxBodySize defines the default maximum proxied request body size.
+	DefaultMaxBodySize = 1 << 20
+)
+
+type options struct {
+	sessionKeys        []*[32]byte
+	cookieName         string
+	cookieDomain       string
+	insecureCookie     bool
+	sessionMaxAge      time.Duration
+	httpClient         *http.Client
+	pathPrefix         string
+	postLoginRedirect  string
+	postLogoutRedirect string
+	maxBodySize        int64
+}
+
+// Option defines functional option pattern function for backend-for-frontend.
+type Option func(*options)
+
+// SessionKeys sets the session cookie encryption keys. The first key is used
+// for encryption, all keys are accepted for decryption to support rotation.
+func SessionKeys(keys ...*[32]byte) Option {
+	return func(opts *options) {
+		opts.sessionKeys = keys
+	}
+}
+
+// CookieName overrides the session cookie name.
+func CookieName(name string) Option {
+	return func(opts *options) {
+		opts.cookieName = name
+	}
+}
+
+// CookieDomain sets the session cookie domain.
+func CookieDomain(domain string) Option {
+	return func(opts *options) {
+		opts.cookieDomain = domain
+	}
+}
+
+// InsecureCookie allows the session cookie to be sent over plain HTTP. It
+// must only be used for local development, and requires a cookie name without
+// the `__Host-` prefix.
+func InsecureCookie() Option {
+	return func(opts *options) {
+		opts.insecureCookie = true
+	}
+}
+
+// SessionMaxAge sets the session idle time.
+func SessionMaxAge(d time.Duration) Option {
+	return func(opts *options) {
+		opts.sessionMaxAge = d
+	}
+}
+
+// HTTPClient sets the HTTP client used to call the upstream API.
+func HTTPClient(client *http.Client) Option {
+	return func(opts *options) {
+		opts.httpClient = client
+	}
+}
+
+// PathPrefix sets the path prefix used to serve the endpoints.
+func PathPrefix(prefix string) Option {
+	return func(opts *options) {
+		opts.pathPrefix = prefix
+	}
+}
+
+// PostLoginRedirect sets the location used after a successful login.
+func PostLoginRedirect(location string) Option {
+	return func(opts *options) {
+		opts.postLoginRedirect = location
+	}
+}
+
+// PostLogoutRedirect sets the location used after logout.
+func PostLogoutRedirect(location string) Option {
+	return func(opts *options) {
+		opts.postLogoutRedirect = location
+	}
+}
+
+// MaxBodySize sets the maximum proxied request body size.
+func MaxBodySize(size int64) Option {
+	return func(opts *options) {
+		opts.maxBodySize = size
+	}
+}
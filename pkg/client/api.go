@@ -24,19 +24,28 @@ import (
 	"golang.org/x/oauth2"
 
 	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
+	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/jwt"
 )
 
+//go:generate mockgen -destination mock/client.gen.go -package mock zntr.io/solid/pkg/client Client
+
 // Client describes OIDC client contract.
 type Client interface {
 	Assertion() (string, error)
 	CreateRequestURI(ctx context.Context, assertion, state string) (*RequestURIResponse, error)
 	AuthenticationURL(ctx context.Context, requestURI string) (string, error)
 	ExchangeCode(ctx context.Context, assertion, authorizationCode, pkceCodeVerifier string) (*oauth2.Token, error)
+	// RevokeToken invalidates the given token using the revocation endpoint.
+	// https://tools.ietf.org/html/rfc7009
+	RevokeToken(ctx context.Context, assertion, token, tokenTypeHint string) error
 	PublicKeys(ctx context.Context) (*jose.JSONWebKeySet, uint64, error)
 	ClientID() string
 	Audience() string
 	ServerMetadata() *discoveryv1.ServerMetadata
 	Issuer() string
+	// Prover returns the DPoP prover used to bind issued access tokens.
+	Prover() dpop.Prover
 }
 
 // Options defines client options
@@ -46,4 +55,14 @@ type Options struct {
 	RedirectURI string
 	Scopes      []string
 	JWK         []byte
+	// RequestObjectEncryptionAlg enables request object encryption using the
+	// given key management algorithm and the server encryption key.
+	RequestObjectEncryptionAlg string
+	// RequestObjectEncryptionEnc defines the request object content
+	// encryption algorithm.
+	RequestObjectEncryptionEnc string
+	// MetadataVerifier enables signed metadata verification. It must be
+	// configured with the trust anchor keys, only metadata values signed by
+	// these keys are used.
+	MetadataVerifier jwt.Verifier
 }
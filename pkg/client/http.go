@@ -18,13 +18,16 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dchest/uniuri"
@@ -37,6 +40,8 @@ import (
 	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/jwe"
+	"zntr.io/solid/pkg/sdk/jwk"
 	jwsreq "zntr.io/solid/pkg/sdk/jwsreq"
 	"zntr.io/solid/pkg/sdk/pkce"
 	"zntr.io/solid/pkg/sdk/types"
@@ -73,11 +78,38 @@ func HTTP(ctx context.Context, issuer string, prover dpop.Prover, authorizationR
 		return nil, fmt.Errorf("unable to decode server metadata: %w", err)
 	}
 
+	// Only trust signed metadata values
+	// https://www.rfc-editor.org/rfc/rfc8414.html#section-3.2
+	if !types.IsNil(opts.MetadataVerifier) {
+		signed, err := verifySignedMetadata(opts.MetadataVerifier, issuer, c.serverMetadata)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify server metadata: %w", err)
+		}
+		c.serverMetadata = signed
+	}
+
 	// Retrieve public keys
 	if _, _, err := c.PublicKeys(ctx); err != nil {
 		return nil, fmt.Errorf("unable to retrieve public keys: %w", err)
 	}
 
+	// Encrypt request objects
+	if opts.RequestObjectEncryptionAlg != "" {
+		// Check server capabilities
+		if !types.StringArray(c.serverMetadata.RequestObjectEncryptionAlgValuesSupported).Contains(opts.RequestObjectEncryptionAlg) {
+			return nil, fmt.Errorf("server doesn't support '%s' as request object encryption algorithm", opts.RequestObjectEncryptionAlg)
+		}
+		if !types.StringArray(c.serverMetadata.RequestObjectEncryptionEncValuesSupported).Contains(opts.RequestObjectEncryptionEnc) {
+			return nil, fmt.Errorf("server doesn't support '%s' as request object content encryption", opts.RequestObjectEncryptionEnc)
+		}
+
+		// Wrap the request encoder
+		c.authorizationRequestEncoder = jwsreq.JWEAuthorizationEncoder(
+			jwe.DefaultEncrypter(c.serverEncryptionKey(opts.RequestObjectEncryptionAlg), opts.RequestObjectEncryptionAlg, opts.RequestObjectEncryptionEnc),
+			authorizationRequestEncoder,
+		)
+	}
+
 	// No error
 	return c, nil
 }
@@ -93,6 +125,8 @@ type httpClient struct {
 	jwks                        *jose.JSONWebKeySet
 	jwksExpiration              uint64
 	serverMetadata              *discoveryv1.ServerMetadata
+	nonceMutex                  sync.RWMutex
+	nonce                       string
 }
 
 // -----------------------------------------------------------------------------
@@ -101,6 +135,7 @@ func (c *httpClient) ClientID() string                            { return c.opt
 func (c *httpClient) Audience() string                            { return c.opts.Audience }
 func (c *httpClient) ServerMetadata() *discoveryv1.ServerMetadata { return c.serverMetadata }
 func (c *httpClient) Issuer() string                              { return c.issuer }
+func (c *httpClient) Prover() dpop.Prover                         { return c.prover }
 
 // -----------------------------------------------------------------------------
 
@@ -113,8 +148,24 @@ func (c *httpClient) Assertion() (string, error) {
 		return "", fmt.Errorf("unable to decode JWK: %w", err)
 	}
 
+	// Resolve signature algorithm from key
+	alg, err := jwk.Algorithm(&privateKey)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve assertion signature algorithm: %w", err)
+	}
+	if err := jwk.CheckAlgorithm(&privateKey, alg); err != nil {
+		return "", fmt.Errorf("unable to use client key: %w", err)
+	}
+
+	// Check algorithm is accepted by the server
+	if c.serverMetadata != nil && len(c.serverMetadata.TokenEndpointAuthSigningAlgValuesSupported) > 0 {
+		if !types.StringArray(c.serverMetadata.TokenEndpointAuthSigningAlgValuesSupported).Contains(alg) {
+			return "", fmt.Errorf("assertion signature algorithm '%s' is not supported by the server", alg)
+		}
+	}
+
 	// Prepare a signer
-	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES384, Key: privateKey}, (&jose.SignerOptions{}).WithType("JWT"))
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.SignatureAlgorithm(alg), Key: privateKey}, (&jose.SignerOptions{}).WithType("JWT"))
 	if err != nil {
 		return "", fmt.Errorf("unable to prepare signer: %w", err)
 	}
@@ -188,31 +239,15 @@ func (c *httpClient) CreateRequestURI(ctx context.Context, assertion, state stri
 	// Assign request
 	params.Add("request", r)
 
-	// Assemble final url
-	parURL.RawQuery = params.Encode()
-
 	// Query PAR endpoint
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, parURL.String(), strings.NewReader(params.Encode()))
+	response, err := c.doWithProof(ctx, http.MethodPost, c.serverMetadata.PushedAuthorizationRequestEndpoint, parURL, params)
 	if err != nil {
-		return nil, fmt.Errorf("unable to prepare par request: %w", err)
-	}
-
-	// Set approppriate header value
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-
-	// Prepare DPoP
-	proof, err := c.prover.Prove(http.MethodPost, c.serverMetadata.PushedAuthorizationRequestEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("unable to compute proof of possession: %w", err)
+		return nil, fmt.Errorf("unable to create authorization request: %w", err)
 	}
+	defer response.Body.Close()
 
-	// Attach proof as header
-	req.Header.Set("DPoP", proof)
-
-	// Do the query
-	response, err := c.httpClient.Do(req)
-	if err != nil || response.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("unable to create authorization request: %w", err)
+	if response.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("unable to create authorization request: unexpected status code %d", response.StatusCode)
 	}
 
 	// Decode payload
@@ -220,7 +255,6 @@ func (c *httpClient) CreateRequestURI(ctx context.Context, assertion, state stri
 	if err := json.NewDecoder(response.Body).Decode(&jsonResponse); err != nil {
 		return nil, fmt.Errorf("unable to decode json response: %w", err)
 	}
-	defer response.Body.Close()
 
 	// Check response
 	if jsonResponse.Error != nil {
@@ -280,29 +314,8 @@ func (c *httpClient) ExchangeCode(ctx context.Context, assertion, code, pkceCode
 	params.Add("client_assertion", assertion)
 	params.Add("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
 
-	// Assemble final url
-	tokenURL.RawQuery = params.Encode()
-
 	// Query token endpoint
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL.String(), strings.NewReader(params.Encode()))
-	if err != nil {
-		return nil, fmt.Errorf("unable to prepare token request: %w", err)
-	}
-
-	// Set approppriate header value
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-
-	// Prepare DPoP
-	proof, err := c.prover.Prove(http.MethodPost, c.serverMetadata.TokenEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("unable to compute proof of possession: %w", err)
-	}
-
-	// Attach proof as header
-	req.Header.Set("DPoP", proof)
-
-	// Do the query
-	response, err := c.httpClient.Do(req)
+	response, err := c.doWithProof(ctx, http.MethodPost, c.serverMetadata.TokenEndpoint, tokenURL, params)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve token: %w", err)
 	}
@@ -329,6 +342,49 @@ func (c *httpClient) ExchangeCode(ctx context.Context, assertion, code, pkceCode
 	return &token, nil
 }
 
+func (c *httpClient) RevokeToken(ctx context.Context, assertion, token, tokenTypeHint string) error {
+	// Check server support
+	if c.serverMetadata.RevocationEndpoint == "" {
+		return fmt.Errorf("server doesn't support token revocation")
+	}
+
+	// Parse revocation endpoint url
+	revocationURL, err := url.Parse(c.serverMetadata.RevocationEndpoint)
+	if err != nil {
+		return fmt.Errorf("unable to parse revocation endpoint url: %w", err)
+	}
+
+	// Prepare parameters
+	params := url.Values{}
+	params.Add("token", token)
+	if tokenTypeHint != "" {
+		params.Add("token_type_hint", tokenTypeHint)
+	}
+	params.Add("client_assertion", assertion)
+	params.Add("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+
+	// Query revocation endpoint
+	response, err := c.doWithProof(ctx, http.MethodPost, c.serverMetadata.RevocationEndpoint, revocationURL, params)
+	if err != nil {
+		return fmt.Errorf("unable to revoke token: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		var err corev1.Error
+
+		// Decode json error
+		if err := json.NewDecoder(io.LimitReader(response.Body, bodyLimiterSize)).Decode(&err); err != nil {
+			return fmt.Errorf("unable to decode json error for token revocation request: %w", err)
+		}
+
+		return fmt.Errorf("unable to revoke token got %s, %s", err.Err, err.ErrorDescription)
+	}
+
+	// No error
+	return nil
+}
+
 func (c *httpClient) PublicKeys(ctx context.Context) (*jose.JSONWebKeySet, uint64, error) {
 	// Check if keys are not cached and not expired
 	if c.jwks != nil && c.jwksExpiration > uint64(time.Now().Unix()) {
@@ -387,3 +443,137 @@ func (c *httpClient) PublicKeys(ctx context.Context) (*jose.JSONWebKeySet, uint6
 	// No error
 	return jwks.JSONWebKeySet, c.jwksExpiration, nil
 }
+
+// -----------------------------------------------------------------------------
+
+// serverEncryptionKey returns a key provider selecting the server public key to
+// use for the given key management algorithm.
+func (c *httpClient) serverEncryptionKey(alg string) jwk.KeyProviderFunc {
+	return func(ctx context.Context) (*jose.JSONWebKey, error) {
+		// Retrieve server public keys
+		jwks, _, err := c.PublicKeys(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve server public keys: %w", err)
+		}
+
+		// Find an encryption key
+		for i := range jwks.Keys {
+			k := jwks.Keys[i]
+			if k.Use != "enc" {
+				continue
+			}
+			if k.Algorithm != "" && k.Algorithm != alg {
+				continue
+			}
+
+			// No error
+			return &k, nil
+		}
+
+		// No matching key
+		return nil, fmt.Errorf("server doesn't publish an encryption key for '%s'", alg)
+	}
+}
+
+// doWithProof sends form parameters, as query and body, with a DPoP proof
+// attached. When the server requires a fresh nonce, the proof is regenerated
+// and the request is sent once more. The client assertion is renewed too, the
+// server may have already registered its identifier while authenticating the
+// rejected request.
+func (c *httpClient) doWithProof(ctx context.Context, method, htu string, target *url.URL, params url.Values) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		// Renew client assertion
+		if retry > 0 && params.Get("client_assertion") != "" {
+			assertion, err := c.Assertion()
+			if err != nil {
+				return nil, fmt.Errorf("unable to renew client assertion: %w", err)
+			}
+			params.Set("client_assertion", assertion)
+		}
+
+		// Assemble final url
+		body := params.Encode()
+		target.RawQuery = body
+
+		// Prepare request
+		req, err := http.NewRequestWithContext(ctx, method, target.String(), strings.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("unable to prepare request: %w", err)
+		}
+
+		// Set approppriate header value
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		// Prepare DPoP
+		proof, err := c.prover.Prove(method, htu, dpop.Nonce(c.dpopNonce()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute proof of possession: %w", err)
+		}
+
+		// Attach proof as header
+		req.Header.Set("DPoP", proof)
+
+		// Do the query
+		response, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep the last server-issued nonce
+		nonce := response.Header.Get(dpop.NonceHeader)
+		if nonce == "" {
+			return response, nil
+		}
+		c.setDPoPNonce(nonce)
+
+		// Check if the server rejected the proof nonce
+		if retry > 0 || !isNonceRequired(response) {
+			return response, nil
+		}
+
+		// Discard the response and retry with the fresh nonce
+		response.Body.Close()
+	}
+}
+
+func (c *httpClient) dpopNonce() string {
+	c.nonceMutex.RLock()
+	defer c.nonceMutex.RUnlock()
+
+	return c.nonce
+}
+
+func (c *httpClient) setDPoPNonce(nonce string) {
+	c.nonceMutex.Lock()
+	defer c.nonceMutex.Unlock()
+
+	c.nonce = nonce
+}
+
+func isNonceRequired(response *http.Response) bool {
+	// Only rejected requests
+	if response.StatusCode != http.StatusBadRequest && response.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+
+	// Check authenticate challenge
+	if strings.Contains(response.Header.Get("WWW-Authenticate"), `error="use_dpop_nonce"`) {
+		return true
+	}
+
+	// Read the error payload
+	payload, err := ioutil.ReadAll(io.LimitReader(response.Body, bodyLimiterSize))
+	response.Body.Close()
+	response.Body = ioutil.NopCloser(bytes.NewReader(payload))
+	if err != nil {
+		return false
+	}
+
+	// Decode json error
+	var jsonErr jsonError
+	if err := json.Unmarshal(payload, &jsonErr); err != nil {
+		return false
+	}
+
+	return jsonErr.ErrorCode == "use_dpop_nonce"
+}
@@ -0,0 +1,270 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/square/go-jose/v3"
+	"github.com/square/go-jose/v3/jwt"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/examples/storage/inmemory"
+	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/jwk"
+	sdkjwt "zntr.io/solid/pkg/sdk/jwt"
+	"zntr.io/solid/pkg/server/clientauthentication"
+	"zntr.io/solid/pkg/server/profile"
+)
+
+type proverFunc func(htm, htu string, opts ...dpop.ProofOption) (string, error)
+
+func (f proverFunc) Prove(htm, htu string, opts ...dpop.ProofOption) (string, error) {
+	return f(htm, htu, opts...)
+}
+
+func Test_httpClient_Assertion(t *testing.T) {
+	generate := map[string]func() (crypto.Signer, error){
+		"ES256": func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P256(), rand.Reader) },
+		"ES384": func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P384(), rand.Reader) },
+		"PS256": func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 2048) },
+		"EdDSA": func() (crypto.Signer, error) {
+			_, pk, err := ed25519.GenerateKey(rand.Reader)
+			return pk, err
+		},
+	}
+
+	for _, alg := range jwk.SupportedSignatureAlgorithms {
+		pk, err := generate[alg]()
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, err := json.Marshal(&jose.JSONWebKey{Key: pk, KeyID: "client-key", Use: "sig"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, serverAlgs := range [][]string{nil, jwk.SupportedSignatureAlgorithms, {"RS256"}} {
+			wantErr := len(serverAlgs) == 1
+
+			t.Run(alg, func(t *testing.T) {
+				c := &httpClient{
+					issuer: "http://127.0.0.1:8080",
+					opts: &Options{
+						ClientID: "6779ef20e75817b79602",
+						JWK:      raw,
+					},
+					serverMetadata: &discoveryv1.ServerMetadata{
+						TokenEndpointAuthSigningAlgValuesSupported: serverAlgs,
+					},
+				}
+
+				assertion, err := c.Assertion()
+				if (err != nil) != wantErr {
+					t.Fatalf("httpClient.Assertion() error = %v, wantErr %v", err, wantErr)
+				}
+				if err != nil {
+					return
+				}
+
+				// Check algorithm and signature
+				token, err := jwt.ParseSigned(assertion)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if token.Headers[0].Algorithm != alg {
+					t.Errorf("alg = %v, want %v", token.Headers[0].Algorithm, alg)
+				}
+				claims := privateJWTClaims{}
+				if err := token.Claims(pk.Public(), &claims); err != nil {
+					t.Errorf("unable to verify assertion: %v", err)
+				}
+			})
+		}
+	}
+}
+
+func Test_httpClient_RevokeToken(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		switch r.FormValue("token") {
+		case "valid":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"unsupported_token_type"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &httpClient{
+		issuer:     "http://127.0.0.1:8080",
+		opts:       &Options{ClientID: "6779ef20e75817b79602"},
+		httpClient: server.Client(),
+		prover: proverFunc(func(htm, htu string, opts ...dpop.ProofOption) (string, error) {
+			return htm + " " + htu, nil
+		}),
+		serverMetadata: &discoveryv1.ServerMetadata{},
+	}
+
+	// Unsupported revocation
+	if err := c.RevokeToken(context.Background(), "assertion", "valid", "access_token"); err == nil {
+		t.Fatal("revocation must fail without revocation endpoint")
+	}
+
+	c.serverMetadata.RevocationEndpoint = server.URL + "/revoke"
+	if err := c.RevokeToken(context.Background(), "assertion", "invalid", "access_token"); err == nil {
+		t.Fatal("revocation error must be reported")
+	}
+	if err := c.RevokeToken(context.Background(), "assertion", "valid", "access_token"); err != nil {
+		t.Fatalf("unable to revoke token: %v", err)
+	}
+	if proof := got.Get("DPoP"); proof != "POST "+server.URL+"/revoke" {
+		t.Errorf("DPoP = %v, want proof for revocation endpoint", proof)
+	}
+}
+
+func Test_httpClient_DPoPNonceRetry(t *testing.T) {
+	ctx := context.Background()
+
+	// Client key
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey, err := json.Marshal(&jose.JSONWebKey{Key: pk, KeyID: "client-key", Use: "sig"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeys, err := json.Marshal(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: pk.Public(), KeyID: "client-key", Use: "sig"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Register client
+	clients := inmemory.Clients()
+	clientID, err := clients.Register(ctx, &corev1.Client{
+		ApplicationType:         oidc.ApplicationTypeServerSideWeb,
+		TokenEndpointAuthMethod: oidc.AuthMethodPrivateKeyJWT,
+		Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+		Jwks:                    publicKeys,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nonce enforcing server with client assertion replay protection
+	const issuer = "http://127.0.0.1:8080"
+	clientAuth := clientauthentication.PrivateKeyJWT(clients, profile.Strict(), inmemory.ClientAssertions(), []string{issuer})
+	nonces := dpop.DefaultNonceProvider()
+	verifier, err := dpop.DefaultVerifier(inmemory.DPoPProofs(), sdkjwt.DefaultVerifier(nil, jwk.SupportedSignatureAlgorithms), dpop.Nonces(nonces))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		// Authenticate client before proof validation
+		if _, err := clientAuth.Authenticate(r.Context(), &corev1.ClientAuthenticationRequest{
+			ClientAssertionType: &wrapperspb.StringValue{Value: r.FormValue("client_assertion_type")},
+			ClientAssertion:     &wrapperspb.StringValue{Value: r.FormValue("client_assertion")},
+		}); err != nil {
+			t.Logf("unable to authenticate client: %v", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		// Validate proof
+		if _, err := verifier.Verify(r.Context(), r.Method, dpop.CleanURL(r), r.Header.Get("DPoP")); err != nil {
+			if !errors.Is(err, dpop.ErrUseNonce) {
+				t.Logf("unable to validate proof: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			nonce, _ := nonces.Nonce(r.Context())
+			w.Header().Set(dpop.NonceHeader, nonce)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"use_dpop_nonce"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"at","token_type":"DPoP","expires_in":60}`))
+	}))
+	defer server.Close()
+
+	// DPoP prover
+	proofKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := (&jose.SignerOptions{}).WithType(dpop.HeaderType)
+	options.EmbedJWK = true
+	prover, err := dpop.DefaultProver(sdkjwt.DefaultSigner(jose.SigningKey{
+		Algorithm: jose.ES256,
+		Key:       &jose.JSONWebKey{Key: proofKey, KeyID: "proof-key", Use: "sig"},
+	}, options))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &httpClient{
+		issuer:     issuer,
+		opts:       &Options{ClientID: clientID, JWK: privateKey},
+		httpClient: server.Client(),
+		prover:     prover,
+		serverMetadata: &discoveryv1.ServerMetadata{
+			TokenEndpoint: server.URL + "/token",
+		},
+	}
+
+	// First call has no nonce and must be retried with a new assertion
+	assertion, err := c.Assertion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := c.ExchangeCode(ctx, assertion, "code", "verifier")
+	if err != nil {
+		t.Fatalf("unable to exchange code: %v", err)
+	}
+	if token.AccessToken != "at" {
+		t.Errorf("access_token = %v, want at", token.AccessToken)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %v, want 2", attempts)
+	}
+}
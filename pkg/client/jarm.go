@@ -0,0 +1,53 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/square/go-jose/v3"
+
+	"zntr.io/solid/pkg/sdk/jarm"
+	"zntr.io/solid/pkg/sdk/jwe"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/jwt"
+)
+
+// ResponseDecoder returns a JARM response decoder verifying responses with the
+// authorization server public keys.
+//
+// When decryption keys are given, nested JWE-then-JWS responses are decrypted
+// before verification. These keys must match the encryption keys registered
+// with the authorization_encrypted_response_alg client metadata.
+func ResponseDecoder(c Client, decryptionKeys *jose.JSONWebKeySet) jarm.ResponseDecoder {
+	// Verify response using server keys
+	decoder := jarm.JWTDecoder(c.Issuer(), jwt.DefaultVerifier(func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		jwks, _, err := c.PublicKeys(ctx)
+		return jwks, err
+	}, jwk.SupportedSignatureAlgorithms))
+
+	// Signed only responses
+	if decryptionKeys == nil || len(decryptionKeys.Keys) == 0 {
+		return decoder
+	}
+
+	// Decrypt nested responses
+	return jarm.JWEDecoder(jwe.DefaultDecrypter(func(_ context.Context) (*jose.JSONWebKeySet, error) {
+		return decryptionKeys, nil
+	}, jwe.DefaultKeyAlgorithms, jwe.DefaultContentEncryptions), decoder)
+}
@@ -0,0 +1,142 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/square/go-jose/v3"
+	"github.com/square/go-jose/v3/jwt"
+
+	"zntr.io/solid/pkg/sdk/jarm"
+)
+
+func TestResponseDecoder(t *testing.T) {
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate server key: %v", err)
+	}
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate client key: %v", err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate other key: %v", err)
+	}
+
+	// Sign response
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: serverKey, KeyID: "server"}}, (&jose.SignerOptions{}).WithType(jarm.HeaderType))
+	if err != nil {
+		t.Fatalf("unable to prepare signer: %v", err)
+	}
+	signed, err := jwt.Signed(signer).Claims(map[string]interface{}{
+		"iss":   "https://as.example.com",
+		"aud":   "s6BhdRkqt3",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"code":  "code",
+		"state": "state",
+	}).CompactSerialize()
+	if err != nil {
+		t.Fatalf("unable to sign response: %v", err)
+	}
+
+	// Encrypt response
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.ECDH_ES_A256KW, Key: &jose.JSONWebKey{Key: &clientKey.PublicKey, KeyID: "client-enc"}}, (&jose.EncrypterOptions{}).WithContentType("JWT"))
+	if err != nil {
+		t.Fatalf("unable to prepare encrypter: %v", err)
+	}
+	obj, err := encrypter.Encrypt([]byte(signed))
+	if err != nil {
+		t.Fatalf("unable to encrypt response: %v", err)
+	}
+	encrypted, err := obj.CompactSerialize()
+	if err != nil {
+		t.Fatalf("unable to serialize response: %v", err)
+	}
+
+	c := &httpClient{
+		issuer: "https://as.example.com",
+		jwks: &jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{Key: &serverKey.PublicKey, KeyID: "server", Algorithm: "ES256", Use: "sig"}},
+		},
+		jwksExpiration: uint64(time.Now().Add(time.Hour).Unix()),
+	}
+
+	tests := []struct {
+		name           string
+		decryptionKeys *jose.JSONWebKeySet
+		response       string
+		wantErr        bool
+	}{
+		{
+			name:     "signed",
+			response: signed,
+			wantErr:  false,
+		},
+		{
+			name:     "encrypted without decryption keys",
+			response: encrypted,
+			wantErr:  true,
+		},
+		{
+			name: "encrypted with unknown key",
+			decryptionKeys: &jose.JSONWebKeySet{
+				Keys: []jose.JSONWebKey{{Key: otherKey, KeyID: "other", Algorithm: string(jose.ECDH_ES_A256KW), Use: "enc"}},
+			},
+			response: encrypted,
+			wantErr:  true,
+		},
+		{
+			name: "encrypted",
+			decryptionKeys: &jose.JSONWebKeySet{
+				Keys: []jose.JSONWebKey{{Key: clientKey, KeyID: "client-enc", Algorithm: string(jose.ECDH_ES_A256KW), Use: "enc"}},
+			},
+			response: encrypted,
+			wantErr:  false,
+		},
+		{
+			name: "signed with decryption keys",
+			decryptionKeys: &jose.JSONWebKeySet{
+				Keys: []jose.JSONWebKey{{Key: clientKey, KeyID: "client-enc", Algorithm: string(jose.ECDH_ES_A256KW), Use: "enc"}},
+			},
+			response: signed,
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResponseDecoder(c, tt.decryptionKeys).Decode(context.Background(), "s6BhdRkqt3", tt.response)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResponseDecoder().Decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Code != "code" || got.State != "state" {
+				t.Errorf("ResponseDecoder().Decode() = %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
+	"zntr.io/solid/pkg/sdk/jwt"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+// verifySignedMetadata validates the signed_metadata value using the given
+// trust anchor verifier and returns the metadata values it contains.
+// https://www.rfc-editor.org/rfc/rfc8414.html#section-2.1
+func verifySignedMetadata(verifier jwt.Verifier, issuer string, meta *discoveryv1.ServerMetadata) (*discoveryv1.ServerMetadata, error) {
+	// Check arguments
+	if types.IsNil(verifier) {
+		return nil, fmt.Errorf("unable to verify signed metadata with nil verifier")
+	}
+	if meta == nil {
+		return nil, fmt.Errorf("unable to verify nil metadata")
+	}
+	if meta.SignedMetadata == "" {
+		return nil, fmt.Errorf("server metadata doesn't contain signed_metadata")
+	}
+
+	// Check signature algorithm
+	if err := verifier.Verify(meta.SignedMetadata); err != nil {
+		return nil, fmt.Errorf("signed_metadata is invalid: %w", err)
+	}
+
+	// Extract claims
+	claims := map[string]interface{}{}
+	if err := verifier.Claims(meta.SignedMetadata, &claims); err != nil {
+		return nil, fmt.Errorf("unable to verify signed_metadata: %w", err)
+	}
+
+	// Check attesting party
+	if iss, _ := claims["iss"].(string); iss != issuer {
+		return nil, fmt.Errorf("signed_metadata issuer doesn't match '%s'", issuer)
+	}
+
+	// Decode metadata values
+	delete(claims, "iss")
+	delete(claims, "iat")
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode signed metadata claims: %w", err)
+	}
+	var signed discoveryv1.ServerMetadata
+	if err := json.Unmarshal(payload, &signed); err != nil {
+		return nil, fmt.Errorf("unable to decode signed metadata values: %w", err)
+	}
+	if signed.Issuer != issuer {
+		return nil, fmt.Errorf("signed metadata issuer doesn't match '%s'", issuer)
+	}
+
+	// Keep the original assertion
+	signed.SignedMetadata = meta.SignedMetadata
+
+	// No error
+	return &signed, nil
+}
@@ -0,0 +1,189 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/square/go-jose/v3"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
+	"zntr.io/solid/pkg/sdk/jwt"
+)
+
+func Test_verifySignedMetadata(t *testing.T) {
+	anchorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	sign := func(key *ecdsa.PrivateKey, claims map[string]interface{}) string {
+		token, err := jwt.DefaultSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, nil).Sign(claims)
+		if err != nil {
+			t.Fatalf("unable to sign metadata: %v", err)
+		}
+		return token
+	}
+
+	trustAnchor := func(algs ...string) jwt.Verifier {
+		return jwt.DefaultVerifier(func(_ context.Context) (*jose.JSONWebKeySet, error) {
+			return &jose.JSONWebKeySet{
+				Keys: []jose.JSONWebKey{
+					{Key: anchorKey.Public(), Algorithm: "ES256", Use: "sig"},
+				},
+			}, nil
+		}, algs)
+	}
+
+	validClaims := map[string]interface{}{
+		"iss":            "https://as.example.org",
+		"iat":            1,
+		"issuer":         "https://as.example.org",
+		"token_endpoint": "https://as.example.org/token",
+	}
+
+	type args struct {
+		verifier jwt.Verifier
+		issuer   string
+		meta     *discoveryv1.ServerMetadata
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *discoveryv1.ServerMetadata
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name: "nil metadata",
+			args: args{
+				verifier: trustAnchor("ES256"),
+				issuer:   "https://as.example.org",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsigned metadata",
+			args: args{
+				verifier: trustAnchor("ES256"),
+				issuer:   "https://as.example.org",
+				meta: &discoveryv1.ServerMetadata{
+					Issuer:        "https://as.example.org",
+					TokenEndpoint: "https://as.example.org/token",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported algorithm",
+			args: args{
+				verifier: trustAnchor("ES384"),
+				issuer:   "https://as.example.org",
+				meta: &discoveryv1.ServerMetadata{
+					SignedMetadata: sign(anchorKey, validClaims),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "untrusted signer",
+			args: args{
+				verifier: trustAnchor("ES256"),
+				issuer:   "https://as.example.org",
+				meta: &discoveryv1.ServerMetadata{
+					SignedMetadata: sign(otherKey, validClaims),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "issuer mismatch",
+			args: args{
+				verifier: trustAnchor("ES256"),
+				issuer:   "https://honest.as.example.org",
+				meta: &discoveryv1.ServerMetadata{
+					SignedMetadata: sign(anchorKey, validClaims),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "metadata issuer mismatch",
+			args: args{
+				verifier: trustAnchor("ES256"),
+				issuer:   "https://as.example.org",
+				meta: &discoveryv1.ServerMetadata{
+					SignedMetadata: sign(anchorKey, map[string]interface{}{
+						"iss":    "https://as.example.org",
+						"issuer": "https://evil.as.example.org",
+					}),
+				},
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				verifier: trustAnchor("ES256"),
+				issuer:   "https://as.example.org",
+				meta: &discoveryv1.ServerMetadata{
+					Issuer:         "https://as.example.org",
+					TokenEndpoint:  "https://evil.as.example.org/token",
+					SignedMetadata: sign(anchorKey, validClaims),
+				},
+			},
+			want: &discoveryv1.ServerMetadata{
+				Issuer:        "https://as.example.org",
+				TokenEndpoint: "https://as.example.org/token",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifySignedMetadata(tt.args.verifier, tt.args.issuer, tt.args.meta)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifySignedMetadata() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform(), protocmp.IgnoreFields(&discoveryv1.ServerMetadata{}, "signed_metadata")); diff != "" {
+				t.Errorf("verifySignedMetadata() res = %s", diff)
+			}
+			if got.SignedMetadata != tt.args.meta.SignedMetadata {
+				t.Errorf("verifySignedMetadata() signed_metadata must be preserved")
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+const (
+	// SchemeBearer defines bearer token authorization scheme.
+	// https://tools.ietf.org/html/rfc6750#section-2.1
+	SchemeBearer = "Bearer"
+	// SchemeDPoP defines DPoP-bound token authorization scheme.
+	// https://datatracker.ietf.org/doc/html/rfc9449#section-7.1
+	SchemeDPoP = "DPoP"
+	// AccessTokenType defines the JWT access token typ header value.
+	AccessTokenType = "at+jwt"
+)
+
+var (
+	// ErrMissingToken is raised when the request doesn't contain an access token.
+	ErrMissingToken = errors.New("resourceserver: access token is missing")
+	// ErrInactiveToken is raised when the access token is expired, revoked or
+	// unknown.
+	ErrInactiveToken = errors.New("resourceserver: access token is not active")
+)
+
+//go:generate mockgen -destination mock/token_validator.gen.go -package mock zntr.io/solid/pkg/resourceserver TokenValidator
+
+// TokenValidator describes access token validation contract.
+type TokenValidator interface {
+	// Validate the given access token and returns its attributes.
+	Validate(ctx context.Context, token string) (*corev1.Token, error)
+}
+
+//go:generate mockgen -destination mock/authenticator.gen.go -package mock zntr.io/solid/pkg/resourceserver Authenticator
+
+// Authenticator describes protected resource request authentication contract.
+type Authenticator interface {
+	// Authenticate the request and returns the validated access token.
+	Authenticate(r *http.Request) (*corev1.Token, error)
+}
+
+// Error describes a protected resource authentication error.
+type Error struct {
+	// Scheme used by the client.
+	Scheme string
+	// StatusCode to send to the client.
+	StatusCode int
+	// Err is the RFC error to send to the client.
+	Err *corev1.Error
+	// Cause is the internal error.
+	Cause error
+	// Challenges to send using WWW-Authenticate headers.
+	Challenges []string
+	// Nonce to send using DPoP-Nonce header.
+	Nonce string
+}
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("resourceserver: %v", e.Cause)
+	}
+	return fmt.Sprintf("resourceserver: %s: %v", e.Err.Err, e.Cause)
+}
+
+// Unwrap returns the internal error.
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,250 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/mtls"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+// DefaultAuthenticator returns a protected resource request authenticator.
+// JWT access tokens are validated with the given validator, opaque tokens are
+// delegated to the introspection validator when configured.
+func DefaultAuthenticator(accessTokens TokenValidator, opts ...Option) Authenticator {
+	// Default options
+	defaultOptions := &options{}
+
+	// Apply param functions
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	return &authenticator{
+		accessTokens:  accessTokens,
+		introspection: defaultOptions.introspection,
+		dpopVerifier:  defaultOptions.dpopVerifier,
+		dpopNonces:    defaultOptions.dpopNonces,
+		realm:         defaultOptions.realm,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type authenticator struct {
+	accessTokens  TokenValidator
+	introspection TokenValidator
+	dpopVerifier  dpop.Verifier
+	dpopNonces    dpop.NonceProvider
+	realm         string
+}
+
+func (a *authenticator) Authenticate(r *http.Request) (*corev1.Token, error) {
+	// Check arguments
+	if r == nil {
+		return nil, fmt.Errorf("unable to authenticate nil request")
+	}
+
+	// Extract access token
+	scheme, token, err := a.extractToken(r)
+	if err != nil {
+		if errors.Is(err, ErrMissingToken) {
+			return nil, a.fail(scheme, http.StatusUnauthorized, nil, err)
+		}
+		return nil, a.fail(scheme, http.StatusBadRequest, rfcerrors.InvalidRequest().Build(), err)
+	}
+
+	// Select validator according to token format
+	validator := a.introspection
+	if strings.Count(token, ".") == 2 && !types.IsNil(a.accessTokens) {
+		validator = a.accessTokens
+	}
+	if types.IsNil(validator) {
+		return nil, a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidToken().Build(), fmt.Errorf("no validator available for this token format"))
+	}
+
+	// Validate access token
+	at, err := validator.Validate(r.Context(), token)
+	if err != nil {
+		return nil, a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidToken().Build(), err)
+	}
+
+	// Check key binding
+	if errBinding := a.checkBinding(r, scheme, token, at.Confirmation); errBinding != nil {
+		return nil, errBinding
+	}
+
+	// No error
+	return at, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (a *authenticator) extractToken(r *http.Request) (string, string, error) {
+	// Retrieve authorization header
+	authorization := r.Header.Values("Authorization")
+	switch len(authorization) {
+	case 0:
+		return "", "", ErrMissingToken
+	case 1:
+	default:
+		return "", "", fmt.Errorf("multiple authorization headers are not allowed")
+	}
+
+	// Split scheme and token
+	parts := strings.SplitN(authorization[0], " ", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("authorization header is malformed")
+	}
+
+	// Check scheme
+	var scheme string
+	switch {
+	case strings.EqualFold(parts[0], SchemeBearer):
+		scheme = SchemeBearer
+	case strings.EqualFold(parts[0], SchemeDPoP) && !types.IsNil(a.dpopVerifier):
+		scheme = SchemeDPoP
+	default:
+		return "", "", ErrMissingToken
+	}
+
+	// Check token
+	token := strings.TrimSpace(parts[1])
+	if token == "" {
+		return scheme, "", fmt.Errorf("access token is blank")
+	}
+
+	// No error
+	return scheme, token, nil
+}
+
+func (a *authenticator) checkBinding(r *http.Request, scheme, token string, cnf *corev1.TokenConfirmation) error {
+	// Certificate-bound access token
+	if cnf != nil && cnf.X5TS256 != "" {
+		if err := mtls.VerifyRequest(r, cnf); err != nil {
+			return a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidToken().Build(), err)
+		}
+	}
+
+	// Bearer access token
+	if cnf == nil || cnf.Jkt == "" {
+		if scheme == SchemeDPoP {
+			return a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidToken().Build(), fmt.Errorf("access token is not DPoP-bound"))
+		}
+		return nil
+	}
+
+	// DPoP-bound access token must use the DPoP scheme
+	if scheme != SchemeDPoP {
+		return a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidToken().Build(), fmt.Errorf("DPoP-bound access token used as a bearer token"))
+	}
+
+	// Retrieve proof
+	proofs := r.Header.Values("DPoP")
+	if len(proofs) != 1 {
+		return a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidDPoPProof().Build(), fmt.Errorf("exactly one DPoP proof is expected"))
+	}
+
+	// Verify proof bound to the access token
+	jkt, err := a.dpopVerifier.Verify(r.Context(), r.Method, dpop.CleanURL(r), proofs[0], dpop.AccessToken(token))
+	if err != nil {
+		if errors.Is(err, dpop.ErrUseNonce) {
+			return a.useNonce(r, err)
+		}
+		return a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidDPoPProof().Build(), err)
+	}
+
+	// Check proof key
+	if !types.SecureCompareString(jkt, cnf.Jkt) {
+		return a.fail(scheme, http.StatusUnauthorized, rfcerrors.InvalidToken().Build(), fmt.Errorf("DPoP proof key doesn't match access token confirmation"))
+	}
+
+	// No error
+	return nil
+}
+
+func (a *authenticator) useNonce(r *http.Request, cause error) error {
+	// Nonce provider is required to send a fresh nonce
+	if types.IsNil(a.dpopNonces) {
+		return a.fail(SchemeDPoP, http.StatusUnauthorized, rfcerrors.InvalidDPoPProof().Build(), cause)
+	}
+
+	// Retrieve current nonce
+	nonce, err := a.dpopNonces.Nonce(r.Context())
+	if err != nil {
+		return a.fail(SchemeDPoP, http.StatusInternalServerError, rfcerrors.ServerError().Build(), err)
+	}
+
+	// Build error
+	e := a.fail(SchemeDPoP, http.StatusUnauthorized, rfcerrors.UseDPoPNonce().Build(), cause)
+	e.Nonce = nonce
+
+	return e
+}
+
+func (a *authenticator) fail(scheme string, statusCode int, rfcErr *corev1.Error, cause error) *Error {
+	return &Error{
+		Scheme:     scheme,
+		StatusCode: statusCode,
+		Err:        rfcErr,
+		Cause:      cause,
+		Challenges: a.challenges(scheme, rfcErr),
+	}
+}
+
+func (a *authenticator) challenges(scheme string, rfcErr *corev1.Error) []string {
+	// Supported schemes
+	schemes := []string{SchemeBearer}
+	if !types.IsNil(a.dpopVerifier) {
+		schemes = append(schemes, SchemeDPoP)
+	}
+
+	res := []string{}
+	for _, s := range schemes {
+		params := []string{}
+
+		// Add realm
+		if a.realm != "" {
+			params = append(params, fmt.Sprintf("realm=%q", a.realm))
+		}
+
+		// Attach error to the scheme used by the client
+		if rfcErr != nil && (s == scheme || scheme == "") {
+			params = append(params, fmt.Sprintf("error=%q", rfcErr.Err))
+			if rfcErr.ErrorDescription != "" {
+				params = append(params, fmt.Sprintf("error_description=%q", rfcErr.ErrorDescription))
+			}
+		}
+
+		// Assemble challenge
+		challenge := s
+		if len(params) > 0 {
+			challenge = fmt.Sprintf("%s %s", s, strings.Join(params, ", "))
+		}
+		res = append(res, challenge)
+	}
+
+	return res
+}
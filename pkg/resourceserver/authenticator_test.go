@@ -0,0 +1,344 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/square/go-jose/v3"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/jwt"
+	storagemock "zntr.io/solid/pkg/server/storage/mock"
+)
+
+type tokenValidatorFunc func(context.Context, string) (*corev1.Token, error)
+
+func (f tokenValidatorFunc) Validate(ctx context.Context, token string) (*corev1.Token, error) {
+	return f(ctx, token)
+}
+
+func testProver(t *testing.T) (dpop.Prover, string) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate dpop key: %v", err)
+	}
+
+	key := &jose.JSONWebKey{
+		Use: "sig",
+		Key: pk,
+	}
+
+	// Compute thumbprint
+	pub := key.Public()
+	h, err := pub.Thumbprint(crypto.SHA256)
+	if err != nil {
+		t.Fatalf("unable to compute thumbprint: %v", err)
+	}
+
+	// Signer options
+	options := (&jose.SignerOptions{}).WithType(dpop.HeaderType)
+	options.EmbedJWK = true
+	prover, err := dpop.DefaultProver(jwt.DefaultSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, options))
+	if err != nil {
+		t.Fatalf("unable to initialize prover: %v", err)
+	}
+
+	return prover, base64.RawURLEncoding.EncodeToString(h)
+}
+
+func Test_authenticator_Authenticate(t *testing.T) {
+	const (
+		jwtToken    = "eyJhbGciOiJFUzI1NiJ9.e30.c2ln"
+		opaqueToken = "2YotnFZFEjr1zCsicMWpAA"
+		endpoint    = "https://api.example.com/resource"
+	)
+
+	prover, jkt := testProver(t)
+	otherProver, _ := testProver(t)
+
+	prove := func(p dpop.Prover, opts ...dpop.ProofOption) string {
+		proof, err := p.Prove(http.MethodGet, endpoint, opts...)
+		if err != nil {
+			t.Fatalf("unable to generate proof: %v", err)
+		}
+		return proof
+	}
+
+	bearerToken := &corev1.Token{TokenId: "bearer"}
+	boundToken := &corev1.Token{TokenId: "bound", Confirmation: &corev1.TokenConfirmation{Jkt: jkt}}
+
+	tests := []struct {
+		name           string
+		headers        map[string][]string
+		token          *corev1.Token
+		validatorErr   error
+		nonces         bool
+		wantErr        bool
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "missing token",
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "unsupported scheme",
+			headers: map[string][]string{
+				"Authorization": {"Basic Zm9vOmJhcg=="},
+			},
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "malformed header",
+			headers: map[string][]string{
+				"Authorization": {"Bearer"},
+			},
+			wantErr:        true,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "invalid_request",
+		},
+		{
+			name: "multiple headers",
+			headers: map[string][]string{
+				"Authorization": {"Bearer " + jwtToken, "Bearer " + jwtToken},
+			},
+			wantErr:        true,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "invalid_request",
+		},
+		{
+			name: "invalid token",
+			headers: map[string][]string{
+				"Authorization": {"Bearer " + jwtToken},
+			},
+			validatorErr:   fmt.Errorf("foo"),
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "invalid_token",
+		},
+		{
+			name: "bound token used as bearer",
+			headers: map[string][]string{
+				"Authorization": {"Bearer " + jwtToken},
+			},
+			token:          boundToken,
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "invalid_token",
+		},
+		{
+			name: "bearer token used with dpop",
+			headers: map[string][]string{
+				"Authorization": {"DPoP " + jwtToken},
+				"Dpop":          {prove(prover, dpop.AccessToken(jwtToken))},
+			},
+			token:          bearerToken,
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "invalid_token",
+		},
+		{
+			name: "missing proof",
+			headers: map[string][]string{
+				"Authorization": {"DPoP " + jwtToken},
+			},
+			token:          boundToken,
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "invalid_dpop_proof",
+		},
+		{
+			name: "proof without ath",
+			headers: map[string][]string{
+				"Authorization": {"DPoP " + jwtToken},
+				"Dpop":          {prove(prover)},
+			},
+			token:          boundToken,
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "invalid_dpop_proof",
+		},
+		{
+			name: "proof from another key",
+			headers: map[string][]string{
+				"Authorization": {"DPoP " + jwtToken},
+				"Dpop":          {prove(otherProver, dpop.AccessToken(jwtToken))},
+			},
+			token:          boundToken,
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "invalid_token",
+		},
+		{
+			name: "nonce required",
+			headers: map[string][]string{
+				"Authorization": {"DPoP " + jwtToken},
+				"Dpop":          {prove(prover, dpop.AccessToken(jwtToken))},
+			},
+			token:          boundToken,
+			nonces:         true,
+			wantErr:        true,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "use_dpop_nonce",
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid: bearer",
+			headers: map[string][]string{
+				"Authorization": {"Bearer " + jwtToken},
+			},
+			token:   bearerToken,
+			wantErr: false,
+		},
+		{
+			name: "valid: opaque token",
+			headers: map[string][]string{
+				"Authorization": {"Bearer " + opaqueToken},
+			},
+			token:   bearerToken,
+			wantErr: false,
+		},
+		{
+			name: "valid: dpop",
+			headers: map[string][]string{
+				"Authorization": {"DPoP " + jwtToken},
+				"Dpop":          {prove(prover, dpop.AccessToken(jwtToken))},
+			},
+			token:   boundToken,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			proofs := storagemock.NewMockDPoP(ctrl)
+			proofs.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+			proofs.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			// Prepare dpop verifier
+			verifierOpts := []dpop.VerifierOption{}
+			opts := []Option{Realm("api")}
+			if tt.nonces {
+				nonces := dpop.DefaultNonceProvider()
+				verifierOpts = append(verifierOpts, dpop.Nonces(nonces))
+				opts = append(opts, DPoPNonces(nonces))
+			}
+			dpopVerifier, err := dpop.DefaultVerifier(proofs, jwt.DefaultVerifier(nil, []string{"ES256"}), verifierOpts...)
+			if err != nil {
+				t.Fatalf("unable to initialize dpop verifier: %v", err)
+			}
+
+			// Prepare validators
+			validator := tokenValidatorFunc(func(_ context.Context, token string) (*corev1.Token, error) {
+				if token != jwtToken {
+					return nil, fmt.Errorf("unexpected token")
+				}
+				return tt.token, tt.validatorErr
+			})
+			introspection := tokenValidatorFunc(func(_ context.Context, token string) (*corev1.Token, error) {
+				if token != opaqueToken {
+					return nil, fmt.Errorf("unexpected token")
+				}
+				return tt.token, nil
+			})
+
+			// Prepare request
+			r := httptest.NewRequest(http.MethodGet, endpoint, nil)
+			r.Header = http.Header{}
+			for k, v := range tt.headers {
+				r.Header[k] = v
+			}
+
+			underTest := DefaultAuthenticator(validator, append(opts, Introspection(introspection), DPoP(dpopVerifier))...)
+			got, err := underTest.Authenticate(r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authenticator.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if got != tt.token {
+					t.Errorf("authenticator.Authenticate() = %v, want %v", got, tt.token)
+				}
+				return
+			}
+
+			// Check error
+			authErr, ok := err.(*Error)
+			if !ok {
+				t.Fatalf("authenticator.Authenticate() error type = %T", err)
+			}
+			if authErr.StatusCode != tt.wantStatusCode {
+				t.Errorf("authenticator.Authenticate() status = %d, want %d", authErr.StatusCode, tt.wantStatusCode)
+			}
+			if (authErr.Err == nil && tt.wantError != "") || (authErr.Err != nil && authErr.Err.Err != tt.wantError) {
+				t.Errorf("authenticator.Authenticate() error = %v, want %v", authErr.Err, tt.wantError)
+			}
+			if tt.nonces && authErr.Nonce == "" {
+				t.Errorf("authenticator.Authenticate() must return a fresh nonce")
+			}
+			if len(authErr.Challenges) != 2 {
+				t.Errorf("authenticator.Authenticate() challenges = %v", authErr.Challenges)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	underTest := Middleware(DefaultAuthenticator(nil, Realm("api")))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler must not be called")
+	}))
+
+	// Unauthenticated request
+	w := httptest.NewRecorder()
+	underTest.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "https://api.example.com/resource", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Middleware() status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	if got := w.Header().Get("WWW-Authenticate"); got != `Bearer realm="api"` {
+		t.Errorf("Middleware() challenge = %s", got)
+	}
+
+	// Invalid token
+	w = httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "https://api.example.com/resource", nil)
+	r.Header.Set("Authorization", "Bearer foo")
+	underTest.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Middleware() status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	if got, want := w.Header().Get("WWW-Authenticate"), `Bearer realm="api", error="invalid_token", error_description=`; len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("Middleware() challenge = %s", got)
+	}
+}
@@ -0,0 +1,66 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"github.com/square/go-jose/v3/jwt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+type confirmationClaims struct {
+	JKT     string `json:"jkt,omitempty"`
+	X5TS256 string `json:"x5t#S256,omitempty"`
+}
+
+func (c *confirmationClaims) build() *corev1.TokenConfirmation {
+	if c == nil || (c.JKT == "" && c.X5TS256 == "") {
+		return nil
+	}
+
+	return &corev1.TokenConfirmation{
+		Jkt:     c.JKT,
+		X5TS256: c.X5TS256,
+	}
+}
+
+type accessTokenClaims struct {
+	Issuer       string              `json:"iss"`
+	Subject      string              `json:"sub"`
+	Audience     jwt.Audience        `json:"aud"`
+	ExpiresAt    uint64              `json:"exp"`
+	IssuedAt     uint64              `json:"iat"`
+	NotBefore    uint64              `json:"nbf,omitempty"`
+	ClientID     string              `json:"client_id"`
+	JTI          string              `json:"jti"`
+	Scope        string              `json:"scope"`
+	Confirmation *confirmationClaims `json:"cnf,omitempty"`
+}
+
+type introspectionResponse struct {
+	Active       bool                `json:"active"`
+	Issuer       string              `json:"iss,omitempty"`
+	Subject      string              `json:"sub,omitempty"`
+	Audience     jwt.Audience        `json:"aud,omitempty"`
+	ExpiresAt    uint64              `json:"exp,omitempty"`
+	IssuedAt     uint64              `json:"iat,omitempty"`
+	ClientID     string              `json:"client_id,omitempty"`
+	JTI          string              `json:"jti,omitempty"`
+	Scope        string              `json:"scope,omitempty"`
+	Confirmation *confirmationClaims `json:"cnf,omitempty"`
+}
@@ -0,0 +1,162 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
+)
+
+const bodyLimiterSize = 1 << 20 // 1 Mb
+
+// AssertionFunc returns a client assertion used to authenticate the resource
+// server.
+type AssertionFunc func(ctx context.Context) (string, error)
+
+// RemoteIntrospection returns a validator querying the authorization server
+// introspection endpoint. It is used for opaque access tokens.
+//
+// As for JWT access tokens, the introspected token must be issued by the
+// given issuer for the given audience, and must not be expired when the
+// server discloses its expiration.
+// https://tools.ietf.org/html/rfc7662
+func RemoteIntrospection(endpoint, issuer, audience string, opts ...IntrospectionOption) TokenValidator {
+	// Default options
+	defaultOptions := &introspectionOptions{
+		httpClient: http.DefaultClient,
+	}
+
+	// Apply param functions
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	return &introspectionValidator{
+		endpoint:   endpoint,
+		issuer:     issuer,
+		audience:   audience,
+		httpClient: defaultOptions.httpClient,
+		assertion:  defaultOptions.assertion,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type introspectionValidator struct {
+	endpoint   string
+	issuer     string
+	audience   string
+	httpClient *http.Client
+	assertion  AssertionFunc
+}
+
+func (v *introspectionValidator) Validate(ctx context.Context, token string) (*corev1.Token, error) {
+	// Check arguments
+	if token == "" {
+		return nil, ErrMissingToken
+	}
+
+	// Prepare parameters
+	params := url.Values{}
+	params.Set("token", token)
+	params.Set("token_type_hint", "access_token")
+
+	// Client authentication
+	if v.assertion != nil {
+		assertion, err := v.assertion(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate client assertion: %w", err)
+		}
+		params.Set("client_assertion_type", oidc.AssertionTypeJWTBearer)
+		params.Set("client_assertion", assertion)
+	}
+
+	// Prepare request
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare introspection request: %w", err)
+	}
+
+	// Set approppriate header value
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	// Do the query
+	response, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query introspection endpoint: %w", err)
+	}
+	defer response.Body.Close()
+
+	// Check status
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspection endpoint returned an unexpected status code %d", response.StatusCode)
+	}
+
+	// Decode payload
+	var jsonResponse introspectionResponse
+	if err := json.NewDecoder(io.LimitReader(response.Body, bodyLimiterSize)).Decode(&jsonResponse); err != nil {
+		return nil, fmt.Errorf("unable to decode introspection response: %w", err)
+	}
+
+	// Check token status
+	if !jsonResponse.Active {
+		return nil, ErrInactiveToken
+	}
+
+	// Check issuer
+	if jsonResponse.Issuer != v.issuer {
+		return nil, fmt.Errorf("access token issuer '%s' is not expected", jsonResponse.Issuer)
+	}
+
+	// Check audience
+	if !jsonResponse.Audience.Contains(v.audience) {
+		return nil, fmt.Errorf("access token is not issued for audience '%s'", v.audience)
+	}
+
+	// Check expiration
+	if jsonResponse.ExpiresAt != 0 && jsonResponse.ExpiresAt < uint64(timeFunc().Unix()) {
+		return nil, fmt.Errorf("access token expired: %w", ErrInactiveToken)
+	}
+
+	// No error
+	return &corev1.Token{
+		TokenType: corev1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+		TokenId:   jsonResponse.JTI,
+		Status:    corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		Value:     token,
+		Metadata: &corev1.TokenMeta{
+			Issuer:    jsonResponse.Issuer,
+			Subject:   jsonResponse.Subject,
+			IssuedAt:  jsonResponse.IssuedAt,
+			ExpiresAt: jsonResponse.ExpiresAt,
+			ClientId:  jsonResponse.ClientID,
+			Scope:     jsonResponse.Scope,
+			Audience:  strings.Join(jsonResponse.Audience, " "),
+		},
+		Confirmation: jsonResponse.Confirmation.build(),
+	}, nil
+}
@@ -0,0 +1,147 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"zntr.io/solid/api/oidc"
+)
+
+func Test_introspectionValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		token     string
+		assertion AssertionFunc
+		handler   http.HandlerFunc
+		wantJkt   string
+		wantErr   bool
+	}{
+		{
+			name:    "blank",
+			wantErr: true,
+		},
+		{
+			name:  "assertion error",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			assertion: func(_ context.Context) (string, error) {
+				return "", fmt.Errorf("foo")
+			},
+			wantErr: true,
+		},
+		{
+			name:  "server error",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "invalid json",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, "{")
+			},
+			wantErr: true,
+		},
+		{
+			name:  "inactive token",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"active":false}`)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "issuer mismatch",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"active":true,"iss":"https://evil.example.com","sub":"user-1","aud":["https://api.example.com"]}`)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "audience mismatch",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"active":true,"iss":"https://as.example.com","sub":"user-1","aud":["https://other.example.com"]}`)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "expired token",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"active":true,"iss":"https://as.example.com","sub":"user-1","aud":["https://api.example.com"],"exp":1}`)
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:  "valid",
+			token: "2YotnFZFEjr1zCsicMWpAA",
+			assertion: func(_ context.Context) (string, error) {
+				return "fake-assertion", nil
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if r.PostForm.Get("token") != "2YotnFZFEjr1zCsicMWpAA" || r.PostForm.Get("client_assertion") != "fake-assertion" || r.PostForm.Get("client_assertion_type") != oidc.AssertionTypeJWTBearer {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				fmt.Fprint(w, `{"active":true,"iss":"https://as.example.com","sub":"user-1","aud":["https://api.example.com"],"cnf":{"jkt":"0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"}}`)
+			},
+			wantJkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := tt.handler
+			if handler == nil {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					t.Error("introspection endpoint must not be called")
+				}
+			}
+			srv := httptest.NewServer(handler)
+			defer srv.Close()
+
+			underTest := RemoteIntrospection(srv.URL, "https://as.example.com", "https://api.example.com", HTTPClient(srv.Client()), ClientAssertion(tt.assertion))
+
+			got, err := underTest.Validate(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("introspectionValidator.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Metadata.Subject != "user-1" || got.Confirmation == nil || got.Confirmation.Jkt != tt.wantJkt {
+				t.Errorf("introspectionValidator.Validate() = %v", got)
+			}
+		})
+	}
+}
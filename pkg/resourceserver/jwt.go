@@ -0,0 +1,162 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/jwt"
+)
+
+var timeFunc = time.Now
+
+// JWTAccessToken returns a validator for RFC9068 JWT access tokens. Use
+// jwk.RemoteKeySetProvider with jwk.DefaultFetcher to retrieve the
+// authorization server keys from its jwks_uri.
+// https://datatracker.ietf.org/doc/html/rfc9068#section-4
+func JWTAccessToken(keySetProvider jwk.KeySetProviderFunc, issuer, audience string, opts ...JWTOption) TokenValidator {
+	// Default options
+	defaultOptions := &jwtOptions{
+		supportedAlgorithms: DefaultSupportedAlgorithms,
+		clockSkew:           DefaultClockSkew,
+	}
+
+	// Apply param functions
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	return &jwtValidator{
+		verifier:  jwt.DefaultVerifier(keySetProvider, defaultOptions.supportedAlgorithms),
+		issuer:    issuer,
+		audience:  audience,
+		clockSkew: defaultOptions.clockSkew,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type jwtValidator struct {
+	verifier  jwt.Verifier
+	issuer    string
+	audience  string
+	clockSkew time.Duration
+}
+
+func (v *jwtValidator) Validate(ctx context.Context, token string) (*corev1.Token, error) {
+	// Check arguments
+	if token == "" {
+		return nil, ErrMissingToken
+	}
+
+	// Parse token
+	t, err := v.verifier.Parse(token)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse access token: %w", err)
+	}
+
+	// Check token type
+	typ, err := t.Type()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve access token type: %w", err)
+	}
+	if typ = strings.ToLower(typ); typ != AccessTokenType && typ != "application/"+AccessTokenType {
+		return nil, fmt.Errorf("access token has an invalid type '%s'", typ)
+	}
+
+	// Check signature algorithm
+	if errVerify := v.verifier.Verify(token); errVerify != nil {
+		return nil, fmt.Errorf("unable to verify access token: %w", errVerify)
+	}
+
+	// Extract claims
+	var claims accessTokenClaims
+	if errClaims := v.verifier.Claims(token, &claims); errClaims != nil {
+		return nil, fmt.Errorf("unable to extract access token claims: %w", errClaims)
+	}
+
+	// Validate claims
+	if errClaims := v.validateClaims(&claims); errClaims != nil {
+		return nil, errClaims
+	}
+
+	// No error
+	return &corev1.Token{
+		TokenType: corev1.TokenType_TOKEN_TYPE_ACCESS_TOKEN,
+		TokenId:   claims.JTI,
+		Status:    corev1.TokenStatus_TOKEN_STATUS_ACTIVE,
+		Value:     token,
+		Metadata: &corev1.TokenMeta{
+			Issuer:    claims.Issuer,
+			Subject:   claims.Subject,
+			IssuedAt:  claims.IssuedAt,
+			ExpiresAt: claims.ExpiresAt,
+			ClientId:  claims.ClientID,
+			Scope:     claims.Scope,
+			Audience:  v.audience,
+		},
+		Confirmation: claims.Confirmation.build(),
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (v *jwtValidator) validateClaims(claims *accessTokenClaims) error {
+	// Check mandatory claims
+	if claims.Issuer == "" || claims.Subject == "" || claims.ClientID == "" || claims.JTI == "" {
+		return fmt.Errorf("access token must contain iss, sub, client_id and jti claims")
+	}
+	if claims.ExpiresAt == 0 || claims.IssuedAt == 0 {
+		return fmt.Errorf("access token must contain exp and iat claims")
+	}
+
+	// Check issuer
+	if claims.Issuer != v.issuer {
+		return fmt.Errorf("access token issuer '%s' is not expected", claims.Issuer)
+	}
+
+	// Check audience
+	if !claims.Audience.Contains(v.audience) {
+		return fmt.Errorf("access token is not issued for audience '%s'", v.audience)
+	}
+
+	now := timeFunc()
+	skew := uint64(v.clockSkew / time.Second)
+	nowUnix := uint64(now.Unix())
+
+	// Check expiration
+	if claims.ExpiresAt+skew < nowUnix {
+		return fmt.Errorf("access token expired: %w", ErrInactiveToken)
+	}
+
+	// Check issuance
+	if claims.IssuedAt > nowUnix+skew {
+		return fmt.Errorf("access token is issued in the future")
+	}
+	if claims.NotBefore > nowUnix+skew {
+		return fmt.Errorf("access token is not yet valid")
+	}
+
+	// No error
+	return nil
+}
@@ -0,0 +1,199 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/square/go-jose/v3"
+	"github.com/square/go-jose/v3/jwt"
+)
+
+var (
+	testIssuer   = "https://as.example.com"
+	testAudience = "https://api.example.com"
+)
+
+func generateSigningKey(t *testing.T) *jose.JSONWebKey {
+	return generateKeyWithCurve(t, elliptic.P256())
+}
+
+func generateKeyWithCurve(t *testing.T, curve elliptic.Curve) *jose.JSONWebKey {
+	pk, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return &jose.JSONWebKey{
+		Key:       pk,
+		KeyID:     "at-key-1",
+		Algorithm: string(jose.ES256),
+		Use:       "sig",
+	}
+}
+
+func staticKeySet(key *jose.JSONWebKey) func(context.Context) (*jose.JSONWebKeySet, error) {
+	return func(_ context.Context) (*jose.JSONWebKeySet, error) {
+		return &jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{key.Public()},
+		}, nil
+	}
+}
+
+func signAccessToken(t *testing.T, key *jose.JSONWebKey, alg jose.SignatureAlgorithm, typ string, claims interface{}) string {
+	opts := (&jose.SignerOptions{}).WithType(jose.ContentType(typ)).WithHeader("kid", key.KeyID)
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, opts)
+	if err != nil {
+		t.Fatalf("unable to prepare signer: %v", err)
+	}
+
+	raw, err := jwt.Signed(sig).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatalf("unable to sign token: %v", err)
+	}
+
+	return raw
+}
+
+func validClaims(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":       testIssuer,
+		"sub":       "user-1",
+		"aud":       testAudience,
+		"exp":       now.Add(5 * time.Minute).Unix(),
+		"iat":       now.Unix(),
+		"client_id": "s6BhdRkqt3",
+		"jti":       "123456789",
+		"scope":     "openid",
+		"cnf": map[string]interface{}{
+			"jkt": "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I",
+		},
+	}
+}
+
+func Test_jwtValidator_Validate(t *testing.T) {
+	key := generateSigningKey(t)
+	now := time.Now()
+
+	with := func(name string, value interface{}) map[string]interface{} {
+		claims := validClaims(now)
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:    "blank",
+			wantErr: true,
+		},
+		{
+			name:    "invalid syntax",
+			token:   "foo",
+			wantErr: true,
+		},
+		{
+			name:    "invalid type",
+			token:   signAccessToken(t, key, jose.ES256, "JWT", validClaims(now)),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported algorithm",
+			token:   signAccessToken(t, generateKeyWithCurve(t, elliptic.P384()), jose.ES384, AccessTokenType, validClaims(now)),
+			wantErr: true,
+		},
+		{
+			name:    "invalid signature",
+			token:   signAccessToken(t, generateSigningKey(t), jose.ES256, AccessTokenType, validClaims(now)),
+			wantErr: true,
+		},
+		{
+			name:    "missing jti",
+			token:   signAccessToken(t, key, jose.ES256, AccessTokenType, with("jti", nil)),
+			wantErr: true,
+		},
+		{
+			name:    "invalid issuer",
+			token:   signAccessToken(t, key, jose.ES256, AccessTokenType, with("iss", "https://evil.example.com")),
+			wantErr: true,
+		},
+		{
+			name:    "invalid audience",
+			token:   signAccessToken(t, key, jose.ES256, AccessTokenType, with("aud", "https://other.example.com")),
+			wantErr: true,
+		},
+		{
+			name:    "expired",
+			token:   signAccessToken(t, key, jose.ES256, AccessTokenType, with("exp", now.Add(-time.Minute).Unix())),
+			wantErr: true,
+		},
+		{
+			name:    "issued in the future",
+			token:   signAccessToken(t, key, jose.ES256, AccessTokenType, with("iat", now.Add(time.Hour).Unix())),
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:    "valid",
+			token:   signAccessToken(t, key, jose.ES256, AccessTokenType, validClaims(now)),
+			wantErr: false,
+		},
+		{
+			name:    "valid: media type",
+			token:   signAccessToken(t, key, jose.ES256, "application/at+jwt", validClaims(now)),
+			wantErr: false,
+		},
+		{
+			name:    "valid: audience array",
+			token:   signAccessToken(t, key, jose.ES256, AccessTokenType, with("aud", []string{"foo", testAudience})),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			underTest := JWTAccessToken(staticKeySet(key), testIssuer, testAudience, SupportedAlgorithms("ES256"))
+
+			got, err := underTest.Validate(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("jwtValidator.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.TokenId != "123456789" || got.Metadata.Subject != "user-1" || got.Value != tt.token {
+				t.Errorf("jwtValidator.Validate() returned unexpected token %v", got)
+			}
+			if got.Confirmation == nil || got.Confirmation.Jkt != "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I" {
+				t.Errorf("jwtValidator.Validate() returned unexpected confirmation %v", got.Confirmation)
+			}
+		})
+	}
+}
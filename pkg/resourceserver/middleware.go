@@ -0,0 +1,102 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/dpop"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+)
+
+type contextKey string
+
+func (c contextKey) String() string {
+	return "zntr.io/solid/pkg/resourceserver/" + string(c)
+}
+
+var contextKeyAccessToken = contextKey("access_token")
+
+// FromContext returns the validated access token bound to the context.
+func FromContext(ctx context.Context) (*corev1.Token, bool) {
+	token, ok := ctx.Value(contextKeyAccessToken).(*corev1.Token)
+	return token, ok
+}
+
+// Inject access token instance in context.
+func Inject(ctx context.Context, token *corev1.Token) context.Context {
+	return context.WithValue(ctx, contextKeyAccessToken, token)
+}
+
+// -----------------------------------------------------------------------------
+
+// Middleware returns an HTTP middleware rejecting unauthenticated requests.
+// The validated access token is available using FromContext.
+func Middleware(authenticator Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Authenticate request
+			token, err := authenticator.Authenticate(r)
+			if err != nil {
+				WriteError(w, err)
+				return
+			}
+
+			// Delegate to next handler
+			next.ServeHTTP(w, r.WithContext(Inject(r.Context(), token)))
+		})
+	}
+}
+
+// WriteError sends the authentication error using RFC6750 and RFC9449
+// WWW-Authenticate challenges.
+func WriteError(w http.ResponseWriter, err error) {
+	var authErr *Error
+	if !errors.As(err, &authErr) {
+		authErr = &Error{
+			StatusCode: http.StatusInternalServerError,
+			Err:        rfcerrors.ServerError().Build(),
+			Cause:      err,
+		}
+	}
+
+	// Set challenges
+	for _, challenge := range authErr.Challenges {
+		w.Header().Add("WWW-Authenticate", challenge)
+	}
+
+	// Set fresh nonce
+	if authErr.Nonce != "" {
+		w.Header().Set(dpop.NonceHeader, authErr.Nonce)
+	}
+
+	// No body when authentication information are missing
+	if authErr.Err == nil {
+		w.WriteHeader(authErr.StatusCode)
+		return
+	}
+
+	// Write json error
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(authErr.StatusCode)
+	json.NewEncoder(w).Encode(authErr.Err)
+}
@@ -0,0 +1,126 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourceserver
+
+import (
+	"net/http"
+	"time"
+
+	"zntr.io/solid/pkg/sdk/dpop"
+)
+
+const (
+	// DefaultClockSkew defines the default time tolerance for token time claims.
+	DefaultClockSkew = 15 * time.Second
+)
+
+var (
+	// DefaultSupportedAlgorithms defines the default accepted access token
+	// signature algorithms.
+	DefaultSupportedAlgorithms = []string{"ES256", "ES384", "PS256", "EdDSA"}
+)
+
+type jwtOptions struct {
+	supportedAlgorithms []string
+	clockSkew           time.Duration
+}
+
+// JWTOption defines functional option pattern function for JWT access token
+// validator.
+type JWTOption func(*jwtOptions)
+
+// SupportedAlgorithms overrides the accepted access token signature algorithms.
+func SupportedAlgorithms(algs ...string) JWTOption {
+	return func(opts *jwtOptions) {
+		opts.supportedAlgorithms = algs
+	}
+}
+
+// ClockSkew sets the time tolerance used to check token time claims.
+func ClockSkew(d time.Duration) JWTOption {
+	return func(opts *jwtOptions) {
+		opts.clockSkew = d
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type introspectionOptions struct {
+	httpClient *http.Client
+	assertion  AssertionFunc
+}
+
+// IntrospectionOption defines functional option pattern function for remote
+// introspection validator.
+type IntrospectionOption func(*introspectionOptions)
+
+// HTTPClient sets the HTTP client used to query the introspection endpoint.
+func HTTPClient(client *http.Client) IntrospectionOption {
+	return func(opts *introspectionOptions) {
+		opts.httpClient = client
+	}
+}
+
+// ClientAssertion sets the client assertion producer used to authenticate the
+// resource server to the introspection endpoint.
+func ClientAssertion(assertion AssertionFunc) IntrospectionOption {
+	return func(opts *introspectionOptions) {
+		opts.assertion = assertion
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type options struct {
+	introspection TokenValidator
+	dpopVerifier  dpop.Verifier
+	dpopNonces    dpop.NonceProvider
+	realm         string
+}
+
+// Option defines functional option pattern function for authenticator.
+type Option func(*options)
+
+// Introspection sets the validator used for opaque access tokens.
+func Introspection(validator TokenValidator) Option {
+	return func(opts *options) {
+		opts.introspection = validator
+	}
+}
+
+// DPoP enables DPoP-bound access token using the given proof verifier.
+func DPoP(verifier dpop.Verifier) Option {
+	return func(opts *options) {
+		opts.dpopVerifier = verifier
+	}
+}
+
+// DPoPNonces sets the nonce provider used to send a fresh nonce to clients
+// when the proof verifier requires one.
+func DPoPNonces(provider dpop.NonceProvider) Option {
+	return func(opts *options) {
+		opts.dpopNonces = provider
+	}
+}
+
+// Realm sets the realm advertised in WWW-Authenticate challenges.
+func Realm(value string) Option {
+	return func(opts *options) {
+		opts.realm = value
+	}
+}
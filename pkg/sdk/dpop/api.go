@@ -19,6 +19,7 @@ package dpop
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
@@ -29,18 +30,40 @@ const (
 	ExpirationTreshold = 15 * time.Second
 	// JTICodeLength defines JTI claim string length
 	JTICodeLength = 16
+	// NonceHeader defines the HTTP header used to transmit server-issued nonces
+	NonceHeader = "DPoP-Nonce"
+	// NonceLength defines generated nonce string length
+	NonceLength = 32
+	// DefaultNonceRotationInterval defines the default nonce lifetime
+	DefaultNonceRotationInterval = 5 * time.Minute
 )
 
+// ErrUseNonce is raised when the proof doesn't contain the expected
+// server-issued nonce. The client must retry with a fresh nonce.
+var ErrUseNonce = errors.New("dpop: proof must use the server provided nonce")
+
 //go:generate mockgen -destination mock/authentication_processor.gen.go -package mock zntr.io/solid/pkg/sdk/dpop Prover
 
 // Prover describes prover contract
 type Prover interface {
-	Prove(htm string, htu string) (string, error)
+	Prove(htm string, htu string, opts ...ProofOption) (string, error)
 }
 
 //go:generate mockgen -destination mock/authentication_processor.gen.go -package mock zntr.io/solid/pkg/sdk/dpop Verifier
 
 // Verifier describes proof verifier contract.
 type Verifier interface {
-	Verify(ctx context.Context, htm, htu, proof string) (string, error)
+	Verify(ctx context.Context, htm, htu, proof string, opts ...ProofOption) (string, error)
+	// SupportedAlgorithms returns the accepted proof signature algorithms.
+	SupportedAlgorithms() []string
+}
+
+//go:generate mockgen -destination mock/nonce_provider.gen.go -package mock zntr.io/solid/pkg/sdk/dpop NonceProvider
+
+// NonceProvider describes server-issued nonce contract.
+type NonceProvider interface {
+	// Nonce returns the current nonce to send to clients.
+	Nonce(ctx context.Context) (string, error)
+	// Validate returns true when the given nonce is still accepted.
+	Validate(ctx context.Context, nonce string) (bool, error)
 }
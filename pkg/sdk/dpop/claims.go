@@ -18,8 +18,10 @@
 package dpop
 
 type proofClaims struct {
-	JTI        string `json:"jti"`
-	HTTPMethod string `json:"htm"`
-	HTTPURL    string `json:"htu"`
-	IssuedAt   uint64 `json:"iat"`
+	JTI             string `json:"jti"`
+	HTTPMethod      string `json:"htm"`
+	HTTPURL         string `json:"htu"`
+	IssuedAt        uint64 `json:"iat"`
+	Nonce           string `json:"nonce,omitempty"`
+	AccessTokenHash string `json:"ath,omitempty"`
 }
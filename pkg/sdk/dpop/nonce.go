@@ -0,0 +1,122 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dpop
+
+import (
+	"context"
+	"crypto/subtle"
+	"sync"
+	"time"
+
+	"github.com/dchest/uniuri"
+)
+
+var timeFunc = time.Now
+
+// DefaultNonceProvider returns an in-memory nonce provider. The nonce is
+// rotated at each interval, the previous one is still accepted during the
+// following interval to let in-flight requests complete.
+func DefaultNonceProvider(opts ...NonceOption) NonceProvider {
+	// Default options
+	defaultOptions := &nonceOptions{
+		rotationInterval: DefaultNonceRotationInterval,
+	}
+
+	// Apply param functions
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	return &defaultNonceProvider{
+		rotationInterval: defaultOptions.rotationInterval,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type defaultNonceProvider struct {
+	sync.Mutex
+	rotationInterval time.Duration
+	current          string
+	previous         string
+	rotatedAt        time.Time
+}
+
+func (p *defaultNonceProvider) Nonce(ctx context.Context) (string, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	// Rotate if needed
+	p.rotate()
+
+	// No error
+	return p.current, nil
+}
+
+func (p *defaultNonceProvider) Validate(ctx context.Context, nonce string) (bool, error) {
+	// Check arguments
+	if nonce == "" {
+		return false, nil
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	// Rotate if needed
+	p.rotate()
+
+	// Check current and previous nonces
+	if subtle.ConstantTimeCompare([]byte(nonce), []byte(p.current)) == 1 {
+		return true, nil
+	}
+	if p.previous != "" && subtle.ConstantTimeCompare([]byte(nonce), []byte(p.previous)) == 1 {
+		return true, nil
+	}
+
+	// No match
+	return false, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (p *defaultNonceProvider) rotate() {
+	now := timeFunc()
+
+	// First use
+	if p.current == "" {
+		p.current = uniuri.NewLen(NonceLength)
+		p.rotatedAt = now
+		return
+	}
+
+	// Check expiration
+	elapsed := now.Sub(p.rotatedAt)
+	if elapsed < p.rotationInterval {
+		return
+	}
+
+	// Previous nonce is kept only if the current one just expired
+	p.previous = ""
+	if elapsed < 2*p.rotationInterval {
+		p.previous = p.current
+	}
+
+	// Generate a new nonce
+	p.current = uniuri.NewLen(NonceLength)
+	p.rotatedAt = now
+}
@@ -0,0 +1,87 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dpop
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func Test_defaultNonceProvider(t *testing.T) {
+	defer func() {
+		timeFunc = time.Now
+	}()
+
+	now := time.Unix(1600000000, 0)
+	timeFunc = func() time.Time { return now }
+
+	ctx := context.Background()
+	underTest := DefaultNonceProvider(NonceRotationInterval(time.Minute))
+
+	// Empty nonce
+	if valid, _ := underTest.Validate(ctx, ""); valid {
+		t.Fatal("empty nonce must not be accepted")
+	}
+
+	// Initial nonce
+	first, err := underTest.Nonce(ctx)
+	if err != nil {
+		t.Fatalf("unable to retrieve nonce: %v", err)
+	}
+	if len(first) != NonceLength {
+		t.Fatalf("nonce length = %d, want %d", len(first), NonceLength)
+	}
+	if valid, _ := underTest.Validate(ctx, first); !valid {
+		t.Fatal("current nonce must be accepted")
+	}
+	if valid, _ := underTest.Validate(ctx, "foo"); valid {
+		t.Fatal("unknown nonce must not be accepted")
+	}
+
+	// Stable during interval
+	now = now.Add(30 * time.Second)
+	if again, _ := underTest.Nonce(ctx); again != first {
+		t.Fatal("nonce must not be rotated before interval")
+	}
+
+	// Rotation keeps previous nonce
+	now = now.Add(45 * time.Second)
+	second, _ := underTest.Nonce(ctx)
+	if second == first {
+		t.Fatal("nonce must be rotated after interval")
+	}
+	if valid, _ := underTest.Validate(ctx, first); !valid {
+		t.Fatal("previous nonce must be accepted after rotation")
+	}
+
+	// Previous nonce expires after next rotation
+	now = now.Add(61 * time.Second)
+	if valid, _ := underTest.Validate(ctx, first); valid {
+		t.Fatal("expired nonce must not be accepted")
+	}
+	if valid, _ := underTest.Validate(ctx, second); !valid {
+		t.Fatal("previous nonce must be accepted after rotation")
+	}
+
+	// Long inactivity discards all nonces
+	now = now.Add(time.Hour)
+	if valid, _ := underTest.Validate(ctx, second); valid {
+		t.Fatal("stale nonce must not be accepted")
+	}
+}
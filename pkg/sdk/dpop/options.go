@@ -0,0 +1,77 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dpop
+
+import "time"
+
+type proofOptions struct {
+	nonce       string
+	accessToken string
+}
+
+// ProofOption defines functional option pattern function for proof generation.
+type ProofOption func(*proofOptions)
+
+// Nonce sets the server-issued nonce to embed in the proof.
+func Nonce(value string) ProofOption {
+	return func(opts *proofOptions) {
+		opts.nonce = value
+	}
+}
+
+// AccessToken binds the proof to the given access token using the `ath` claim.
+// The verifier rejects proofs without a matching access token hash.
+func AccessToken(value string) ProofOption {
+	return func(opts *proofOptions) {
+		opts.accessToken = value
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type verifierOptions struct {
+	nonces NonceProvider
+}
+
+// VerifierOption defines functional option pattern function for proof verifier.
+type VerifierOption func(*verifierOptions)
+
+// Nonces enables server-issued nonce verification using the given provider.
+func Nonces(provider NonceProvider) VerifierOption {
+	return func(opts *verifierOptions) {
+		opts.nonces = provider
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type nonceOptions struct {
+	rotationInterval time.Duration
+}
+
+// NonceOption defines functional option pattern function for nonce provider.
+type NonceOption func(*nonceOptions)
+
+// NonceRotationInterval sets the nonce lifetime before rotation.
+func NonceRotationInterval(value time.Duration) NonceOption {
+	return func(opts *nonceOptions) {
+		if value > 0 {
+			opts.rotationInterval = value
+		}
+	}
+}
@@ -52,7 +52,7 @@ type defaultProver struct {
 	signer jwt.Signer
 }
 
-func (p *defaultProver) Prove(htm, htu string) (string, error) {
+func (p *defaultProver) Prove(htm, htu string, opts ...ProofOption) (string, error) {
 	// Check parameters
 	if htm == "" {
 		return "", fmt.Errorf("htm must not be blank")
@@ -75,12 +75,24 @@ func (p *defaultProver) Prove(htm, htu string) (string, error) {
 		return "", fmt.Errorf("invalid HTTP Method in proof '%s'", htm)
 	}
 
+	// Apply param functions
+	dopts := &proofOptions{}
+	for _, o := range opts {
+		o(dopts)
+	}
+
 	// Create proof claims
 	claims := &proofClaims{
 		JTI:        uniuri.NewLen(JTICodeLength),
 		HTTPMethod: htm,
 		HTTPURL:    fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, u.Path),
 		IssuedAt:   uint64(time.Now().UTC().Unix()),
+		Nonce:      dopts.nonce,
+	}
+
+	// Bind proof to access token
+	if dopts.accessToken != "" {
+		claims.AccessTokenHash = AccessTokenHash(dopts.accessToken)
 	}
 
 	// Sign claims
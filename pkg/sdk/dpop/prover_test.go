@@ -64,8 +64,9 @@ func Test_defaultProver_Prove(t *testing.T) {
 		signer jwt.Signer
 	}
 	type args struct {
-		htm string
-		htu string
+		htm  string
+		htu  string
+		opts []ProofOption
 	}
 	tests := []struct {
 		name    string
@@ -133,6 +134,24 @@ func Test_defaultProver_Prove(t *testing.T) {
 			wantErr: false,
 			want:    "fake-token",
 		},
+		{
+			name: "valid with nonce",
+			args: args{
+				htm:  "POST",
+				htu:  "https://server.com/resource",
+				opts: []ProofOption{Nonce("server-nonce")},
+			},
+			prepare: func(signer *jwtmock.MockSigner) {
+				signer.EXPECT().Sign(gomock.Any()).DoAndReturn(func(claims interface{}) (string, error) {
+					if c, ok := claims.(*proofClaims); !ok || c.Nonce != "server-nonce" {
+						return "", fmt.Errorf("nonce claim is missing")
+					}
+					return "fake-token", nil
+				})
+			},
+			wantErr: false,
+			want:    "fake-token",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -147,7 +166,7 @@ func Test_defaultProver_Prove(t *testing.T) {
 			}
 
 			p, _ := DefaultProver(mockSigner)
-			got, err := p.Prove(tt.args.htm, tt.args.htu)
+			got, err := p.Prove(tt.args.htm, tt.args.htu, tt.args.opts...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("defaultProver.Prove() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1,6 +1,8 @@
 package dpop
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 )
@@ -19,3 +21,10 @@ func CleanURL(r *http.Request) string {
 	// Assemble response
 	return fmt.Sprintf("%s://%s%s", scheme, r.Host, r.URL.Path)
 }
+
+// AccessTokenHash returns the `ath` claim value for the given access token.
+// https://datatracker.ietf.org/doc/html/rfc9449#section-4.2
+func AccessTokenHash(accessToken string) string {
+	h := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
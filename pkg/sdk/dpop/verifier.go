@@ -19,6 +19,7 @@ package dpop
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -28,6 +29,7 @@ import (
 
 	"golang.org/x/crypto/blake2b"
 
+	"zntr.io/solid/pkg/sdk/jwk"
 	"zntr.io/solid/pkg/sdk/jwt"
 	"zntr.io/solid/pkg/sdk/types"
 	"zntr.io/solid/pkg/server/storage"
@@ -37,7 +39,7 @@ import (
 
 // DefaultVerifier returns a verifier instance with in-memory cache for proof
 // storage.
-func DefaultVerifier(proofs storage.DPoP, verifier jwt.Verifier) (Verifier, error) {
+func DefaultVerifier(proofs storage.DPoP, verifier jwt.Verifier, opts ...VerifierOption) (Verifier, error) {
 	// Check arguments
 	if types.IsNil(proofs) {
 		return nil, fmt.Errorf("proof storage is mandatory and couldn't be nil")
@@ -46,10 +48,17 @@ func DefaultVerifier(proofs storage.DPoP, verifier jwt.Verifier) (Verifier, erro
 		return nil, fmt.Errorf("jwt verifier is mandatory and couldn't be nil")
 	}
 
+	// Apply param functions
+	dopts := &verifierOptions{}
+	for _, o := range opts {
+		o(dopts)
+	}
+
 	// No error
 	return &defaultVerifier{
 		proofs:   proofs,
 		verifier: verifier,
+		nonces:   dopts.nonces,
 	}, nil
 }
 
@@ -58,11 +67,16 @@ func DefaultVerifier(proofs storage.DPoP, verifier jwt.Verifier) (Verifier, erro
 type defaultVerifier struct {
 	proofs   storage.DPoP
 	verifier jwt.Verifier
+	nonces   NonceProvider
+}
+
+func (v *defaultVerifier) SupportedAlgorithms() []string {
+	return v.verifier.SupportedAlgorithms()
 }
 
 // Verify given DPoP proof.
 // https://www.ietf.org/id/draft-ietf-oauth-dpop-01.html#section-4.2
-func (v *defaultVerifier) Verify(ctx context.Context, htm, htu, proof string) (string, error) {
+func (v *defaultVerifier) Verify(ctx context.Context, htm, htu, proof string, opts ...ProofOption) (string, error) {
 	// Check parameters
 	if htm == "" {
 		return "", fmt.Errorf("htm must not be blank")
@@ -111,6 +125,22 @@ func (v *defaultVerifier) Verify(ctx context.Context, htm, htu, proof string) (s
 		return "", errJti
 	}
 
+	// Apply param functions
+	dopts := &proofOptions{}
+	for _, o := range opts {
+		o(dopts)
+	}
+
+	// Check access token binding
+	if errAth := v.checkProofAccessToken(dopts.accessToken, claims); errAth != nil {
+		return "", errAth
+	}
+
+	// Check server-issued nonce
+	if errNonce := v.checkProofNonce(ctx, claims); errNonce != nil {
+		return "", errNonce
+	}
+
 	// Check if exists
 	if errCache := v.checkProofCache(ctx, jtiHash); errCache != nil {
 		return "", errCache
@@ -150,6 +180,18 @@ func (v *defaultVerifier) validateProofHeader(proof jwt.Token) error {
 		return fmt.Errorf("proof has not a valid jwt syntax, the embedded public is invalid")
 	}
 
+	// Check algorithm
+	alg, err := proof.Algorithm()
+	if err != nil {
+		return fmt.Errorf("proof has not a valid jwt syntax, valid 'alg' header is mandatory")
+	}
+	if !types.StringArray(v.verifier.SupportedAlgorithms()).Contains(alg) {
+		return fmt.Errorf("proof uses an invalid or not supported algorithm '%s'", alg)
+	}
+	if err := jwk.CheckAlgorithm(pubJWK, alg); err != nil {
+		return fmt.Errorf("proof embedded public key can't be used with '%s' algorithm: %w", alg, err)
+	}
+
 	// No error
 	return nil
 }
@@ -216,6 +258,50 @@ func (v *defaultVerifier) validateProofClaims(htm, htu string, claims *proofClai
 	return jtiStorage, nil
 }
 
+func (v *defaultVerifier) checkProofAccessToken(accessToken string, claims *proofClaims) error {
+	// Skip if proof is not used with an access token
+	if accessToken == "" {
+		return nil
+	}
+
+	// Check access token hash presence
+	if claims.AccessTokenHash == "" {
+		return fmt.Errorf("invalid proof: access token hash is missing")
+	}
+
+	// Compare hashes
+	if subtle.ConstantTimeCompare([]byte(claims.AccessTokenHash), []byte(AccessTokenHash(accessToken))) != 1 {
+		return fmt.Errorf("invalid proof: access token hash don't match")
+	}
+
+	// No error
+	return nil
+}
+
+func (v *defaultVerifier) checkProofNonce(ctx context.Context, claims *proofClaims) error {
+	// Skip if nonce are not enabled
+	if types.IsNil(v.nonces) {
+		return nil
+	}
+
+	// Check nonce presence
+	if claims.Nonce == "" {
+		return fmt.Errorf("invalid proof: nonce is missing: %w", ErrUseNonce)
+	}
+
+	// Validate with provider
+	valid, err := v.nonces.Validate(ctx, claims.Nonce)
+	if err != nil {
+		return fmt.Errorf("unable to validate proof nonce: %w", err)
+	}
+	if !valid {
+		return fmt.Errorf("invalid proof: nonce is invalid or expired: %w", ErrUseNonce)
+	}
+
+	// No error
+	return nil
+}
+
 func (v *defaultVerifier) checkProofCache(ctx context.Context, jtiHash string) error {
 	// Check existence
 	valid, err := v.proofs.Exists(ctx, jtiHash)
@@ -19,12 +19,15 @@ package dpop
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/square/go-jose/v3"
 
 	"zntr.io/solid/pkg/sdk/jwt"
 	jwtmock "zntr.io/solid/pkg/sdk/jwt/mock"
@@ -32,6 +35,14 @@ import (
 	storagemock "zntr.io/solid/pkg/server/storage/mock"
 )
 
+var proofPublicKey = func() *jose.JSONWebKey {
+	var k jose.JSONWebKey
+	if err := json.Unmarshal([]byte(`{"kty": "EC","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk"}`), &k); err != nil {
+		panic(err)
+	}
+	return &k
+}()
+
 func TestDefaultVerifier(t *testing.T) {
 	type args struct {
 		proofs   storage.DPoP
@@ -180,7 +191,8 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			},
 			prepare: func(_ *storagemock.MockDPoP, verifier *jwtmock.MockVerifier, token *jwtmock.MockToken) {
 				token.EXPECT().Type().Return(HeaderType, nil)
-				token.EXPECT().PublicKey().Return(&struct{}{}, nil).Times(2)
+				token.EXPECT().PublicKey().Return(proofPublicKey, nil).Times(2)
+				token.EXPECT().Algorithm().Return("ES256", nil)
 				token.EXPECT().Claims(gomock.Any(), gomock.Any()).Return(fmt.Errorf("foo"))
 				verifier.EXPECT().Parse("fake-proof").Return(token, nil)
 			},
@@ -195,7 +207,8 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			},
 			prepare: func(_ *storagemock.MockDPoP, verifier *jwtmock.MockVerifier, token *jwtmock.MockToken) {
 				token.EXPECT().Type().Return(HeaderType, nil)
-				token.EXPECT().PublicKey().Return(&struct{}{}, nil).Times(2)
+				token.EXPECT().PublicKey().Return(proofPublicKey, nil).Times(2)
+				token.EXPECT().Algorithm().Return("ES256", nil)
 				token.EXPECT().Claims(gomock.Any(), gomock.Any()).Return(nil)
 				verifier.EXPECT().Parse("fake-proof").Return(token, nil)
 			},
@@ -211,7 +224,8 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			prepare: func(proofs *storagemock.MockDPoP, verifier *jwtmock.MockVerifier, token *jwtmock.MockToken) {
 				verifier.EXPECT().Parse("fake-proof").Return(token, nil)
 				token.EXPECT().Type().Return(HeaderType, nil)
-				token.EXPECT().PublicKey().Return(&struct{}{}, nil).Times(2)
+				token.EXPECT().PublicKey().Return(proofPublicKey, nil).Times(2)
+				token.EXPECT().Algorithm().Return("ES256", nil)
 				token.EXPECT().Claims(gomock.Any(), gomock.Any()).Do(func(key interface{}, claims interface{}) {
 					switch v := claims.(type) {
 					case *proofClaims:
@@ -237,7 +251,8 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			prepare: func(proofs *storagemock.MockDPoP, verifier *jwtmock.MockVerifier, token *jwtmock.MockToken) {
 				verifier.EXPECT().Parse("fake-proof").Return(token, nil)
 				token.EXPECT().Type().Return(HeaderType, nil)
-				token.EXPECT().PublicKey().Return(&struct{}{}, nil).Times(2)
+				token.EXPECT().PublicKey().Return(proofPublicKey, nil).Times(2)
+				token.EXPECT().Algorithm().Return("ES256", nil)
 				token.EXPECT().Claims(gomock.Any(), gomock.Any()).Do(func(key interface{}, claims interface{}) {
 					switch v := claims.(type) {
 					case *proofClaims:
@@ -263,7 +278,8 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			prepare: func(proofs *storagemock.MockDPoP, verifier *jwtmock.MockVerifier, token *jwtmock.MockToken) {
 				verifier.EXPECT().Parse("fake-proof").Return(token, nil)
 				token.EXPECT().Type().Return(HeaderType, nil)
-				token.EXPECT().PublicKey().Return(&struct{}{}, nil).Times(2)
+				token.EXPECT().PublicKey().Return(proofPublicKey, nil).Times(2)
+				token.EXPECT().Algorithm().Return("ES256", nil)
 				token.EXPECT().Claims(gomock.Any(), gomock.Any()).Do(func(key interface{}, claims interface{}) {
 					switch v := claims.(type) {
 					case *proofClaims:
@@ -290,7 +306,8 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			prepare: func(proofs *storagemock.MockDPoP, verifier *jwtmock.MockVerifier, token *jwtmock.MockToken) {
 				verifier.EXPECT().Parse("fake-proof").Return(token, nil)
 				token.EXPECT().Type().Return(HeaderType, nil)
-				token.EXPECT().PublicKey().Return(&struct{}{}, nil).Times(2)
+				token.EXPECT().PublicKey().Return(proofPublicKey, nil).Times(2)
+				token.EXPECT().Algorithm().Return("ES256", nil)
 				token.EXPECT().Claims(gomock.Any(), gomock.Any()).Do(func(key interface{}, claims interface{}) {
 					switch v := claims.(type) {
 					case *proofClaims:
@@ -318,7 +335,8 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			prepare: func(proofs *storagemock.MockDPoP, verifier *jwtmock.MockVerifier, token *jwtmock.MockToken) {
 				verifier.EXPECT().Parse("fake-proof").Return(token, nil)
 				token.EXPECT().Type().Return(HeaderType, nil)
-				token.EXPECT().PublicKey().Return(&struct{}{}, nil).Times(2)
+				token.EXPECT().PublicKey().Return(proofPublicKey, nil).Times(2)
+				token.EXPECT().Algorithm().Return("ES256", nil)
 				token.EXPECT().Claims(gomock.Any(), gomock.Any()).Do(func(key interface{}, claims interface{}) {
 					switch v := claims.(type) {
 					case *proofClaims:
@@ -346,6 +364,7 @@ func Test_defaultVerifier_Verify(t *testing.T) {
 			mockStorage := storagemock.NewMockDPoP(ctrl)
 			mockVerifier := jwtmock.NewMockVerifier(ctrl)
 			mockToken := jwtmock.NewMockToken(ctrl)
+			mockVerifier.EXPECT().SupportedAlgorithms().Return([]string{"ES256"}).AnyTimes()
 
 			// Prepare mocks
 			if tt.prepare != nil {
@@ -429,13 +448,53 @@ func Test_defaultVerifier_validateProofHeader(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "algorithm error",
+			args: args{
+				token: func(ctrl *gomock.Controller) jwt.Token {
+					mockToken := jwtmock.NewMockToken(ctrl)
+					mockToken.EXPECT().Type().Return(HeaderType, nil)
+					mockToken.EXPECT().PublicKey().Return(proofPublicKey, nil)
+					mockToken.EXPECT().Algorithm().Return("", fmt.Errorf("foo"))
+					return mockToken
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported algorithm",
+			args: args{
+				token: func(ctrl *gomock.Controller) jwt.Token {
+					mockToken := jwtmock.NewMockToken(ctrl)
+					mockToken.EXPECT().Type().Return(HeaderType, nil)
+					mockToken.EXPECT().PublicKey().Return(proofPublicKey, nil)
+					mockToken.EXPECT().Algorithm().Return("HS256", nil)
+					return mockToken
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "algorithm / key mismatch",
+			args: args{
+				token: func(ctrl *gomock.Controller) jwt.Token {
+					mockToken := jwtmock.NewMockToken(ctrl)
+					mockToken.EXPECT().Type().Return(HeaderType, nil)
+					mockToken.EXPECT().PublicKey().Return(proofPublicKey, nil)
+					mockToken.EXPECT().Algorithm().Return("ES384", nil)
+					return mockToken
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "valid",
 			args: args{
 				token: func(ctrl *gomock.Controller) jwt.Token {
 					mockToken := jwtmock.NewMockToken(ctrl)
 					mockToken.EXPECT().Type().Return(HeaderType, nil)
-					mockToken.EXPECT().PublicKey().Return(&struct{}{}, nil)
+					mockToken.EXPECT().PublicKey().Return(proofPublicKey, nil)
+					mockToken.EXPECT().Algorithm().Return("ES256", nil)
 					return mockToken
 				},
 			},
@@ -449,6 +508,7 @@ func Test_defaultVerifier_validateProofHeader(t *testing.T) {
 
 			mockStorage := storagemock.NewMockDPoP(ctrl)
 			mockVerifier := jwtmock.NewMockVerifier(ctrl)
+			mockVerifier.EXPECT().SupportedAlgorithms().Return([]string{"ES256", "ES384"}).AnyTimes()
 
 			v := &defaultVerifier{
 				proofs:   mockStorage,
@@ -664,3 +724,146 @@ func Test_defaultVerifier_validateProofClaims(t *testing.T) {
 		})
 	}
 }
+
+type nonceProviderFunc func(context.Context, string) (bool, error)
+
+func (f nonceProviderFunc) Nonce(_ context.Context) (string, error) { return "", nil }
+func (f nonceProviderFunc) Validate(ctx context.Context, nonce string) (bool, error) {
+	return f(ctx, nonce)
+}
+
+func Test_defaultVerifier_checkProofNonce(t *testing.T) {
+	type args struct {
+		claims *proofClaims
+	}
+	tests := []struct {
+		name         string
+		nonces       NonceProvider
+		args         args
+		wantErr      bool
+		wantUseNonce bool
+	}{
+		{
+			name: "nonce disabled",
+			args: args{
+				claims: &proofClaims{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing nonce",
+			nonces: nonceProviderFunc(func(_ context.Context, _ string) (bool, error) {
+				return true, nil
+			}),
+			args: args{
+				claims: &proofClaims{},
+			},
+			wantErr:      true,
+			wantUseNonce: true,
+		},
+		{
+			name: "provider error",
+			nonces: nonceProviderFunc(func(_ context.Context, _ string) (bool, error) {
+				return false, fmt.Errorf("foo")
+			}),
+			args: args{
+				claims: &proofClaims{Nonce: "foo"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid nonce",
+			nonces: nonceProviderFunc(func(_ context.Context, _ string) (bool, error) {
+				return false, nil
+			}),
+			args: args{
+				claims: &proofClaims{Nonce: "foo"},
+			},
+			wantErr:      true,
+			wantUseNonce: true,
+		},
+		{
+			name: "valid",
+			nonces: nonceProviderFunc(func(_ context.Context, nonce string) (bool, error) {
+				return nonce == "foo", nil
+			}),
+			args: args{
+				claims: &proofClaims{Nonce: "foo"},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &defaultVerifier{
+				nonces: tt.nonces,
+			}
+			err := v.checkProofNonce(context.Background(), tt.args.claims)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("defaultVerifier.checkProofNonce() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrUseNonce) != tt.wantUseNonce {
+				t.Errorf("defaultVerifier.checkProofNonce() error = %v, want use nonce %v", err, tt.wantUseNonce)
+			}
+		})
+	}
+}
+
+func Test_defaultVerifier_checkProofAccessToken(t *testing.T) {
+	type args struct {
+		accessToken string
+		claims      *proofClaims
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "missing ath",
+			args: args{
+				accessToken: "Kz~8mXK1EalYznwH-LC-1fBAo.4Ljp~zsPE_NeO.gxU",
+				claims:      &proofClaims{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ath mismatch",
+			args: args{
+				accessToken: "Kz~8mXK1EalYznwH-LC-1fBAo.4Ljp~zsPE_NeO.gxU",
+				claims: &proofClaims{
+					AccessTokenHash: "foo",
+				},
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "no access token",
+			args: args{
+				claims: &proofClaims{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			args: args{
+				accessToken: "Kz~8mXK1EalYznwH-LC-1fBAo.4Ljp~zsPE_NeO.gxU",
+				claims: &proofClaims{
+					// https://datatracker.ietf.org/doc/html/rfc9449#section-7.1
+					AccessTokenHash: "fUHyO2r2Z3DZ53EsNrWBb0xWXoaNy59IiKCAqksmQEo",
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &defaultVerifier{}
+			if err := v.checkProofAccessToken(tt.args.accessToken, tt.args.claims); (err != nil) != tt.wantErr {
+				t.Errorf("defaultVerifier.checkProofAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
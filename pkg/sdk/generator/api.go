@@ -57,3 +57,10 @@ type DeviceUserCode interface {
 type ClientID interface {
 	Generate(ctx context.Context) (string, error)
 }
+
+//go:generate mockgen -destination mock/initial_access_token.gen.go -package mock zntr.io/solid/pkg/sdk/generator InitialAccessToken
+
+// InitialAccessToken describes client registration initial access token generator contract.
+type InitialAccessToken interface {
+	Generate(ctx context.Context) (string, error)
+}
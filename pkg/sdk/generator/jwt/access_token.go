@@ -31,11 +31,44 @@ import (
 
 // -----------------------------------------------------------------------------
 
-// AccessToken instantiate a JWT access token generator.
-func AccessToken(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc) generator.Token {
+// AccessToken instantiate a JWT access token generator. The given algorithm
+// is used by default, it could be overridden per audience.
+func AccessToken(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc, opts ...AccessTokenOption) generator.Token {
+	// Default options
+	defaultOptions := &accessTokenOptions{
+		audienceAlgorithms: map[string]jose.SignatureAlgorithm{},
+	}
+
+	// Apply param functions
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	return &accessTokenGenerator{
+		alg:                alg,
+		keyProvider:        keyProvider,
+		audienceAlgorithms: defaultOptions.audienceAlgorithms,
+	}
+}
+
+// OpaqueAccessToken instantiate a JWT access token generator delegating
+// signatures to the given opaque signer.
+func OpaqueAccessToken(alg jose.SignatureAlgorithm, signer jose.OpaqueSigner) generator.Token {
 	return &accessTokenGenerator{
-		alg:         alg,
-		keyProvider: keyProvider,
+		alg: alg,
+		keyProvider: func(_ context.Context) (*jose.JSONWebKey, error) {
+			// Check arguments
+			if signer == nil {
+				return nil, fmt.Errorf("unable to use nil signer")
+			}
+
+			// Wrap signer with its public key identifier
+			return &jose.JSONWebKey{
+				Key:       signer,
+				KeyID:     signer.Public().KeyID,
+				Algorithm: string(alg),
+			}, nil
+		},
 	}
 }
 
@@ -45,8 +78,9 @@ func AccessToken(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc) g
 type KeyProviderFunc func() (*jose.JSONWebKey, error)
 
 type accessTokenGenerator struct {
-	alg         jose.SignatureAlgorithm
-	keyProvider jwk.KeyProviderFunc
+	alg                jose.SignatureAlgorithm
+	keyProvider        jwk.KeyProviderFunc
+	audienceAlgorithms map[string]jose.SignatureAlgorithm
 }
 
 func (c *accessTokenGenerator) Generate(ctx context.Context, jti string, meta *corev1.TokenMeta, cnf *corev1.TokenConfirmation) (string, error) {
@@ -61,8 +95,14 @@ func (c *accessTokenGenerator) Generate(ctx context.Context, jti string, meta *c
 		return "", fmt.Errorf("token meta must not be nil")
 	}
 
+	// Resolve audience algorithm
+	alg := c.alg
+	if audAlg, ok := c.audienceAlgorithms[meta.Audience]; ok {
+		alg = audAlg
+	}
+
 	// Retrieve signing key
-	key, err := c.keyProvider(ctx)
+	key, err := c.keyProvider(jwk.WithAlgorithm(ctx, string(alg)))
 	if err != nil {
 		return "", fmt.Errorf("unable to retrieve a signing key: %w", err)
 	}
@@ -74,13 +114,16 @@ func (c *accessTokenGenerator) Generate(ctx context.Context, jti string, meta *c
 	if key.KeyID == "" {
 		return "", fmt.Errorf("key provider returned a unidentifiable key")
 	}
+	if err := jwk.CheckAlgorithm(key, string(alg)); err != nil {
+		return "", fmt.Errorf("unable to use signing key: %w", err)
+	}
 
 	// Preapre JWT header
 	options := (&jose.SignerOptions{}).WithType("at+jwt")
 	options = options.WithHeader(jose.HeaderKey("kid"), key.KeyID)
 
 	// Prepare a signer
-	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: c.alg, Key: key}, options)
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, options)
 	if err != nil {
 		return "", fmt.Errorf("unable to prepare signer: %w", err)
 	}
@@ -99,9 +142,20 @@ func (c *accessTokenGenerator) Generate(ctx context.Context, jti string, meta *c
 
 	// If token has a confirmation
 	if cnf != nil {
+		confirmation := map[string]interface{}{}
+
 		// Add jwt key token proof
-		claims["cnf"] = map[string]interface{}{
-			"jkt": cnf.Jkt,
+		if cnf.Jkt != "" {
+			confirmation["jkt"] = cnf.Jkt
+		}
+		// Add certificate thumbprint proof
+		if cnf.X5TS256 != "" {
+			confirmation["x5t#S256"] = cnf.X5TS256
+		}
+
+		// Assign confirmation
+		if len(confirmation) > 0 {
+			claims["cnf"] = confirmation
 		}
 	}
 
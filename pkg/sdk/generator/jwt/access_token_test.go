@@ -19,14 +19,22 @@ package jwt
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/square/go-jose/v3"
+	jwt "github.com/square/go-jose/v3/jwt"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/signer"
 )
 
 var jwtPrivateKey = []byte(`{"kid":"foo", "kty": "EC","d": "olYJLJ3aiTyP44YXs0R3g1qChRKnYnk7GDxffQhAgL8","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ES256"}`)
@@ -68,6 +76,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx: context.Background(),
 				jti: "",
 			},
 			wantErr: true,
@@ -80,6 +89,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx:  context.Background(),
 				jti:  "123456789",
 				meta: nil,
 			},
@@ -93,6 +103,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx:  context.Background(),
 				jti:  "123456789",
 				meta: &corev1.TokenMeta{},
 			},
@@ -106,6 +117,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx:  context.Background(),
 				jti:  "123456789",
 				meta: &corev1.TokenMeta{},
 			},
@@ -130,6 +142,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx:  context.Background(),
 				jti:  "123456789",
 				meta: &corev1.TokenMeta{},
 			},
@@ -151,6 +164,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx: context.Background(),
 				jti: "123456789",
 				meta: &corev1.TokenMeta{
 					Issuer:    "http://localhost:8080",
@@ -178,6 +192,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx: context.Background(),
 				jti: "123456789",
 				meta: &corev1.TokenMeta{
 					Issuer:    "http://localhost:8080",
@@ -205,6 +220,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx: context.Background(),
 				jti: "123456789",
 				meta: &corev1.TokenMeta{
 					Issuer:    "http://localhost:8080",
@@ -232,6 +248,7 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 				},
 			},
 			args: args{
+				ctx: context.Background(),
 				jti: "123456789",
 				meta: &corev1.TokenMeta{
 					Issuer:    "http://localhost:8080",
@@ -246,6 +263,37 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "ec256 sign with certificate confirmation",
+			fields: fields{
+				alg: jose.ES256,
+				keyProvider: func(_ context.Context) (*jose.JSONWebKey, error) {
+					var privateKey jose.JSONWebKey
+
+					// Decode JWK
+					err := json.Unmarshal(jwtPrivateKey, &privateKey)
+					if err != nil {
+						return nil, fmt.Errorf("unable to decode JWK: %w", err)
+					}
+					return &privateKey, nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				jti: "123456789",
+				meta: &corev1.TokenMeta{
+					Issuer:    "http://localhost:8080",
+					Audience:  "azertyuiop",
+					ClientId:  "789456",
+					ExpiresAt: 3601,
+					IssuedAt:  1,
+				},
+				cnf: &corev1.TokenConfirmation{
+					X5TS256: "bwcK0esc3ACC3DB2Y5_lESsXE8o9ltc05O89jdN-dg2",
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -258,3 +306,128 @@ func Test_accessTokenGenerator_Generate(t *testing.T) {
 		})
 	}
 }
+
+func Test_OpaqueAccessToken(t *testing.T) {
+	var privateKey jose.JSONWebKey
+
+	// Decode JWK
+	if err := json.Unmarshal(jwtPrivateKey, &privateKey); err != nil {
+		t.Fatalf("unable to decode JWK: %v", err)
+	}
+
+	// Wrap as opaque signer
+	s, err := signer.Local(&privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nil signer
+	if _, err := OpaqueAccessToken(jose.ES256, nil).Generate(context.Background(), "123456789", &corev1.TokenMeta{}, nil); err == nil {
+		t.Fatal("nil signer must be rejected")
+	}
+
+	// Generate token
+	raw, err := OpaqueAccessToken(jose.ES256, s).Generate(context.Background(), "123456789", &corev1.TokenMeta{
+		Issuer:    "http://localhost:8080",
+		Audience:  "azertyuiop",
+		ClientId:  "789456",
+		ExpiresAt: 3601,
+		IssuedAt:  1,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to generate token: %v", err)
+	}
+
+	// Verify token
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Headers[0].KeyID != "foo" {
+		t.Errorf("kid = %v, want foo", token.Headers[0].KeyID)
+	}
+	claims := map[string]interface{}{}
+	if err := token.Claims(privateKey.Public(), &claims); err != nil {
+		t.Errorf("unable to verify token: %v", err)
+	}
+}
+
+func Test_accessTokenGenerator_AudienceAlgorithms(t *testing.T) {
+	// Prepare one key per algorithm
+	keys := map[string]*jose.JSONWebKey{}
+	for _, alg := range jwk.SupportedSignatureAlgorithms {
+		var (
+			pk  crypto.Signer
+			err error
+		)
+		switch jose.SignatureAlgorithm(alg) {
+		case jose.ES256:
+			pk, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		case jose.ES384:
+			pk, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		case jose.PS256:
+			pk, err = rsa.GenerateKey(rand.Reader, 2048)
+		case jose.EdDSA:
+			_, pk, err = ed25519.GenerateKey(rand.Reader)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[alg] = &jose.JSONWebKey{Key: pk, KeyID: alg, Algorithm: alg}
+	}
+
+	// Select key according to algorithm hint
+	keyProvider := func(ctx context.Context) (*jose.JSONWebKey, error) {
+		alg, ok := jwk.AlgorithmFromContext(ctx)
+		if !ok {
+			return nil, fmt.Errorf("no algorithm hint")
+		}
+		return keys[alg], nil
+	}
+
+	opts := []AccessTokenOption{}
+	for _, alg := range jwk.SupportedSignatureAlgorithms {
+		opts = append(opts, AudienceAlgorithm("aud-"+alg, jose.SignatureAlgorithm(alg)))
+	}
+	underTest := AccessToken(jose.ES384, keyProvider, opts...)
+
+	for _, alg := range append([]string{""}, jwk.SupportedSignatureAlgorithms...) {
+		aud, want := "aud-"+alg, alg
+		if alg == "" {
+			aud, want = "default", string(jose.ES384)
+		}
+
+		t.Run(want+"/"+aud, func(t *testing.T) {
+			raw, err := underTest.Generate(context.Background(), "123456789", &corev1.TokenMeta{
+				Issuer:    "http://localhost:8080",
+				Audience:  aud,
+				ClientId:  "789456",
+				ExpiresAt: 3601,
+				IssuedAt:  1,
+			}, nil)
+			if err != nil {
+				t.Fatalf("unable to generate token: %v", err)
+			}
+
+			token, err := jwt.ParseSigned(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token.Headers[0].Algorithm != want {
+				t.Errorf("alg = %v, want %v", token.Headers[0].Algorithm, want)
+			}
+			claims := map[string]interface{}{}
+			if err := token.Claims(keys[want].Public(), &claims); err != nil {
+				t.Errorf("unable to verify token: %v", err)
+			}
+		})
+	}
+
+	// Algorithm / key mismatch
+	mismatch := AccessToken(jose.PS256, func(_ context.Context) (*jose.JSONWebKey, error) {
+		return keys[string(jose.ES256)], nil
+	})
+	if _, err := mismatch.Generate(context.Background(), "123456789", &corev1.TokenMeta{}, nil); err == nil {
+		t.Fatal("algorithm / key mismatch must be rejected")
+	}
+}
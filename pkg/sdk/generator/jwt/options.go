@@ -0,0 +1,37 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwt
+
+import "github.com/square/go-jose/v3"
+
+// Access token generator options holder
+type accessTokenOptions struct {
+	audienceAlgorithms map[string]jose.SignatureAlgorithm
+}
+
+// AccessTokenOption defines functional pattern function type contract.
+type AccessTokenOption func(*accessTokenOptions)
+
+// AudienceAlgorithm overrides the signature algorithm used for access tokens
+// issued for the given audience, according to the algorithms accepted by the
+// resource server.
+func AudienceAlgorithm(audience string, alg jose.SignatureAlgorithm) AccessTokenOption {
+	return func(opts *accessTokenOptions) {
+		opts.audienceAlgorithms[audience] = alg
+	}
+}
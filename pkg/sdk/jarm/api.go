@@ -28,11 +28,15 @@ const (
 	HeaderType = "jarm+jwt"
 )
 
+//go:generate mockgen -destination mock/response_decoder.gen.go -package mock zntr.io/solid/pkg/sdk/jarm ResponseDecoder
+
 // ResponseDecoder describes Authorization Response Decoder contract.
 type ResponseDecoder interface {
 	Decode(ctx context.Context, audience, response string) (*corev1.AuthorizationCodeResponse, error)
 }
 
+//go:generate mockgen -destination mock/response_encoder.gen.go -package mock zntr.io/solid/pkg/sdk/jarm ResponseEncoder
+
 // ResponseEncoder describes Authorization Response Encoder contract.
 type ResponseEncoder interface {
 	Encode(ctx context.Context, issuer string, resp *corev1.AuthorizationCodeResponse) (string, error)
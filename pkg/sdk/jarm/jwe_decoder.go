@@ -0,0 +1,64 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jarm
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwe"
+)
+
+// -----------------------------------------------------------------------------
+
+// JWEDecoder builds a nested JWE-then-JWS Response decoder instance. Signed
+// only responses are delegated as-is to the given decoder.
+// https://openid.net/specs/oauth-v2-jarm.html#section-2.4
+func JWEDecoder(decrypter jwe.Decrypter, decoder ResponseDecoder) ResponseDecoder {
+	return &jweDecoder{
+		decrypter: decrypter,
+		decoder:   decoder,
+	}
+}
+
+type jweDecoder struct {
+	decrypter jwe.Decrypter
+	decoder   ResponseDecoder
+}
+
+func (d *jweDecoder) Decode(ctx context.Context, audience, response string) (*corev1.AuthorizationCodeResponse, error) {
+	// Check arguments
+	if response == "" {
+		return nil, fmt.Errorf("response must not be blank")
+	}
+
+	// Signed only response
+	if !jwe.IsEncrypted(response) {
+		return d.decoder.Decode(ctx, audience, response)
+	}
+
+	// Decrypt response
+	signed, err := d.decrypter.Decrypt(ctx, response)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt JARM response: %w", err)
+	}
+
+	// Delegate to signed response decoder
+	return d.decoder.Decode(ctx, audience, signed)
+}
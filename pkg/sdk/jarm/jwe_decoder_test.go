@@ -0,0 +1,123 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jarm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	jarmmock "zntr.io/solid/pkg/sdk/jarm/mock"
+	jwemock "zntr.io/solid/pkg/sdk/jwe/mock"
+)
+
+func Test_jweDecoder_Decode(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		audience string
+		response string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*jwemock.MockDecrypter, *jarmmock.MockResponseDecoder)
+		want    *corev1.AuthorizationCodeResponse
+		wantErr bool
+	}{
+		{
+			name: "blank response",
+			args: args{
+				audience: "s6BhdRkqt3",
+			},
+			wantErr: true,
+		},
+		{
+			name: "decrypter error",
+			args: args{
+				audience: "s6BhdRkqt3",
+				response: "header.key.iv.ciphertext.tag",
+			},
+			prepare: func(decrypter *jwemock.MockDecrypter, _ *jarmmock.MockResponseDecoder) {
+				decrypter.EXPECT().Decrypt(gomock.Any(), "header.key.iv.ciphertext.tag").Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid signed only",
+			args: args{
+				audience: "s6BhdRkqt3",
+				response: "header.payload.signature",
+			},
+			prepare: func(_ *jwemock.MockDecrypter, decoder *jarmmock.MockResponseDecoder) {
+				decoder.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "header.payload.signature").Return(&corev1.AuthorizationCodeResponse{
+					Code: "1234567891234567890",
+				}, nil)
+			},
+			wantErr: false,
+			want: &corev1.AuthorizationCodeResponse{
+				Code: "1234567891234567890",
+			},
+		},
+		{
+			name: "valid encrypted",
+			args: args{
+				audience: "s6BhdRkqt3",
+				response: "header.key.iv.ciphertext.tag",
+			},
+			prepare: func(decrypter *jwemock.MockDecrypter, decoder *jarmmock.MockResponseDecoder) {
+				decrypter.EXPECT().Decrypt(gomock.Any(), "header.key.iv.ciphertext.tag").Return("header.payload.signature", nil)
+				decoder.EXPECT().Decode(gomock.Any(), "s6BhdRkqt3", "header.payload.signature").Return(&corev1.AuthorizationCodeResponse{
+					Code: "1234567891234567890",
+				}, nil)
+			},
+			wantErr: false,
+			want: &corev1.AuthorizationCodeResponse{
+				Code: "1234567891234567890",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			decrypter := jwemock.NewMockDecrypter(ctrl)
+			decoder := jarmmock.NewMockResponseDecoder(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(decrypter, decoder)
+			}
+
+			d := JWEDecoder(decrypter, decoder)
+			got, err := d.Decode(tt.args.ctx, tt.args.audience, tt.args.response)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("jweDecoder.Decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("jweDecoder.Decode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
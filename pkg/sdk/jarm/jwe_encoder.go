@@ -0,0 +1,59 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jarm
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwe"
+)
+
+// -----------------------------------------------------------------------------
+
+// JWEEncoder builds a nested JWE-then-JWS Response encoder instance.
+// https://openid.net/specs/oauth-v2-jarm.html#section-2.2
+func JWEEncoder(encrypter jwe.Encrypter, encoder ResponseEncoder) ResponseEncoder {
+	return &jweEncoder{
+		encrypter: encrypter,
+		encoder:   encoder,
+	}
+}
+
+type jweEncoder struct {
+	encrypter jwe.Encrypter
+	encoder   ResponseEncoder
+}
+
+func (e *jweEncoder) Encode(ctx context.Context, issuer string, resp *corev1.AuthorizationCodeResponse) (string, error) {
+	// Sign the response
+	signed, err := e.encoder.Encode(ctx, issuer, resp)
+	if err != nil {
+		return "", err
+	}
+
+	// Encrypt the signed response
+	raw, err := e.encrypter.Encrypt(ctx, signed)
+	if err != nil {
+		return "", fmt.Errorf("unable to encrypt JARM assertion: %w", err)
+	}
+
+	// No error
+	return raw, nil
+}
@@ -0,0 +1,107 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jarm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	jarmmock "zntr.io/solid/pkg/sdk/jarm/mock"
+	jwemock "zntr.io/solid/pkg/sdk/jwe/mock"
+)
+
+func Test_jweEncoder_Encode(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		issuer string
+		resp   *corev1.AuthorizationCodeResponse
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*jwemock.MockEncrypter, *jarmmock.MockResponseEncoder)
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "encoder error",
+			args: args{
+				issuer: "https://example.com",
+				resp:   &corev1.AuthorizationCodeResponse{},
+			},
+			prepare: func(_ *jwemock.MockEncrypter, encoder *jarmmock.MockResponseEncoder) {
+				encoder.EXPECT().Encode(gomock.Any(), "https://example.com", gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "encrypter error",
+			args: args{
+				issuer: "https://example.com",
+				resp:   &corev1.AuthorizationCodeResponse{},
+			},
+			prepare: func(encrypter *jwemock.MockEncrypter, encoder *jarmmock.MockResponseEncoder) {
+				encoder.EXPECT().Encode(gomock.Any(), "https://example.com", gomock.Any()).Return("header.payload.signature", nil)
+				encrypter.EXPECT().Encrypt(gomock.Any(), "header.payload.signature").Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				issuer: "https://example.com",
+				resp:   &corev1.AuthorizationCodeResponse{},
+			},
+			prepare: func(encrypter *jwemock.MockEncrypter, encoder *jarmmock.MockResponseEncoder) {
+				encoder.EXPECT().Encode(gomock.Any(), "https://example.com", gomock.Any()).Return("header.payload.signature", nil)
+				encrypter.EXPECT().Encrypt(gomock.Any(), "header.payload.signature").Return("header.key.iv.ciphertext.tag", nil)
+			},
+			wantErr: false,
+			want:    "header.key.iv.ciphertext.tag",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			encrypter := jwemock.NewMockEncrypter(ctrl)
+			encoder := jarmmock.NewMockResponseEncoder(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(encrypter, encoder)
+			}
+
+			d := JWEEncoder(encrypter, encoder)
+			got, err := d.Encode(tt.args.ctx, tt.args.issuer, tt.args.resp)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("jweEncoder.Encode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("jweEncoder.Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwe
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	// ContentTypeJWT is the content type used for nested JWT.
+	// https://tools.ietf.org/html/rfc7519#section-5.2
+	ContentTypeJWT = "JWT"
+)
+
+var (
+	// DefaultKeyAlgorithms defines the default key management algorithms.
+	DefaultKeyAlgorithms = []string{"ECDH-ES+A256KW", "RSA-OAEP-256"}
+	// DefaultContentEncryptions defines the default content encryption
+	// algorithms.
+	DefaultContentEncryptions = []string{"A256GCM", "A128CBC-HS256"}
+)
+
+//go:generate mockgen -destination mock/encrypter.gen.go -package mock zntr.io/solid/pkg/sdk/jwe Encrypter
+
+// Encrypter describes nested JWT encrypter contract.
+type Encrypter interface {
+	Encrypt(ctx context.Context, token string) (string, error)
+}
+
+//go:generate mockgen -destination mock/decrypter.gen.go -package mock zntr.io/solid/pkg/sdk/jwe Decrypter
+
+// Decrypter describes nested JWT decrypter contract.
+type Decrypter interface {
+	Decrypt(ctx context.Context, token string) (string, error)
+}
+
+// IsEncrypted returns true if the given value uses the JWE compact
+// serialization.
+func IsEncrypted(value string) bool {
+	return strings.Count(value, ".") == 4
+}
@@ -0,0 +1,129 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/square/go-jose/v3"
+
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+// DefaultDecrypter returns a nested JWT decrypter using private keys returned
+// by the given key set provider.
+func DefaultDecrypter(keySetProvider jwk.KeySetProviderFunc, keyAlgorithms, contentEncryptions []string) Decrypter {
+	return &defaultDecrypter{
+		keySetProvider:     keySetProvider,
+		keyAlgorithms:      types.StringArray(keyAlgorithms),
+		contentEncryptions: types.StringArray(contentEncryptions),
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type defaultDecrypter struct {
+	keySetProvider     jwk.KeySetProviderFunc
+	keyAlgorithms      types.StringArray
+	contentEncryptions types.StringArray
+}
+
+func (d *defaultDecrypter) Decrypt(ctx context.Context, token string) (string, error) {
+	// Check arguments
+	if token == "" {
+		return "", fmt.Errorf("unable to decrypt blank token")
+	}
+	if d.keySetProvider == nil {
+		return "", fmt.Errorf("unable to decrypt without key set provider")
+	}
+
+	// Parse JWE token
+	obj, err := jose.ParseEncrypted(token)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse encrypted token: %w", err)
+	}
+
+	// Validate algorithms
+	if !d.keyAlgorithms.Contains(obj.Header.Algorithm) {
+		return "", fmt.Errorf("token uses an invalid or not supported key algorithm `%s`", obj.Header.Algorithm)
+	}
+	enc, _ := obj.Header.ExtraHeaders[jose.HeaderKey("enc")].(string)
+	if !d.contentEncryptions.Contains(enc) {
+		return "", fmt.Errorf("token uses an invalid or not supported content encryption `%s`", enc)
+	}
+
+	// Validate content type
+	cty, _ := obj.Header.ExtraHeaders[jose.HeaderContentType].(string)
+	if !strings.EqualFold(cty, ContentTypeJWT) {
+		return "", fmt.Errorf("token must contain a nested JWT, got content type `%s`", cty)
+	}
+
+	// Give key identifier as a hint to the key set provider
+	kid := obj.Header.KeyID
+	if kid != "" {
+		ctx = jwk.WithKeyID(ctx, kid)
+	}
+
+	// Retrieve KeySet
+	jwks, err := d.keySetProvider(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve KeySet: %w", err)
+	}
+	if jwks == nil {
+		return "", fmt.Errorf("key set provider returned a nil KeySet")
+	}
+
+	// Set all keys by default
+	keys := jwks.Keys
+
+	// Check if token refer to a key
+	if kid != "" {
+		keys = jwks.Key(kid)
+	}
+
+	// Iterate on all keys to find a matching one.
+	for i := range keys {
+		// Extract key
+		k := keys[i]
+
+		// Check key type
+		if k.Use != "" && k.Use != "enc" {
+			// Ignore signature key
+			continue
+		}
+		if k.IsPublic() {
+			// Ignore public key
+			continue
+		}
+
+		// Try to decrypt with current key
+		payload, err := obj.Decrypt(k.Key)
+		if err != nil {
+			continue
+		}
+
+		// No error
+		return string(payload), nil
+	}
+
+	// No matching key
+	return "", fmt.Errorf("unable to decrypt token with any key")
+}
@@ -0,0 +1,178 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/square/go-jose/v3"
+)
+
+func encryptToken(t *testing.T, key *jose.JSONWebKey, alg jose.KeyAlgorithm, enc jose.ContentEncryption, cty string) string {
+	opts := &jose.EncrypterOptions{}
+	if cty != "" {
+		opts = opts.WithContentType(jose.ContentType(cty))
+	}
+
+	encrypter, err := jose.NewEncrypter(enc, jose.Recipient{
+		Algorithm: alg,
+		Key:       key.Public().Key,
+		KeyID:     key.KeyID,
+	}, opts)
+	if err != nil {
+		t.Fatalf("unable to prepare encrypter: %v", err)
+	}
+
+	obj, err := encrypter.Encrypt([]byte(testToken))
+	if err != nil {
+		t.Fatalf("unable to encrypt token: %v", err)
+	}
+
+	raw, err := obj.CompactSerialize()
+	if err != nil {
+		t.Fatalf("unable to serialize token: %v", err)
+	}
+
+	return raw
+}
+
+func staticKeySet(keys ...*jose.JSONWebKey) func(context.Context) (*jose.JSONWebKeySet, error) {
+	return func(_ context.Context) (*jose.JSONWebKeySet, error) {
+		jwks := &jose.JSONWebKeySet{}
+		for _, k := range keys {
+			jwks.Keys = append(jwks.Keys, *k)
+		}
+		return jwks, nil
+	}
+}
+
+func Test_defaultDecrypter_Decrypt(t *testing.T) {
+	key := generateEncryptionKey(t, "enc-1")
+	otherKey := generateEncryptionKey(t, "enc-2")
+	publicKey := key.Public()
+
+	signatureKey := generateEncryptionKey(t, "enc-1")
+	signatureKey.Use = "sig"
+
+	type fields struct {
+		keySetProvider func(context.Context) (*jose.JSONWebKeySet, error)
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "blank token",
+			fields:  fields{keySetProvider: staticKeySet(key)},
+			wantErr: true,
+		},
+		{
+			name:    "nil key set provider",
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A256GCM, "JWT"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid token",
+			fields:  fields{keySetProvider: staticKeySet(key)},
+			token:   testToken,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported key algorithm",
+			fields:  fields{keySetProvider: staticKeySet(key)},
+			token:   encryptToken(t, key, jose.ECDH_ES, jose.A256GCM, "JWT"),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported content encryption",
+			fields:  fields{keySetProvider: staticKeySet(key)},
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A128GCM, "JWT"),
+			wantErr: true,
+		},
+		{
+			name:    "missing content type",
+			fields:  fields{keySetProvider: staticKeySet(key)},
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A256GCM, ""),
+			wantErr: true,
+		},
+		{
+			name: "key set provider error",
+			fields: fields{
+				keySetProvider: func(_ context.Context) (*jose.JSONWebKeySet, error) {
+					return nil, fmt.Errorf("foo")
+				},
+			},
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A256GCM, "JWT"),
+			wantErr: true,
+		},
+		{
+			name: "nil key set",
+			fields: fields{
+				keySetProvider: func(_ context.Context) (*jose.JSONWebKeySet, error) {
+					return nil, nil
+				},
+			},
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A256GCM, "JWT"),
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			fields:  fields{keySetProvider: staticKeySet(otherKey)},
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A256GCM, "JWT"),
+			wantErr: true,
+		},
+		{
+			name:    "public key only",
+			fields:  fields{keySetProvider: staticKeySet(&publicKey)},
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A256GCM, "JWT"),
+			wantErr: true,
+		},
+		{
+			name:    "signature key ignored",
+			fields:  fields{keySetProvider: staticKeySet(signatureKey)},
+			token:   encryptToken(t, signatureKey, jose.ECDH_ES_A256KW, jose.A256GCM, "JWT"),
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:    "valid",
+			fields:  fields{keySetProvider: staticKeySet(otherKey, key)},
+			token:   encryptToken(t, key, jose.ECDH_ES_A256KW, jose.A256GCM, "JWT"),
+			want:    testToken,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DefaultDecrypter(tt.fields.keySetProvider, DefaultKeyAlgorithms, DefaultContentEncryptions)
+			got, err := d.Decrypt(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("defaultDecrypter.Decrypt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("defaultDecrypter.Decrypt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/square/go-jose/v3"
+
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+// DefaultEncrypter returns a nested JWT encrypter using the recipient public
+// key returned by the given key provider.
+func DefaultEncrypter(recipient jwk.KeyProviderFunc, keyAlgorithm, contentEncryption string) Encrypter {
+	return &defaultEncrypter{
+		recipient:         recipient,
+		keyAlgorithm:      keyAlgorithm,
+		contentEncryption: contentEncryption,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type defaultEncrypter struct {
+	recipient         jwk.KeyProviderFunc
+	keyAlgorithm      string
+	contentEncryption string
+}
+
+func (e *defaultEncrypter) Encrypt(ctx context.Context, token string) (string, error) {
+	// Check arguments
+	if token == "" {
+		return "", fmt.Errorf("unable to encrypt blank token")
+	}
+	if e.recipient == nil {
+		return "", fmt.Errorf("unable to encrypt without recipient key provider")
+	}
+
+	// Retrieve recipient key
+	key, err := e.recipient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve recipient key: %w", err)
+	}
+	if types.IsNil(key) {
+		return "", fmt.Errorf("recipient key provider returned a nil key")
+	}
+	if !key.IsPublic() {
+		return "", fmt.Errorf("recipient key must be a public key")
+	}
+
+	// Prepare encrypter
+	enc, err := jose.NewEncrypter(jose.ContentEncryption(e.contentEncryption), jose.Recipient{
+		Algorithm: jose.KeyAlgorithm(e.keyAlgorithm),
+		Key:       key.Key,
+		KeyID:     key.KeyID,
+	}, (&jose.EncrypterOptions{}).WithContentType(ContentTypeJWT))
+	if err != nil {
+		return "", fmt.Errorf("unable to prepare encrypter: %w", err)
+	}
+
+	// Encrypt the token
+	obj, err := enc.Encrypt([]byte(token))
+	if err != nil {
+		return "", fmt.Errorf("unable to encrypt token: %w", err)
+	}
+
+	// Serialize
+	raw, err := obj.CompactSerialize()
+	if err != nil {
+		return "", fmt.Errorf("unable to serialize encrypted token: %w", err)
+	}
+
+	// No error
+	return raw, nil
+}
@@ -0,0 +1,169 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwk
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/square/go-jose/v3"
+)
+
+// MinRSAKeySize defines the minimal accepted RSA modulus size in bits.
+const MinRSAKeySize = 2048
+
+// SupportedSignatureAlgorithms defines the signature algorithms handled by
+// signers and verifiers.
+var SupportedSignatureAlgorithms = []string{
+	string(jose.ES256), string(jose.ES384), string(jose.PS256), string(jose.EdDSA),
+}
+
+// ErrAlgorithmKeyMismatch is raised when a signature algorithm can't be used
+// with a key.
+var ErrAlgorithmKeyMismatch = errors.New("signature algorithm and key mismatch")
+
+// Algorithm returns the signature algorithm declared by the key or derived
+// from the key type when not declared.
+func Algorithm(key interface{}) (string, error) {
+	// Declared algorithm
+	switch k := key.(type) {
+	case *jose.JSONWebKey:
+		if k == nil {
+			return "", errors.New("unable to process nil key")
+		}
+		if k.Algorithm != "" {
+			return k.Algorithm, nil
+		}
+		return Algorithm(k.Key)
+	case jose.JSONWebKey:
+		return Algorithm(&k)
+	case jose.OpaqueSigner:
+		if algs := k.Algs(); len(algs) > 0 {
+			return string(algs[0]), nil
+		}
+		return "", fmt.Errorf("opaque signer doesn't support any algorithm: %w", ErrAlgorithmKeyMismatch)
+	default:
+	}
+
+	// Derived from public key
+	switch k := publicKey(key).(type) {
+	case *ecdsa.PublicKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			return string(jose.ES256), nil
+		case 384:
+			return string(jose.ES384), nil
+		case 521:
+			return string(jose.ES512), nil
+		default:
+		}
+	case *rsa.PublicKey:
+		return string(jose.PS256), nil
+	case ed25519.PublicKey:
+		return string(jose.EdDSA), nil
+	default:
+	}
+
+	return "", fmt.Errorf("unable to derive algorithm from key type %T: %w", key, ErrAlgorithmKeyMismatch)
+}
+
+// CheckAlgorithm validates that the given signature algorithm can be used
+// with the given key (raw, JWK or opaque signer, public or private).
+func CheckAlgorithm(key interface{}, alg string) error {
+	// Check declared algorithm
+	switch k := key.(type) {
+	case *jose.JSONWebKey:
+		if k == nil {
+			return errors.New("unable to process nil key")
+		}
+		if k.Algorithm != "" && k.Algorithm != alg {
+			return fmt.Errorf("key is restricted to '%s' algorithm: %w", k.Algorithm, ErrAlgorithmKeyMismatch)
+		}
+		return CheckAlgorithm(k.Key, alg)
+	case jose.JSONWebKey:
+		return CheckAlgorithm(&k, alg)
+	case jose.OpaqueSigner:
+		supported := false
+		for _, a := range k.Algs() {
+			if string(a) == alg {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("opaque signer doesn't support '%s' algorithm: %w", alg, ErrAlgorithmKeyMismatch)
+		}
+		return CheckAlgorithm(k.Public(), alg)
+	default:
+	}
+
+	// Check key type
+	switch k := publicKey(key).(type) {
+	case *ecdsa.PublicKey:
+		var size int
+		switch jose.SignatureAlgorithm(alg) {
+		case jose.ES256:
+			size = 256
+		case jose.ES384:
+			size = 384
+		case jose.ES512:
+			size = 521
+		default:
+			return fmt.Errorf("ECDSA key can't be used with '%s' algorithm: %w", alg, ErrAlgorithmKeyMismatch)
+		}
+		if k.Curve.Params().BitSize != size {
+			return fmt.Errorf("'%s' algorithm requires a P-%d key: %w", alg, size, ErrAlgorithmKeyMismatch)
+		}
+	case *rsa.PublicKey:
+		switch jose.SignatureAlgorithm(alg) {
+		case jose.PS256, jose.PS384, jose.PS512, jose.RS256, jose.RS384, jose.RS512:
+		default:
+			return fmt.Errorf("RSA key can't be used with '%s' algorithm: %w", alg, ErrAlgorithmKeyMismatch)
+		}
+		if k.N.BitLen() < MinRSAKeySize {
+			return fmt.Errorf("RSA key must be at least %d bits: %w", MinRSAKeySize, ErrAlgorithmKeyMismatch)
+		}
+	case ed25519.PublicKey:
+		if jose.SignatureAlgorithm(alg) != jose.EdDSA {
+			return fmt.Errorf("Ed25519 key can't be used with '%s' algorithm: %w", alg, ErrAlgorithmKeyMismatch)
+		}
+	default:
+		return fmt.Errorf("key type %T can't be used for signature: %w", key, ErrAlgorithmKeyMismatch)
+	}
+
+	// No error
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+
+func publicKey(key interface{}) crypto.PublicKey {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return k
+	case crypto.Signer:
+		return k.Public()
+	default:
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwk
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+
+	"github.com/square/go-jose/v3"
+)
+
+func generateKeys(t *testing.T) map[string]crypto.Signer {
+	t.Helper()
+
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsa2048, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string]crypto.Signer{
+		"ES256": p256,
+		"ES384": p384,
+		"PS256": rsa2048,
+		"EdDSA": ed,
+	}
+}
+
+func TestCheckAlgorithm_Matrix(t *testing.T) {
+	keys := generateKeys(t)
+
+	for keyAlg, k := range keys {
+		for _, alg := range SupportedSignatureAlgorithms {
+			wantErr := keyAlg != alg
+			for name, key := range map[string]interface{}{
+				"private":     k,
+				"public":      k.Public(),
+				"private jwk": &jose.JSONWebKey{Key: k},
+				"public jwk":  jose.JSONWebKey{Key: k.Public()},
+			} {
+				t.Run(keyAlg+"/"+alg+"/"+name, func(t *testing.T) {
+					err := CheckAlgorithm(key, alg)
+					if (err != nil) != wantErr {
+						t.Errorf("CheckAlgorithm() error = %v, wantErr %v", err, wantErr)
+					}
+					if err != nil && !errors.Is(err, ErrAlgorithmKeyMismatch) {
+						t.Errorf("CheckAlgorithm() error = %v, want ErrAlgorithmKeyMismatch", err)
+					}
+				})
+			}
+
+			t.Run(keyAlg+"/"+alg+"/derived", func(t *testing.T) {
+				got, err := Algorithm(k.Public())
+				if err != nil {
+					t.Fatalf("Algorithm() error = %v", err)
+				}
+				if got != keyAlg {
+					t.Errorf("Algorithm() = %v, want %v", got, keyAlg)
+				}
+			})
+		}
+	}
+}
+
+func TestCheckAlgorithm(t *testing.T) {
+	keys := generateKeys(t)
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		key interface{}
+		alg string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name: "nil jwk",
+			args: args{
+				key: (*jose.JSONWebKey)(nil),
+				alg: "ES256",
+			},
+			wantErr: true,
+		},
+		{
+			name: "symmetric key",
+			args: args{
+				key: []byte("foo"),
+				alg: "HS256",
+			},
+			wantErr: true,
+		},
+		{
+			name: "declared algorithm mismatch",
+			args: args{
+				key: &jose.JSONWebKey{Key: keys["PS256"], Algorithm: "RS256"},
+				alg: "PS256",
+			},
+			wantErr: true,
+		},
+		{
+			name: "weak rsa key",
+			args: args{
+				key: weak,
+				alg: "PS256",
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "declared algorithm",
+			args: args{
+				key: &jose.JSONWebKey{Key: keys["PS256"], Algorithm: "RS256"},
+				alg: "RS256",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckAlgorithm(tt.args.key, tt.args.alg); (err != nil) != tt.wantErr {
+				t.Errorf("CheckAlgorithm() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -28,3 +28,10 @@ type KeySetProviderFunc func(ctx context.Context) (*jose.JSONWebKeySet, error)
 
 // KeyProviderFunc defines key provider contract.
 type KeyProviderFunc func(ctx context.Context) (*jose.JSONWebKey, error)
+
+//go:generate mockgen -destination mock/fetcher.gen.go -package mock zntr.io/solid/pkg/sdk/jwk Fetcher
+
+// Fetcher describes remote JSON Web Key Set retrieval contract.
+type Fetcher interface {
+	Fetch(ctx context.Context, uri string) (*jose.JSONWebKeySet, error)
+}
@@ -0,0 +1,57 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwk
+
+import (
+	"context"
+)
+
+type contextKey string
+
+func (c contextKey) String() string {
+	return "zntr.io/solid/pkg/sdk/jwk/" + string(c)
+}
+
+var contextKeyKeyID = contextKey("kid")
+
+// KeyIDFromContext returns the expected key identifier bound to the context.
+func KeyIDFromContext(ctx context.Context) (string, bool) {
+	kid, ok := ctx.Value(contextKeyKeyID).(string)
+	return kid, ok
+}
+
+// WithKeyID returns a context holding the expected key identifier. Key set
+// providers could use it as a hint to refresh their key set.
+func WithKeyID(ctx context.Context, kid string) context.Context {
+	return context.WithValue(ctx, contextKeyKeyID, kid)
+}
+
+var contextKeyAlgorithm = contextKey("alg")
+
+// AlgorithmFromContext returns the expected signature algorithm bound to the
+// context.
+func AlgorithmFromContext(ctx context.Context) (string, bool) {
+	alg, ok := ctx.Value(contextKeyAlgorithm).(string)
+	return alg, ok
+}
+
+// WithAlgorithm returns a context holding the expected signature algorithm.
+// Key providers could use it to select a compatible signing key.
+func WithAlgorithm(ctx context.Context, alg string) context.Context {
+	return context.WithValue(ctx, contextKeyAlgorithm, alg)
+}
@@ -0,0 +1,394 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwk
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/square/go-jose/v3"
+)
+
+const (
+	// DefaultCacheTTL defines the key set cache duration used when the remote
+	// server doesn't return caching directives.
+	DefaultCacheTTL = 1 * time.Hour
+	// DefaultMaxCacheTTL defines the maximum key set cache duration.
+	DefaultMaxCacheTTL = 24 * time.Hour
+	// DefaultMinRefreshInterval defines the minimum delay between two
+	// retrievals of the same key set.
+	DefaultMinRefreshInterval = 1 * time.Minute
+	// DefaultMaxCacheEntries defines the maximum number of cached key sets.
+	DefaultMaxCacheEntries = 1024
+
+	bodyLimiterSize = 64 << 10
+)
+
+var timeFunc = time.Now
+
+// Fetcher options holder
+type fetcherOptions struct {
+	httpClient         *http.Client
+	cacheTTL           time.Duration
+	maxCacheTTL        time.Duration
+	minRefreshInterval time.Duration
+	maxEntries         int
+}
+
+// FetcherOption defines functional pattern function type contract.
+type FetcherOption func(*fetcherOptions)
+
+// HTTPClient defines the HTTP client used to retrieve remote key sets.
+func HTTPClient(client *http.Client) FetcherOption {
+	return func(opts *fetcherOptions) {
+		opts.httpClient = client
+	}
+}
+
+// CacheTTL defines the cache duration used when the remote server doesn't
+// return caching directives.
+func CacheTTL(d time.Duration) FetcherOption {
+	return func(opts *fetcherOptions) {
+		opts.cacheTTL = d
+	}
+}
+
+// MaxCacheTTL defines the maximum cache duration whatever the remote server
+// caching directives.
+func MaxCacheTTL(d time.Duration) FetcherOption {
+	return func(opts *fetcherOptions) {
+		opts.maxCacheTTL = d
+	}
+}
+
+// MinRefreshInterval defines the minimum delay between two retrievals of the
+// same key set, used to rate limit refresh on unknown key identifier.
+func MinRefreshInterval(d time.Duration) FetcherOption {
+	return func(opts *fetcherOptions) {
+		opts.minRefreshInterval = d
+	}
+}
+
+// MaxCacheEntries defines the maximum number of cached key sets. The least
+// recently used key set is evicted when the limit is reached.
+func MaxCacheEntries(n int) FetcherOption {
+	return func(opts *fetcherOptions) {
+		opts.maxEntries = n
+	}
+}
+
+// DefaultFetcher returns a remote key set fetcher with an in-memory cache
+// honoring HTTP caching directives (Cache-Control, Expires, ETag).
+//
+// When the context holds an expected key identifier (see WithKeyID) not
+// present in the cached key set, the key set is refreshed, no more than once
+// per refresh interval.
+func DefaultFetcher(opts ...FetcherOption) Fetcher {
+	// Default options
+	defaultOptions := &fetcherOptions{
+		httpClient:         &http.Client{Timeout: 10 * time.Second},
+		cacheTTL:           DefaultCacheTTL,
+		maxCacheTTL:        DefaultMaxCacheTTL,
+		minRefreshInterval: DefaultMinRefreshInterval,
+		maxEntries:         DefaultMaxCacheEntries,
+	}
+
+	// Parse options
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	return &defaultFetcher{
+		opts:    defaultOptions,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// RemoteKeySetProvider returns a key set provider backed by the given fetcher
+// for the given uri.
+func RemoteKeySetProvider(fetcher Fetcher, uri string) KeySetProviderFunc {
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		return fetcher.Fetch(ctx, uri)
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type fetcherEntry struct {
+	sync.Mutex
+	jwks         *jose.JSONWebKeySet
+	err          error
+	etag         string
+	lastModified string
+	expiresAt    time.Time
+	fetchedAt    time.Time
+}
+
+// cacheItem holds the eviction metadata of a cache entry, guarded by the
+// fetcher mutex.
+type cacheItem struct {
+	uri     string
+	entry   *fetcherEntry
+	staleAt time.Time
+}
+
+type defaultFetcher struct {
+	opts    *fetcherOptions
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+func (f *defaultFetcher) Fetch(ctx context.Context, uri string) (*jose.JSONWebKeySet, error) {
+	// Check arguments
+	if uri == "" {
+		return nil, fmt.Errorf("unable to fetch key set from blank uri")
+	}
+
+	// Retrieve cache entry
+	entry := f.entry(uri)
+	entry.Lock()
+	defer entry.Unlock()
+	defer f.touch(uri, entry)
+
+	now := timeFunc()
+
+	// Check if refresh is allowed
+	if !entry.fetchedAt.IsZero() && now.Sub(entry.fetchedAt) < f.opts.minRefreshInterval {
+		if entry.jwks == nil {
+			return nil, fmt.Errorf("unable to retrieve key set from '%s': %w", uri, entry.err)
+		}
+
+		// Serve from cache
+		return entry.jwks, nil
+	}
+
+	// Check cached key set
+	if entry.jwks != nil && now.Before(entry.expiresAt) {
+		kid, ok := KeyIDFromContext(ctx)
+		if !ok || kid == "" || len(entry.jwks.Key(kid)) > 0 {
+			// Serve from cache
+			return entry.jwks, nil
+		}
+	}
+
+	// Refresh key set
+	entry.fetchedAt = now
+	entry.err = f.refresh(ctx, uri, entry, now)
+	if entry.err != nil {
+		if entry.jwks == nil {
+			return nil, fmt.Errorf("unable to retrieve key set from '%s': %w", uri, entry.err)
+		}
+
+		// Serve stale key set on error
+		return entry.jwks, nil
+	}
+
+	// No error
+	return entry.jwks, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (f *defaultFetcher) entry(uri string) *fetcherEntry {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	// Mark as recently used
+	if elem, ok := f.entries[uri]; ok {
+		f.lru.MoveToFront(elem)
+		return elem.Value.(*cacheItem).entry
+	}
+
+	// Evict expired entries before growing
+	now := timeFunc()
+	for elem := f.lru.Back(); elem != nil; {
+		prev := elem.Prev()
+		if item := elem.Value.(*cacheItem); !item.staleAt.IsZero() && now.After(item.staleAt) {
+			f.remove(elem)
+		}
+		elem = prev
+	}
+
+	// Evict least recently used entries
+	for f.opts.maxEntries > 0 && f.lru.Len() >= f.opts.maxEntries {
+		f.remove(f.lru.Back())
+	}
+
+	entry := &fetcherEntry{}
+	f.entries[uri] = f.lru.PushFront(&cacheItem{
+		uri:   uri,
+		entry: entry,
+	})
+
+	return entry
+}
+
+// touch updates the entry eviction deadline, it must be called with the entry
+// lock held.
+func (f *defaultFetcher) touch(uri string, entry *fetcherEntry) {
+	// Keep the entry until the key set expires and the refresh rate limit ends
+	staleAt := entry.fetchedAt.Add(f.opts.minRefreshInterval)
+	if entry.expiresAt.After(staleAt) {
+		staleAt = entry.expiresAt
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if elem, ok := f.entries[uri]; ok && elem.Value.(*cacheItem).entry == entry {
+		elem.Value.(*cacheItem).staleAt = staleAt
+	}
+}
+
+func (f *defaultFetcher) remove(elem *list.Element) {
+	f.lru.Remove(elem)
+	delete(f.entries, elem.Value.(*cacheItem).uri)
+}
+
+func (f *defaultFetcher) refresh(ctx context.Context, uri string, entry *fetcherEntry, now time.Time) error {
+	// Prepare request
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return fmt.Errorf("unable to prepare key set request: %w", err)
+	}
+	req.Header.Set("Accept", "application/jwk-set+json, application/json")
+
+	// Conditional request
+	if entry.jwks != nil {
+		if entry.etag != "" {
+			req.Header.Set("If-None-Match", entry.etag)
+		}
+		if entry.lastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.lastModified)
+		}
+	}
+
+	// Do the query
+	response, err := f.opts.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to query key set: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusNotModified:
+		if entry.jwks == nil {
+			return fmt.Errorf("unexpected not modified response for an uncached key set")
+		}
+	case http.StatusOK:
+		// Decode payload
+		var jwks jose.JSONWebKeySet
+		if err := json.NewDecoder(io.LimitReader(response.Body, bodyLimiterSize)).Decode(&jwks); err != nil {
+			return fmt.Errorf("unable to decode key set: %w", err)
+		}
+
+		// Check keys
+		if len(jwks.Keys) == 0 {
+			return fmt.Errorf("remote key set doesn't contain keys")
+		}
+
+		// Update entry
+		entry.jwks = &jwks
+		entry.etag = response.Header.Get("ETag")
+		entry.lastModified = response.Header.Get("Last-Modified")
+	default:
+		return fmt.Errorf("unexpected key set response status %d", response.StatusCode)
+	}
+
+	// Compute expiration
+	entry.expiresAt = now.Add(f.cacheDuration(response.Header, now))
+
+	// No error
+	return nil
+}
+
+// cacheDuration computes the response freshness lifetime.
+// https://tools.ietf.org/html/rfc7234#section-4.2.1
+func (f *defaultFetcher) cacheDuration(h http.Header, now time.Time) time.Duration {
+	ttl := f.opts.cacheTTL
+
+	// Parse cache directives
+	directives := map[string]string{}
+	for _, part := range strings.Split(h.Get("Cache-Control"), ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		name := strings.ToLower(strings.TrimSpace(kv[0]))
+		if len(kv) == 2 {
+			directives[name] = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+		} else {
+			directives[name] = ""
+		}
+	}
+
+	_, noStore := directives["no-store"]
+	_, noCache := directives["no-cache"]
+	maxAge, hasMaxAge := directives["max-age"]
+
+	switch {
+	case noStore, noCache:
+		ttl = 0
+	case hasMaxAge:
+		seconds, err := strconv.ParseInt(maxAge, 10, 64)
+		if err != nil {
+			ttl = 0
+			break
+		}
+		ttl = time.Duration(seconds) * time.Second
+
+		// Remove current age
+		if age, err := strconv.ParseInt(h.Get("Age"), 10, 64); err == nil && age > 0 {
+			ttl -= time.Duration(age) * time.Second
+		}
+	case h.Get("Expires") != "":
+		expires, err := http.ParseTime(h.Get("Expires"))
+		if err != nil {
+			ttl = 0
+			break
+		}
+
+		// Compute lifetime according to server date
+		date := now
+		if d, err := http.ParseTime(h.Get("Date")); err == nil {
+			date = d
+		}
+		ttl = expires.Sub(date)
+	}
+
+	// Clamp duration
+	if ttl < 0 {
+		ttl = 0
+	}
+	if ttl > f.opts.maxCacheTTL {
+		ttl = f.opts.maxCacheTTL
+	}
+
+	return ttl
+}
@@ -0,0 +1,317 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var (
+	remoteJWKS  = `{"keys": [{"kid": "key-1", "kty": "EC","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ES256"}]}`
+	rotatedJWKS = `{"keys": [{"kid": "key-2", "kty": "EC","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ES256"}]}`
+)
+
+func TestDefaultFetcher_Fetch(t *testing.T) {
+	type step struct {
+		advance time.Duration
+		kid     string
+		wantKid string
+		wantErr bool
+	}
+	tests := []struct {
+		name      string
+		handler   func(calls int32) http.HandlerFunc
+		steps     []step
+		wantCalls int32
+	}{
+		{
+			name: "server error",
+			handler: func(_ int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			},
+			steps: []step{
+				{wantErr: true},
+				{advance: 10 * time.Second, wantErr: true},
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "empty key set",
+			handler: func(_ int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					fmt.Fprint(w, `{"keys":[]}`)
+				}
+			},
+			steps: []step{
+				{wantErr: true},
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "max-age caching",
+			handler: func(_ int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Cache-Control", "public, max-age=300")
+					fmt.Fprint(w, remoteJWKS)
+				}
+			},
+			steps: []step{
+				{wantKid: "key-1"},
+				{advance: 2 * time.Minute, wantKid: "key-1"},
+				{advance: 4 * time.Minute, wantKid: "key-1"},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "no-cache directive",
+			handler: func(_ int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Cache-Control", "no-cache")
+					fmt.Fprint(w, remoteJWKS)
+				}
+			},
+			steps: []step{
+				{wantKid: "key-1"},
+				{advance: 10 * time.Second, wantKid: "key-1"},
+				{advance: 1 * time.Minute, wantKid: "key-1"},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "etag revalidation",
+			handler: func(_ int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("If-None-Match") == `"v1"` {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+					w.Header().Set("ETag", `"v1"`)
+					w.Header().Set("Cache-Control", "max-age=60")
+					fmt.Fprint(w, remoteJWKS)
+				}
+			},
+			steps: []step{
+				{wantKid: "key-1"},
+				{advance: 2 * time.Minute, wantKid: "key-1"},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "unknown kid refresh",
+			handler: func(calls int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Cache-Control", "max-age=3600")
+					if calls > 1 {
+						fmt.Fprint(w, rotatedJWKS)
+						return
+					}
+					fmt.Fprint(w, remoteJWKS)
+				}
+			},
+			steps: []step{
+				{kid: "key-1", wantKid: "key-1"},
+				{advance: 2 * time.Minute, kid: "key-2", wantKid: "key-2"},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "unknown kid refresh is rate limited",
+			handler: func(calls int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Cache-Control", "max-age=3600")
+					fmt.Fprint(w, remoteJWKS)
+				}
+			},
+			steps: []step{
+				{kid: "key-1", wantKid: "key-1"},
+				{advance: 1 * time.Second, kid: "key-2", wantKid: "key-1"},
+				{advance: 1 * time.Second, kid: "key-3", wantKid: "key-1"},
+				{advance: 2 * time.Minute, kid: "key-3", wantKid: "key-1"},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "stale key set served on error",
+			handler: func(calls int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if calls > 1 {
+						w.WriteHeader(http.StatusBadGateway)
+						return
+					}
+					w.Header().Set("Cache-Control", "max-age=60")
+					fmt.Fprint(w, remoteJWKS)
+				}
+			},
+			steps: []step{
+				{wantKid: "key-1"},
+				{advance: 2 * time.Minute, wantKid: "key-1"},
+			},
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.handler(atomic.AddInt32(&calls, 1))(w, r)
+			}))
+			defer srv.Close()
+
+			// Freeze time
+			now := time.Unix(1, 0)
+			timeFunc = func() time.Time { return now }
+			defer func() { timeFunc = time.Now }()
+
+			underTest := DefaultFetcher(HTTPClient(srv.Client()))
+
+			for i, s := range tt.steps {
+				now = now.Add(s.advance)
+
+				ctx := context.Background()
+				if s.kid != "" {
+					ctx = WithKeyID(ctx, s.kid)
+				}
+
+				got, err := underTest.Fetch(ctx, srv.URL)
+				if (err != nil) != s.wantErr {
+					t.Errorf("defaultFetcher.Fetch() step %d error = %v, wantErr %v", i, err, s.wantErr)
+					return
+				}
+				if s.wantErr {
+					continue
+				}
+				if len(got.Key(s.wantKid)) == 0 {
+					t.Errorf("defaultFetcher.Fetch() step %d doesn't contain expected key '%s'", i, s.wantKid)
+				}
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("defaultFetcher.Fetch() remote calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func Test_defaultFetcher_eviction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=300")
+		fmt.Fprint(w, remoteJWKS)
+	}))
+	defer srv.Close()
+
+	// Freeze time
+	now := time.Unix(1, 0)
+	timeFunc = func() time.Time { return now }
+	defer func() { timeFunc = time.Now }()
+
+	underTest := DefaultFetcher(HTTPClient(srv.Client()), MaxCacheEntries(2)).(*defaultFetcher)
+
+	// Fill the cache over its capacity
+	for _, path := range []string{"/a", "/b", "/a", "/c"} {
+		if _, err := underTest.Fetch(context.Background(), srv.URL+path); err != nil {
+			t.Fatalf("defaultFetcher.Fetch() error = %v", err)
+		}
+	}
+	if _, ok := underTest.entries[srv.URL+"/b"]; ok || len(underTest.entries) != 2 {
+		t.Errorf("defaultFetcher.entries should have evicted the least recently used entry, got %d entries", len(underTest.entries))
+	}
+
+	// Expired entries are evicted on insertion
+	now = now.Add(10 * time.Minute)
+	if _, err := underTest.Fetch(context.Background(), srv.URL+"/d"); err != nil {
+		t.Fatalf("defaultFetcher.Fetch() error = %v", err)
+	}
+	if len(underTest.entries) != 1 || underTest.lru.Len() != 1 {
+		t.Errorf("defaultFetcher.entries should only contain the last entry, got %d entries", len(underTest.entries))
+	}
+}
+
+func Test_defaultFetcher_cacheDuration(t *testing.T) {
+	now := time.Date(2020, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    time.Duration
+	}{
+		{
+			name: "no directive",
+			want: DefaultCacheTTL,
+		},
+		{
+			name:    "no-store",
+			headers: map[string]string{"Cache-Control": "no-store"},
+			want:    0,
+		},
+		{
+			name:    "max-age",
+			headers: map[string]string{"Cache-Control": "public, max-age=600"},
+			want:    10 * time.Minute,
+		},
+		{
+			name:    "max-age with age",
+			headers: map[string]string{"Cache-Control": "max-age=600", "Age": "120"},
+			want:    8 * time.Minute,
+		},
+		{
+			name:    "invalid max-age",
+			headers: map[string]string{"Cache-Control": "max-age=foo"},
+			want:    0,
+		},
+		{
+			name:    "max-age over maximum",
+			headers: map[string]string{"Cache-Control": "max-age=604800"},
+			want:    DefaultMaxCacheTTL,
+		},
+		{
+			name: "expires",
+			headers: map[string]string{
+				"Date":    now.Format(http.TimeFormat),
+				"Expires": now.Add(30 * time.Minute).Format(http.TimeFormat),
+			},
+			want: 30 * time.Minute,
+		},
+		{
+			name:    "invalid expires",
+			headers: map[string]string{"Expires": "0"},
+			want:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := DefaultFetcher().(*defaultFetcher)
+
+			h := http.Header{}
+			for k, v := range tt.headers {
+				h.Set(k, v)
+			}
+
+			if got := f.cacheDuration(h, now); got != tt.want {
+				t.Errorf("defaultFetcher.cacheDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -53,6 +53,11 @@ func ValidateToken(jwks *jose.JSONWebKeySet, token *jwt.JSONWebToken, claims int
 			continue
 		}
 
+		// Check algorithm and key consistency
+		if len(token.Headers) > 0 && CheckAlgorithm(&k, token.Headers[0].Algorithm) != nil {
+			continue
+		}
+
 		// Try to verify with current key
 		if err := token.Claims(k, claims); err != nil {
 			continue
@@ -95,6 +100,11 @@ func ValidateSignature(jwks *jose.JSONWebKeySet, signature *jose.JSONWebSignatur
 			continue
 		}
 
+		// Check algorithm and key consistency
+		if len(signature.Signatures) > 0 && CheckAlgorithm(&k, signature.Signatures[0].Header.Algorithm) != nil {
+			continue
+		}
+
 		// Try to verify with current key
 		if _, err := signature.Verify(k); err != nil {
 			continue
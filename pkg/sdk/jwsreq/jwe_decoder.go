@@ -0,0 +1,65 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwsreq
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwe"
+)
+
+// -----------------------------------------------------------------------------
+
+// JWEAuthorizationDecoder returns an authorization request decoder instance
+// handling nested JWE-then-JWS request objects. Signed only request objects
+// are delegated as-is to the given decoder.
+// https://openid.net/specs/openid-connect-core-1_0.html#EncryptedRequestObject
+func JWEAuthorizationDecoder(decrypter jwe.Decrypter, decoder AuthorizationDecoder) AuthorizationDecoder {
+	return &jweDecoder{
+		decrypter: decrypter,
+		decoder:   decoder,
+	}
+}
+
+type jweDecoder struct {
+	decrypter jwe.Decrypter
+	decoder   AuthorizationDecoder
+}
+
+func (d *jweDecoder) Decode(ctx context.Context, value string) (*corev1.AuthorizationRequest, error) {
+	// Check arguments
+	if value == "" {
+		return nil, fmt.Errorf("value must not be blank")
+	}
+
+	// Signed only request object
+	if !jwe.IsEncrypted(value) {
+		return d.decoder.Decode(ctx, value)
+	}
+
+	// Decrypt request
+	signed, err := d.decrypter.Decrypt(ctx, value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt request: %w", err)
+	}
+
+	// Delegate to signed request decoder
+	return d.decoder.Decode(ctx, signed)
+}
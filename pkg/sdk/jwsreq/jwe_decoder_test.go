@@ -0,0 +1,127 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwsreq
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	jwemock "zntr.io/solid/pkg/sdk/jwe/mock"
+	jwsreqmock "zntr.io/solid/pkg/sdk/jwsreq/mock"
+)
+
+func Test_jweDecoder_Decode(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		value string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*jwemock.MockDecrypter, *jwsreqmock.MockAuthorizationDecoder)
+		want    *corev1.AuthorizationRequest
+		wantErr bool
+	}{
+		{
+			name:    "blank value",
+			wantErr: true,
+		},
+		{
+			name: "decrypter error",
+			args: args{
+				value: "header.key.iv.ciphertext.tag",
+			},
+			prepare: func(decrypter *jwemock.MockDecrypter, _ *jwsreqmock.MockAuthorizationDecoder) {
+				decrypter.EXPECT().Decrypt(gomock.Any(), "header.key.iv.ciphertext.tag").Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "decoder error",
+			args: args{
+				value: "header.key.iv.ciphertext.tag",
+			},
+			prepare: func(decrypter *jwemock.MockDecrypter, decoder *jwsreqmock.MockAuthorizationDecoder) {
+				decrypter.EXPECT().Decrypt(gomock.Any(), "header.key.iv.ciphertext.tag").Return("header.payload.signature", nil)
+				decoder.EXPECT().Decode(gomock.Any(), "header.payload.signature").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid signed only",
+			args: args{
+				value: "header.payload.signature",
+			},
+			prepare: func(_ *jwemock.MockDecrypter, decoder *jwsreqmock.MockAuthorizationDecoder) {
+				decoder.EXPECT().Decode(gomock.Any(), "header.payload.signature").Return(&corev1.AuthorizationRequest{
+					Scope: "openid",
+				}, nil)
+			},
+			wantErr: false,
+			want: &corev1.AuthorizationRequest{
+				Scope: "openid",
+			},
+		},
+		{
+			name: "valid encrypted",
+			args: args{
+				value: "header.key.iv.ciphertext.tag",
+			},
+			prepare: func(decrypter *jwemock.MockDecrypter, decoder *jwsreqmock.MockAuthorizationDecoder) {
+				decrypter.EXPECT().Decrypt(gomock.Any(), "header.key.iv.ciphertext.tag").Return("header.payload.signature", nil)
+				decoder.EXPECT().Decode(gomock.Any(), "header.payload.signature").Return(&corev1.AuthorizationRequest{
+					Scope: "openid",
+				}, nil)
+			},
+			wantErr: false,
+			want: &corev1.AuthorizationRequest{
+				Scope: "openid",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDecrypter := jwemock.NewMockDecrypter(ctrl)
+			mockDecoder := jwsreqmock.NewMockAuthorizationDecoder(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(mockDecrypter, mockDecoder)
+			}
+
+			d := JWEAuthorizationDecoder(mockDecrypter, mockDecoder)
+			got, err := d.Decode(tt.args.ctx, tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("jweDecoder.Decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmpOpts...); diff != "" {
+				t.Errorf("jweDecoder.Decode() res =%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwsreq
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwe"
+)
+
+// -----------------------------------------------------------------------------
+
+// JWEAuthorizationEncoder returns an authorization request encoder instance
+// producing nested JWE-then-JWS request objects.
+// https://openid.net/specs/openid-connect-core-1_0.html#EncryptedRequestObject
+func JWEAuthorizationEncoder(encrypter jwe.Encrypter, encoder AuthorizationEncoder) AuthorizationEncoder {
+	return &jweEncoder{
+		encrypter: encrypter,
+		encoder:   encoder,
+	}
+}
+
+type jweEncoder struct {
+	encrypter jwe.Encrypter
+	encoder   AuthorizationEncoder
+}
+
+func (enc *jweEncoder) Encode(ctx context.Context, ar *corev1.AuthorizationRequest) (string, error) {
+	// Check arguments
+	if ar == nil {
+		return "", fmt.Errorf("unable to encode nil request")
+	}
+
+	// Sign request
+	signed, err := enc.encoder.Encode(ctx, ar)
+	if err != nil {
+		return "", fmt.Errorf("unable to sign request: %w", err)
+	}
+
+	// Encrypt signed request
+	req, err := enc.encrypter.Encrypt(ctx, signed)
+	if err != nil {
+		return "", fmt.Errorf("unable to encrypt request: %w", err)
+	}
+
+	// No error
+	return req, nil
+}
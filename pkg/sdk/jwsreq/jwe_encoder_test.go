@@ -0,0 +1,113 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwsreq
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	jwemock "zntr.io/solid/pkg/sdk/jwe/mock"
+	jwsreqmock "zntr.io/solid/pkg/sdk/jwsreq/mock"
+)
+
+func Test_jweEncoder_Encode(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		ar  *corev1.AuthorizationRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*jwemock.MockEncrypter, *jwsreqmock.MockAuthorizationEncoder)
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "nil authorization request",
+			wantErr: true,
+		},
+		{
+			name: "encoder error",
+			args: args{
+				ar: &corev1.AuthorizationRequest{
+					Scope: "openid",
+				},
+			},
+			prepare: func(_ *jwemock.MockEncrypter, encoder *jwsreqmock.MockAuthorizationEncoder) {
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "encrypter error",
+			args: args{
+				ar: &corev1.AuthorizationRequest{
+					Scope: "openid",
+				},
+			},
+			prepare: func(encrypter *jwemock.MockEncrypter, encoder *jwsreqmock.MockAuthorizationEncoder) {
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any()).Return("header.payload.signature", nil)
+				encrypter.EXPECT().Encrypt(gomock.Any(), "header.payload.signature").Return("", fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ar: &corev1.AuthorizationRequest{
+					Scope: "openid",
+				},
+			},
+			prepare: func(encrypter *jwemock.MockEncrypter, encoder *jwsreqmock.MockAuthorizationEncoder) {
+				encoder.EXPECT().Encode(gomock.Any(), gomock.Any()).Return("header.payload.signature", nil)
+				encrypter.EXPECT().Encrypt(gomock.Any(), "header.payload.signature").Return("header.key.iv.ciphertext.tag", nil)
+			},
+			wantErr: false,
+			want:    "header.key.iv.ciphertext.tag",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEncrypter := jwemock.NewMockEncrypter(ctrl)
+			mockEncoder := jwsreqmock.NewMockAuthorizationEncoder(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(mockEncrypter, mockEncoder)
+			}
+
+			enc := JWEAuthorizationEncoder(mockEncrypter, mockEncoder)
+			got, err := enc.Encode(tt.args.ctx, tt.args.ar)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("jweEncoder.Encode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("jweEncoder.Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -33,6 +33,8 @@ type Verifier interface {
 	Parse(token string) (Token, error)
 	Verify(token string) error
 	Claims(token string, claims interface{}) error
+	// SupportedAlgorithms returns the accepted signature algorithms.
+	SupportedAlgorithms() []string
 }
 
 //go:generate mockgen -destination mock/token.gen.go -package mock zntr.io/solid/pkg/sdk/jwt Token
@@ -18,12 +18,14 @@
 package jwt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/square/go-jose/v3"
 	"github.com/square/go-jose/v3/jwt"
 
+	"zntr.io/solid/pkg/sdk/jwk"
 	"zntr.io/solid/pkg/sdk/types"
 )
 
@@ -48,6 +50,11 @@ func (ds *defaultSigner) Sign(claims interface{}) (string, error) {
 		return "", errors.New("unable to sign nil claim object")
 	}
 
+	// Check algorithm and key consistency
+	if err := jwk.CheckAlgorithm(ds.privateKey.Key, string(ds.privateKey.Algorithm)); err != nil {
+		return "", fmt.Errorf("unable to use signing key: %w", err)
+	}
+
 	// Prepare a signer
 	sig, err := jose.NewSigner(ds.privateKey, ds.options)
 	if err != nil {
@@ -63,3 +70,72 @@ func (ds *defaultSigner) Sign(claims interface{}) (string, error) {
 	// No error
 	return raw, nil
 }
+
+// -----------------------------------------------------------------------------
+
+// ProviderSigner declares a JWT signer resolving the private key from the
+// given provider for each signature, so that key rotation is honored. The
+// algorithm is given to the provider as a hint, when blank it is derived from
+// the resolved key.
+func ProviderSigner(alg jose.SignatureAlgorithm, keyProvider jwk.KeyProviderFunc, opts *jose.SignerOptions) Signer {
+	return &providerSigner{
+		alg:         alg,
+		keyProvider: keyProvider,
+		options:     opts,
+	}
+}
+
+type providerSigner struct {
+	alg         jose.SignatureAlgorithm
+	keyProvider jwk.KeyProviderFunc
+	options     *jose.SignerOptions
+}
+
+func (ps *providerSigner) Sign(claims interface{}) (string, error) {
+	// Check arguments
+	if ps.keyProvider == nil {
+		return "", errors.New("unable to sign with nil key provider")
+	}
+
+	// Give expected algorithm as a hint to the key provider
+	ctx := context.Background()
+	if ps.alg != "" {
+		ctx = jwk.WithAlgorithm(ctx, string(ps.alg))
+	}
+
+	// Retrieve current private key
+	pk, err := ps.keyProvider(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve signing key: %w", err)
+	}
+	if pk == nil {
+		return "", errors.New("key provider returned a nil key")
+	}
+
+	// Resolve algorithm from key
+	alg := ps.alg
+	if alg == "" {
+		keyAlg, err := jwk.Algorithm(pk)
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve signature algorithm: %w", err)
+		}
+		alg = jose.SignatureAlgorithm(keyAlg)
+	}
+
+	// Delegate to default signer
+	return DefaultSigner(jose.SigningKey{
+		Algorithm: alg,
+		Key:       pk,
+	}, ps.options).Sign(claims)
+}
+
+// -----------------------------------------------------------------------------
+
+// OpaqueSigner declares a JWT signer delegating signatures to the given opaque
+// signer so that private key material is not held by the JWT signer.
+func OpaqueSigner(alg jose.SignatureAlgorithm, signer jose.OpaqueSigner, opts *jose.SignerOptions) Signer {
+	return DefaultSigner(jose.SigningKey{
+		Algorithm: alg,
+		Key:       signer,
+	}, opts)
+}
@@ -18,13 +18,17 @@
 package jwt
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"fmt"
 	"testing"
 
 	"github.com/dchest/uniuri"
 	"github.com/square/go-jose/v3"
+
+	"zntr.io/solid/pkg/sdk/jwk"
 )
 
 func Test_defaultSigner_Sign(t *testing.T) {
@@ -151,3 +155,78 @@ func Test_defaultSigner_Sign(t *testing.T) {
 		})
 	}
 }
+
+func Test_providerSigner_Sign(t *testing.T) {
+	// Generate an ephemeral key
+	pk, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type fields struct {
+		keyProvider jwk.KeyProviderFunc
+	}
+	type args struct {
+		claims interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "nil key provider",
+			wantErr: true,
+		},
+		{
+			name: "key provider error",
+			fields: fields{
+				keyProvider: func(_ context.Context) (*jose.JSONWebKey, error) {
+					return nil, fmt.Errorf("foo")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil key",
+			fields: fields{
+				keyProvider: func(_ context.Context) (*jose.JSONWebKey, error) {
+					return nil, nil
+				},
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			fields: fields{
+				keyProvider: func(_ context.Context) (*jose.JSONWebKey, error) {
+					return &jose.JSONWebKey{
+						Use:   "sig",
+						Key:   pk,
+						KeyID: uniuri.NewLen(8),
+					}, nil
+				},
+			},
+			args: args{
+				claims: struct {
+					JTI string `json:"jti"`
+				}{
+					JTI: uniuri.New(),
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := ProviderSigner(jose.ES384, tt.fields.keyProvider, &jose.SignerOptions{})
+			_, err := ps.Sign(tt.args.claims)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("providerSigner.Sign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
@@ -42,6 +42,14 @@ type defaultVerifier struct {
 	supportedAlgorithms types.StringArray
 }
 
+func (v *defaultVerifier) SupportedAlgorithms() []string {
+	// Return a copy
+	algs := make([]string, len(v.supportedAlgorithms))
+	copy(algs, v.supportedAlgorithms)
+
+	return algs
+}
+
 func (v *defaultVerifier) Parse(token string) (Token, error) {
 	// Parse JWT token
 	t, err := jwt.ParseSigned(token)
@@ -84,17 +92,37 @@ func (v *defaultVerifier) Claims(raw string, claims interface{}) error {
 		return fmt.Errorf("unable to parse signed token: %w", err)
 	}
 
+	// Check token header
+	if len(token.Headers) == 0 {
+		return fmt.Errorf("unable to process token without header")
+	}
+
+	// Validate algorithm
+	alg := token.Headers[0].Algorithm
+	if !v.supportedAlgorithms.Contains(alg) {
+		return fmt.Errorf("token uses an invalid or not supported algorithm `%s`", alg)
+	}
+
+	// Give key identifier as a hint to the key set provider
+	ctx := context.Background()
+	kid := token.Headers[0].KeyID
+	if kid != "" {
+		ctx = jwk.WithKeyID(ctx, kid)
+	}
+
 	// Retrieve KeySet
-	jwks, err := v.keySetProvider(context.Background())
+	jwks, err := v.keySetProvider(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve KeySet: %w", err)
 	}
+	if jwks == nil {
+		return fmt.Errorf("key set provider returned a nil KeySet")
+	}
 
 	// Set all keys by default
 	keys := jwks.Keys
 
 	// Check if token refer to a key
-	kid := token.Headers[0].KeyID
 	if kid != "" {
 		keys = jwks.Key(kid)
 	}
@@ -112,6 +140,11 @@ func (v *defaultVerifier) Claims(raw string, claims interface{}) error {
 			continue
 		}
 
+		// Check algorithm and key consistency
+		if err := jwk.CheckAlgorithm(k, alg); err != nil {
+			continue
+		}
+
 		// Try to verify with current key
 		if err := token.Claims(k, claims); err != nil {
 			continue
@@ -0,0 +1,97 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/square/go-jose/v3"
+
+	"zntr.io/solid/pkg/sdk/jwk"
+)
+
+func Test_defaultVerifier_Claims_Matrix(t *testing.T) {
+	generate := map[string]func() (crypto.Signer, error){
+		"ES256": func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P256(), rand.Reader) },
+		"ES384": func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P384(), rand.Reader) },
+		"PS256": func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 2048) },
+		"EdDSA": func() (crypto.Signer, error) {
+			_, pk, err := ed25519.GenerateKey(rand.Reader)
+			return pk, err
+		},
+	}
+
+	for _, alg := range jwk.SupportedSignatureAlgorithms {
+		pk, err := generate[alg]()
+		if err != nil {
+			t.Fatal(err)
+		}
+		key := &jose.JSONWebKey{Key: pk, KeyID: "key-" + alg, Use: "sig", Algorithm: alg}
+		pub := key.Public()
+
+		for _, verifierAlg := range jwk.SupportedSignatureAlgorithms {
+			for _, keyAlg := range []string{"", alg, "RS256"} {
+				wantErr := verifierAlg != alg || keyAlg == "RS256"
+
+				t.Run(alg+"/"+verifierAlg+"/"+keyAlg, func(t *testing.T) {
+					// Sign token
+					raw, err := DefaultSigner(jose.SigningKey{
+						Algorithm: jose.SignatureAlgorithm(alg),
+						Key:       key,
+					}, nil).Sign(map[string]interface{}{"jti": "123456"})
+					if err != nil {
+						t.Fatalf("unable to sign token: %v", err)
+					}
+
+					// Prepare verifier
+					verificationKey := pub
+					verificationKey.Algorithm = keyAlg
+					underTest := DefaultVerifier(func(_ context.Context) (*jose.JSONWebKeySet, error) {
+						return &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{verificationKey}}, nil
+					}, []string{verifierAlg})
+
+					claims := map[string]interface{}{}
+					if err := underTest.Claims(raw, &claims); (err != nil) != wantErr {
+						t.Errorf("defaultVerifier.Claims() error = %v, wantErr %v", err, wantErr)
+					}
+				})
+			}
+		}
+
+		t.Run(alg+"/key mismatch", func(t *testing.T) {
+			for _, other := range jwk.SupportedSignatureAlgorithms {
+				if other == alg {
+					continue
+				}
+				if _, err := DefaultSigner(jose.SigningKey{
+					Algorithm: jose.SignatureAlgorithm(other),
+					Key:       pk,
+				}, nil).Sign(map[string]interface{}{"jti": "123456"}); err == nil {
+					t.Errorf("signing with %s key and %s algorithm must be rejected", alg, other)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package locale
+
+import (
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+// ClientName returns the client name matching the given ui_locales.
+func ClientName(c *corev1.Client, uiLocales string) string {
+	return Localize(c.GetClientName(), c.GetClientNameI18N(), uiLocales)
+}
+
+// LogoURI returns the client logo URI matching the given ui_locales.
+func LogoURI(c *corev1.Client, uiLocales string) string {
+	return Localize(c.GetLogoUri(), c.GetLogoUriI18N(), uiLocales)
+}
+
+// TosURI returns the client terms of service URI matching the given ui_locales.
+func TosURI(c *corev1.Client, uiLocales string) string {
+	return Localize(c.GetTosUri(), c.GetTosUriI18N(), uiLocales)
+}
+
+// PolicyURI returns the client policy URI matching the given ui_locales.
+func PolicyURI(c *corev1.Client, uiLocales string) string {
+	return Localize(c.GetPolicyUri(), c.GetPolicyUriI18N(), uiLocales)
+}
@@ -0,0 +1,161 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package locale provides BCP47 language tag helpers used to resolve localized
+// client metadata.
+//
+// https://openid.net/specs/openid-connect-core-1_0.html#ClaimsLanguagesAndScripts
+package locale
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// https://tools.ietf.org/html/rfc5646#section-2.1
+var langtagRegex = regexp.MustCompile(`(?i)^(?:` +
+	// language ["-" script] ["-" region] *("-" variant) *("-" extension) ["-" privateuse]
+	`(?:[a-z]{2,3}(?:-[a-z]{3}){0,3}|[a-z]{4}|[a-z]{5,8})` +
+	`(?:-[a-z]{4})?` +
+	`(?:-(?:[a-z]{2}|[0-9]{3}))?` +
+	`(?:-(?:[a-z0-9]{5,8}|[0-9][a-z0-9]{3}))*` +
+	`(?:-[0-9a-wy-z](?:-[a-z0-9]{2,8})+)*` +
+	`(?:-x(?:-[a-z0-9]{1,8})+)?` +
+	// privateuse
+	`|x(?:-[a-z0-9]{1,8})+` +
+	`)$`)
+
+// https://tools.ietf.org/html/rfc5646#section-2.2.8
+var grandfathered = map[string]struct{}{
+	"en-gb-oed": {}, "i-ami": {}, "i-bnn": {}, "i-default": {}, "i-enochian": {},
+	"i-hak": {}, "i-klingon": {}, "i-lux": {}, "i-mingo": {}, "i-navajo": {},
+	"i-pwn": {}, "i-tao": {}, "i-tay": {}, "i-tsu": {}, "sgn-be-fr": {},
+	"sgn-be-nl": {}, "sgn-ch-de": {},
+}
+
+// IsValid returns true if the given tag is a well-formed BCP47 language tag.
+func IsValid(tag string) bool {
+	if _, ok := grandfathered[strings.ToLower(tag)]; ok {
+		return true
+	}
+
+	return langtagRegex.MatchString(tag)
+}
+
+// Lookup returns the value registered for the best matching language tag
+// according to the given space delimited preferred languages (ui_locales).
+// It returns false when no value matches.
+//
+// https://tools.ietf.org/html/rfc4647#section-3.4
+func Lookup(values map[string]string, preferred string) (string, bool) {
+	// Check arguments
+	if len(values) == 0 {
+		return "", false
+	}
+
+	// Tags are case insensitive
+	index := make(map[string]string, len(values))
+	for tag, value := range values {
+		index[strings.ToLower(tag)] = value
+	}
+
+	for _, lang := range strings.Fields(preferred) {
+		lang = strings.ToLower(lang)
+		for lang != "" && lang != "*" {
+			if value, ok := index[lang]; ok {
+				return value, true
+			}
+			lang = truncate(lang)
+		}
+	}
+
+	// No match
+	return "", false
+}
+
+// Localize returns the localized value or the given default value when no
+// localized value matches the preferred languages.
+func Localize(defaultValue string, values map[string]string, preferred string) string {
+	if value, ok := Lookup(values, preferred); ok {
+		return value
+	}
+
+	return defaultValue
+}
+
+// Members decodes localized string members of the given JSON object, expressed
+// as `<name>#<language tag>`, for the given member names. Values are indexed by
+// member name and language tag.
+//
+// https://openid.net/specs/openid-connect-registration-1_0.html#LanguagesAndScripts
+func Members(data []byte, names ...string) (map[string]map[string]string, error) {
+	// Decode all members
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+
+	res := map[string]map[string]string{}
+	for key, raw := range members {
+		parts := strings.SplitN(key, "#", 2)
+		if len(parts) != 2 || !contains(names, parts[0]) {
+			continue
+		}
+
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("unable to decode '%s' member: %w", key, err)
+		}
+		if res[parts[0]] == nil {
+			res[parts[0]] = map[string]string{}
+		}
+		res[parts[0]][parts[1]] = value
+	}
+
+	// No error
+	return res, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// truncate removes the last subtag of the given language range, and the
+// preceding singleton if any.
+func truncate(lang string) string {
+	idx := strings.LastIndex(lang, "-")
+	if idx < 0 {
+		return ""
+	}
+	lang = lang[:idx]
+
+	// Remove trailing singleton
+	if idx = strings.LastIndex(lang, "-"); idx >= 0 && len(lang)-idx == 2 {
+		lang = lang[:idx]
+	}
+
+	return lang
+}
@@ -0,0 +1,134 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package locale
+
+import (
+	"testing"
+)
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		want bool
+	}{
+		{name: "blank", tag: "", want: false},
+		{name: "too short", tag: "f", want: false},
+		{name: "underscore", tag: "en_US", want: false},
+		{name: "trailing dash", tag: "en-", want: false},
+		{name: "invalid region", tag: "en-USA1", want: false},
+		{name: "empty extension", tag: "en-a", want: false},
+		{name: "empty private use", tag: "x", want: false},
+		// ---------------------------------------------------------------------
+		{name: "language", tag: "fr", want: true},
+		{name: "language / region", tag: "en-US", want: true},
+		{name: "language / numeric region", tag: "es-419", want: true},
+		{name: "language / script / region", tag: "ja-Jpan-JP", want: true},
+		{name: "extended language", tag: "zh-yue-HK", want: true},
+		{name: "variant", tag: "sl-rozaj-biske", want: true},
+		{name: "extension", tag: "de-DE-u-co-phonebk", want: true},
+		{name: "private use suffix", tag: "en-US-x-twain", want: true},
+		{name: "private use", tag: "x-whatever", want: true},
+		{name: "grandfathered", tag: "i-klingon", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValid(tt.tag); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	values := map[string]string{
+		"fr":         "Client",
+		"ja-Jpan-JP": "クライアント名",
+		"de-CH":      "Kunde",
+	}
+
+	tests := []struct {
+		name      string
+		values    map[string]string
+		preferred string
+		want      string
+		wantFound bool
+	}{
+		{name: "nil values", preferred: "fr", wantFound: false},
+		{name: "blank preferred", values: values, wantFound: false},
+		{name: "no match", values: values, preferred: "en-US es", wantFound: false},
+		{name: "more specific value", values: values, preferred: "de", wantFound: false},
+		{name: "wildcard", values: values, preferred: "*", wantFound: false},
+		// ---------------------------------------------------------------------
+		{name: "exact", values: values, preferred: "fr", want: "Client", wantFound: true},
+		{name: "case insensitive", values: values, preferred: "JA-jpan-jp", want: "クライアント名", wantFound: true},
+		{name: "truncated", values: values, preferred: "fr-CA", want: "Client", wantFound: true},
+		{name: "truncated singleton", values: values, preferred: "de-CH-x-phonebk", want: "Kunde", wantFound: true},
+		{name: "preference order", values: values, preferred: "en de-CH fr", want: "Kunde", wantFound: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := Lookup(tt.values, tt.preferred)
+			if found != tt.wantFound {
+				t.Errorf("Lookup() found = %v, want %v", found, tt.wantFound)
+			}
+			if got != tt.want {
+				t.Errorf("Lookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	values := map[string]string{
+		"fr": "Client",
+	}
+
+	if got := Localize("My Client", values, "fr-FR"); got != "Client" {
+		t.Errorf("Localize() = %v, want %v", got, "Client")
+	}
+	if got := Localize("My Client", values, "en"); got != "My Client" {
+		t.Errorf("Localize() = %v, want %v", got, "My Client")
+	}
+}
+
+func TestMembers(t *testing.T) {
+	data := []byte(`{
+		"client_name": "My Client",
+		"client_name#fr": "Mon client",
+		"client_name#ja-Jpan-JP": "クライアント名",
+		"logo_uri#fr": "https://client.example.org/logo-fr.png",
+		"unknown#fr": "ignored"
+	}`)
+
+	got, err := Members(data, "client_name", "tos_uri")
+	if err != nil {
+		t.Fatalf("Members() error = %v", err)
+	}
+	if len(got) != 1 || len(got["client_name"]) != 2 {
+		t.Fatalf("Members() = %v, want 2 client_name values only", got)
+	}
+	if got["client_name"]["ja-Jpan-JP"] != "クライアント名" {
+		t.Errorf("Members() = %v, want %v", got["client_name"]["ja-Jpan-JP"], "クライアント名")
+	}
+
+	// Localized members must be strings
+	if _, err := Members([]byte(`{"client_name#fr": 1}`), "client_name"); err == nil {
+		t.Error("Members() must reject non string values")
+	}
+}
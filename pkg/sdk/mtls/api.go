@@ -0,0 +1,27 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mtls
+
+import "errors"
+
+// ConfirmationClaim defines the certificate thumbprint confirmation member name.
+const ConfirmationClaim = "x5t#S256"
+
+// ErrCertificateMismatch is raised when the presented certificate doesn't
+// match the token confirmation.
+var ErrCertificateMismatch = errors.New("certificate thumbprint mismatch")
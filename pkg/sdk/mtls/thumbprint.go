@@ -0,0 +1,60 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mtls
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+)
+
+// Thumbprint returns the base64url-encoded SHA-256 hash of the DER encoding
+// of the given certificate.
+// https://tools.ietf.org/html/rfc8705#section-3.1
+func Thumbprint(cert *x509.Certificate) (string, error) {
+	// Check arguments
+	if cert == nil {
+		return "", fmt.Errorf("unable to compute thumbprint of nil certificate")
+	}
+	if len(cert.Raw) == 0 {
+		return "", fmt.Errorf("unable to compute thumbprint of empty certificate")
+	}
+
+	// Compute hash
+	h := sha256.Sum256(cert.Raw)
+
+	// No error
+	return base64.RawURLEncoding.EncodeToString(h[:]), nil
+}
+
+// ClientCertificate returns the leaf certificate presented by the client
+// during the TLS handshake.
+func ClientCertificate(r *http.Request) (*x509.Certificate, error) {
+	// Check arguments
+	if r == nil {
+		return nil, fmt.Errorf("unable to process nil request")
+	}
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, fmt.Errorf("request doesn't have a client certificate")
+	}
+
+	// No error
+	return r.TLS.PeerCertificates[0], nil
+}
@@ -0,0 +1,68 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mtls
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+// Verify checks that the given certificate matches the certificate thumbprint
+// held by the token confirmation.
+// https://tools.ietf.org/html/rfc8705#section-3
+func Verify(cert *x509.Certificate, cnf *corev1.TokenConfirmation) error {
+	// Check arguments
+	if cnf == nil {
+		return fmt.Errorf("unable to verify certificate with nil confirmation")
+	}
+	if cnf.X5TS256 == "" {
+		return fmt.Errorf("token is not bound to a certificate")
+	}
+
+	// Compute presented certificate thumbprint
+	x5t, err := Thumbprint(cert)
+	if err != nil {
+		return fmt.Errorf("unable to compute certificate thumbprint: %w", err)
+	}
+
+	// Compare thumbprints
+	if !types.SecureCompareString(x5t, cnf.X5TS256) {
+		return ErrCertificateMismatch
+	}
+
+	// No error
+	return nil
+}
+
+// VerifyRequest checks that the client certificate used for the given request
+// matches the token confirmation. It should be used by resource servers to
+// validate certificate-bound access tokens.
+func VerifyRequest(r *http.Request, cnf *corev1.TokenConfirmation) error {
+	// Retrieve client certificate
+	cert, err := ClientCertificate(r)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve client certificate: %w", err)
+	}
+
+	// Delegate to certificate verification
+	return Verify(cert, cnf)
+}
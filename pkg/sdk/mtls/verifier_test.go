@@ -0,0 +1,167 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+func generateCertificate(t *testing.T) *x509.Certificate {
+	t.Helper()
+
+	// Generate a key pair
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	// Self-sign a certificate
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "s6BhdRkqt3"},
+		NotBefore:    time.Unix(1, 0),
+		NotAfter:     time.Unix(3601, 0),
+	}, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "s6BhdRkqt3"},
+	}, &pk.PublicKey, pk)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %v", err)
+	}
+
+	return cert
+}
+
+func TestThumbprint(t *testing.T) {
+	cert := generateCertificate(t)
+
+	tests := []struct {
+		name    string
+		cert    *x509.Certificate
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			cert:    &x509.Certificate{},
+			wantErr: true,
+		},
+		{
+			name:    "valid",
+			cert:    cert,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Thumbprint(tt.cert)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Thumbprint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != 43 {
+				t.Errorf("Thumbprint() = %v, expected 43 characters", got)
+			}
+		})
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	cert := generateCertificate(t)
+	other := generateCertificate(t)
+
+	x5t, err := Thumbprint(cert)
+	if err != nil {
+		t.Fatalf("unable to compute thumbprint: %v", err)
+	}
+
+	withCertificate := func(c *x509.Certificate) *http.Request {
+		return &http.Request{
+			TLS: &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{c},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		r       *http.Request
+		cnf     *corev1.TokenConfirmation
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name:    "no tls",
+			r:       &http.Request{},
+			cnf:     &corev1.TokenConfirmation{X5TS256: x5t},
+			wantErr: true,
+		},
+		{
+			name:    "nil confirmation",
+			r:       withCertificate(cert),
+			wantErr: true,
+		},
+		{
+			name:    "unbound token",
+			r:       withCertificate(cert),
+			cnf:     &corev1.TokenConfirmation{Jkt: "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"},
+			wantErr: true,
+		},
+		{
+			name:    "certificate mismatch",
+			r:       withCertificate(other),
+			cnf:     &corev1.TokenConfirmation{X5TS256: x5t},
+			wantErr: true,
+		},
+		{
+			name:    "valid",
+			r:       withCertificate(cert),
+			cnf:     &corev1.TokenConfirmation{X5TS256: x5t},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifyRequest(tt.r, tt.cnf); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
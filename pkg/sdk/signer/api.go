@@ -0,0 +1,62 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signer
+
+import (
+	"crypto"
+	"errors"
+	"io"
+)
+
+// ErrUnsupportedAlgorithm is raised when the signer key can't produce the
+// requested signature algorithm.
+var ErrUnsupportedAlgorithm = errors.New("unsupported signature algorithm")
+
+// SignFunc describes a digest signature callback contract. It has the same
+// semantics as crypto.Signer Sign function, the digest is the raw message when
+// opts.HashFunc() is zero (Ed25519).
+type SignFunc func(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error)
+
+// Func wraps the given public key and signature callback as a crypto.Signer.
+func Func(publicKey crypto.PublicKey, fn SignFunc) crypto.Signer {
+	return &funcSigner{
+		publicKey: publicKey,
+		fn:        fn,
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type funcSigner struct {
+	publicKey crypto.PublicKey
+	fn        SignFunc
+}
+
+func (s *funcSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+func (s *funcSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	// Check arguments
+	if s.fn == nil {
+		return nil, errors.New("unable to sign with nil callback")
+	}
+
+	// Delegate to callback
+	return s.fn(rand, digest, opts)
+}
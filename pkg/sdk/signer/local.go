@@ -0,0 +1,53 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signer
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/square/go-jose/v3"
+)
+
+// Local returns an opaque signer backed by an in-process software private key.
+// The key identifier and algorithm are taken from the given key.
+func Local(k *jose.JSONWebKey) (jose.OpaqueSigner, error) {
+	// Check arguments
+	if k == nil {
+		return nil, errors.New("unable to use nil key")
+	}
+	if k.IsPublic() {
+		return nil, errors.New("key must be a private key")
+	}
+
+	// Extract signer
+	s, ok := k.Key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("key type %T can't be used as a signer", k.Key)
+	}
+
+	// Restrict to key algorithm if any
+	algs := []jose.SignatureAlgorithm{}
+	if k.Algorithm != "" {
+		algs = append(algs, jose.SignatureAlgorithm(k.Algorithm))
+	}
+
+	// Wrap as opaque signer
+	return Opaque(s, k.KeyID, algs...)
+}
@@ -0,0 +1,84 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/square/go-jose/v3"
+)
+
+func TestLocal(t *testing.T) {
+	pk := generateKey(t, jose.ES256)
+
+	tests := []struct {
+		name    string
+		k       *jose.JSONWebKey
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name: "public key",
+			k: &jose.JSONWebKey{
+				Key: pk.Public(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "symmetric key",
+			k: &jose.JSONWebKey{
+				Key: []byte("foo"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "algorithm / key mismatch",
+			k: &jose.JSONWebKey{
+				Key:       pk,
+				Algorithm: string(jose.ES384),
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			k: &jose.JSONWebKey{
+				Key:       pk.(*ecdsa.PrivateKey),
+				KeyID:     "123456",
+				Algorithm: string(jose.ES256),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Local(tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Local() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.Public().KeyID != tt.k.KeyID {
+				t.Errorf("Local() kid = %v, want %v", got.Public().KeyID, tt.k.KeyID)
+			}
+		})
+	}
+}
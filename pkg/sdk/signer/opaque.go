@@ -0,0 +1,210 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/square/go-jose/v3"
+)
+
+// Opaque adapts the given crypto.Signer as a JOSE opaque signer so that the
+// private key never leaves the signer. Algorithms default to all algorithms
+// compatible with the public key type. The key identifier defaults to the
+// public key thumbprint.
+func Opaque(s crypto.Signer, kid string, algs ...jose.SignatureAlgorithm) (jose.OpaqueSigner, error) {
+	// Check arguments
+	if s == nil {
+		return nil, errors.New("unable to use nil signer")
+	}
+
+	// Retrieve compatible algorithms
+	supported, err := algorithms(s.Public())
+	if err != nil {
+		return nil, err
+	}
+	if len(algs) == 0 {
+		algs = supported
+	}
+	for _, alg := range algs {
+		if !containsAlg(supported, alg) {
+			return nil, fmt.Errorf("algorithm '%s' can't be used with the signer key: %w", alg, ErrUnsupportedAlgorithm)
+		}
+	}
+
+	// Prepare public key
+	pub := &jose.JSONWebKey{
+		Key:       s.Public(),
+		Use:       "sig",
+		Algorithm: string(algs[0]),
+	}
+
+	// Assign key identifier
+	if kid == "" {
+		h, err := pub.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute key thumbprint: %w", err)
+		}
+		kid = base64.RawURLEncoding.EncodeToString(h)
+	}
+	pub.KeyID = kid
+
+	// No error
+	return &opaqueSigner{
+		signer: s,
+		public: pub,
+		algs:   algs,
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+
+type opaqueSigner struct {
+	signer crypto.Signer
+	public *jose.JSONWebKey
+	algs   []jose.SignatureAlgorithm
+}
+
+func (s *opaqueSigner) Public() *jose.JSONWebKey {
+	return s.public
+}
+
+func (s *opaqueSigner) Algs() []jose.SignatureAlgorithm {
+	return s.algs
+}
+
+func (s *opaqueSigner) SignPayload(payload []byte, alg jose.SignatureAlgorithm) ([]byte, error) {
+	// Check arguments
+	if !containsAlg(s.algs, alg) {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	// Pure signature
+	if alg == jose.EdDSA {
+		return s.signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	}
+
+	// Compute digest
+	h := hashFunc(alg)
+	hasher := h.New()
+	if _, err := hasher.Write(payload); err != nil {
+		return nil, fmt.Errorf("unable to compute payload digest: %w", err)
+	}
+	digest := hasher.Sum(nil)
+
+	switch alg {
+	case jose.PS256, jose.PS384, jose.PS512:
+		return s.signer.Sign(rand.Reader, digest, &rsa.PSSOptions{
+			SaltLength: rsa.PSSSaltLengthEqualsHash,
+			Hash:       h,
+		})
+	case jose.RS256, jose.RS384, jose.RS512:
+		return s.signer.Sign(rand.Reader, digest, h)
+	default:
+	}
+
+	// ECDSA signers return ASN.1 encoded signatures
+	der, err := s.signer.Sign(rand.Reader, digest, h)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, ok := s.public.Key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	return concatSignature(pub, der)
+}
+
+// -----------------------------------------------------------------------------
+
+func algorithms(pub crypto.PublicKey) ([]jose.SignatureAlgorithm, error) {
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			return []jose.SignatureAlgorithm{jose.ES256}, nil
+		case 384:
+			return []jose.SignatureAlgorithm{jose.ES384}, nil
+		case 521:
+			return []jose.SignatureAlgorithm{jose.ES512}, nil
+		default:
+		}
+	case *rsa.PublicKey:
+		return []jose.SignatureAlgorithm{jose.PS256, jose.PS384, jose.PS512, jose.RS256, jose.RS384, jose.RS512}, nil
+	case ed25519.PublicKey:
+		return []jose.SignatureAlgorithm{jose.EdDSA}, nil
+	default:
+	}
+
+	return nil, fmt.Errorf("signer public key type %T is not supported: %w", pub, ErrUnsupportedAlgorithm)
+}
+
+func hashFunc(alg jose.SignatureAlgorithm) crypto.Hash {
+	switch alg {
+	case jose.ES384, jose.PS384, jose.RS384:
+		return crypto.SHA384
+	case jose.ES512, jose.PS512, jose.RS512:
+		return crypto.SHA512
+	default:
+	}
+
+	return crypto.SHA256
+}
+
+func containsAlg(algs []jose.SignatureAlgorithm, alg jose.SignatureAlgorithm) bool {
+	for _, a := range algs {
+		if a == alg {
+			return true
+		}
+	}
+
+	return false
+}
+
+// concatSignature converts an ASN.1 ECDSA signature to the JWS R || S form.
+// https://www.rfc-editor.org/rfc/rfc7518.html#section-3.4
+func concatSignature(pub *ecdsa.PublicKey, der []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("unable to decode ECDSA signature: %w", err)
+	}
+
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	if sig.R == nil || sig.S == nil || sig.R.BitLen() > 8*size || sig.S.BitLen() > 8*size {
+		return nil, errors.New("invalid ECDSA signature size")
+	}
+
+	out := make([]byte, 2*size)
+	sig.R.FillBytes(out[:size])
+	sig.S.FillBytes(out[size:])
+
+	return out, nil
+}
@@ -0,0 +1,191 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/square/go-jose/v3"
+)
+
+func generateKey(t *testing.T, alg jose.SignatureAlgorithm) crypto.Signer {
+	t.Helper()
+
+	var (
+		k   crypto.Signer
+		err error
+	)
+	switch alg {
+	case jose.ES256:
+		k, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case jose.ES384:
+		k, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case jose.ES512:
+		k, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case jose.EdDSA:
+		_, k, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		k, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return k
+}
+
+func Test_opaqueSigner_SignPayload(t *testing.T) {
+	algs := []jose.SignatureAlgorithm{jose.ES256, jose.ES384, jose.ES512, jose.PS256, jose.RS256, jose.EdDSA}
+	for _, alg := range algs {
+		t.Run(string(alg), func(t *testing.T) {
+			s, err := Opaque(generateKey(t, alg), "", alg)
+			if err != nil {
+				t.Fatalf("unable to initialize opaque signer: %v", err)
+			}
+			if s.Public().KeyID == "" {
+				t.Fatal("key identifier must be assigned")
+			}
+
+			// Sign with opaque signer
+			sig, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: s}, nil)
+			if err != nil {
+				t.Fatalf("unable to prepare signer: %v", err)
+			}
+			jws, err := sig.Sign([]byte("foo"))
+			if err != nil {
+				t.Fatalf("unable to sign payload: %v", err)
+			}
+			raw, err := jws.CompactSerialize()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Verify with public key
+			parsed, err := jose.ParseSigned(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.Signatures[0].Header.KeyID != s.Public().KeyID {
+				t.Error("key identifier must be set in header")
+			}
+			if _, err := parsed.Verify(s.Public()); err != nil {
+				t.Errorf("unable to verify signature: %v", err)
+			}
+		})
+	}
+}
+
+func TestOpaque(t *testing.T) {
+	type args struct {
+		s    crypto.Signer
+		kid  string
+		algs []jose.SignatureAlgorithm
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name: "unsupported key type",
+			args: args{
+				s: Func("foo", nil),
+			},
+			wantErr: true,
+		},
+		{
+			name: "algorithm / key mismatch",
+			args: args{
+				s:    generateKey(t, jose.ES256),
+				algs: []jose.SignatureAlgorithm{jose.ES384},
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				s:   generateKey(t, jose.PS256),
+				kid: "123456",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Opaque(tt.args.s, tt.args.kid, tt.args.algs...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Opaque() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.Public().KeyID != tt.args.kid {
+				t.Errorf("Opaque() kid = %v, want %v", got.Public().KeyID, tt.args.kid)
+			}
+		})
+	}
+}
+
+func TestFunc(t *testing.T) {
+	k := generateKey(t, jose.ES256)
+
+	// Callback error
+	s, err := Opaque(Func(k.Public(), func(_ io.Reader, _ []byte, _ crypto.SignerOpts) ([]byte, error) {
+		return nil, fmt.Errorf("foo")
+	}), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SignPayload([]byte("foo"), jose.ES256); err == nil {
+		t.Fatal("callback error must be raised")
+	}
+
+	// Invalid signature encoding
+	s, err = Opaque(Func(k.Public(), func(_ io.Reader, _ []byte, _ crypto.SignerOpts) ([]byte, error) {
+		return []byte("foo"), nil
+	}), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SignPayload([]byte("foo"), jose.ES256); err == nil {
+		t.Fatal("invalid signature must be rejected")
+	}
+
+	// Delegate to key
+	s, err = Opaque(Func(k.Public(), k.Sign), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SignPayload([]byte("foo"), jose.ES384); err == nil {
+		t.Fatal("unsupported algorithm must be rejected")
+	}
+	if _, err := s.SignPayload([]byte("foo"), jose.ES256); err != nil {
+		t.Fatalf("unable to sign payload: %v", err)
+	}
+}
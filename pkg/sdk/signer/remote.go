@@ -0,0 +1,148 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const maxMessageSize = 64 << 10
+
+var hashes = map[string]crypto.Hash{
+	"":        crypto.Hash(0),
+	"SHA-256": crypto.SHA256,
+	"SHA-384": crypto.SHA384,
+	"SHA-512": crypto.SHA512,
+}
+
+type signatureRequest struct {
+	Hash   string `json:"hash"`
+	PSS    bool   `json:"pss,omitempty"`
+	Digest []byte `json:"digest"`
+}
+
+type signatureResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// Remote returns a crypto.Signer delegating digest signatures to a signing
+// service exposed by Handler. The private key never enters this process.
+func Remote(client *http.Client, endpoint string, publicKey crypto.PublicKey) crypto.Signer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return Func(publicKey, func(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+		// Check arguments
+		if opts == nil {
+			return nil, errors.New("unable to sign without signer options")
+		}
+
+		// Prepare request
+		req := &signatureRequest{
+			Digest: digest,
+		}
+		if h := opts.HashFunc(); h != crypto.Hash(0) {
+			req.Hash = h.String()
+		}
+		if _, ok := opts.(*rsa.PSSOptions); ok {
+			req.PSS = true
+		}
+		body, err := json.Marshal(req)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode signature request: %w", err)
+		}
+
+		// Send to signing service
+		resp, err := client.Post(endpoint, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("unable to contact signing service: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("signing service returned an unexpected status %d", resp.StatusCode)
+		}
+
+		// Decode response
+		var res signatureResponse
+		if err := json.NewDecoder(io.LimitReader(resp.Body, maxMessageSize)).Decode(&res); err != nil {
+			return nil, fmt.Errorf("unable to decode signing service response: %w", err)
+		}
+		if len(res.Signature) == 0 {
+			return nil, errors.New("signing service returned an empty signature")
+		}
+
+		// No error
+		return res.Signature, nil
+	})
+}
+
+// Handler exposes the given signer as a signing service consumed by Remote.
+// It must be deployed behind an authenticated channel.
+func Handler(s crypto.Signer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only POST verb
+		if r.Method != http.MethodPost {
+			http.Error(w, "invalid request method", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Decode request
+		var req signatureRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxMessageSize)).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+
+		// Resolve signer options
+		h, ok := hashes[req.Hash]
+		if !ok || len(req.Digest) == 0 {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		var opts crypto.SignerOpts = h
+		if req.PSS {
+			opts = &rsa.PSSOptions{
+				SaltLength: rsa.PSSSaltLengthEqualsHash,
+				Hash:       h,
+			}
+		}
+
+		// Sign digest
+		sig, err := s.Sign(rand.Reader, req.Digest, opts)
+		if err != nil {
+			http.Error(w, "unable to sign digest", http.StatusInternalServerError)
+			return
+		}
+
+		// Send response
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&signatureResponse{Signature: sig}); err != nil {
+			http.Error(w, "unable to encode response", http.StatusInternalServerError)
+			return
+		}
+	})
+}
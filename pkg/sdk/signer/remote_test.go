@@ -0,0 +1,121 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signer_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/square/go-jose/v3"
+	josejwt "github.com/square/go-jose/v3/jwt"
+
+	"zntr.io/solid/pkg/sdk/jwt"
+	"zntr.io/solid/pkg/sdk/signer"
+	"zntr.io/solid/pkg/sdk/signer/signertest"
+)
+
+func TestRemote(t *testing.T) {
+	tests := []struct {
+		alg jose.SignatureAlgorithm
+		key func() (crypto.Signer, error)
+	}{
+		{
+			alg: jose.ES256,
+			key: func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P256(), rand.Reader) },
+		},
+		{
+			alg: jose.ES384,
+			key: func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P384(), rand.Reader) },
+		},
+		{
+			alg: jose.PS256,
+			key: func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 2048) },
+		},
+		{
+			alg: jose.EdDSA,
+			key: func() (crypto.Signer, error) {
+				_, pk, err := ed25519.GenerateKey(rand.Reader)
+				return pk, err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.alg), func(t *testing.T) {
+			pk, err := tt.key()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Start signing service
+			srv := signertest.NewServer(pk)
+			defer srv.Close()
+
+			// Wrap remote signer
+			s, err := signer.Opaque(srv.Signer(), "remote-key", tt.alg)
+			if err != nil {
+				t.Fatalf("unable to initialize opaque signer: %v", err)
+			}
+
+			// Sign claims
+			raw, err := jwt.OpaqueSigner(tt.alg, s, nil).Sign(map[string]interface{}{
+				"iss": "http://127.0.0.1:8080",
+			})
+			if err != nil {
+				t.Fatalf("unable to sign claims: %v", err)
+			}
+
+			// Verify with public key
+			token, err := josejwt.ParseSigned(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			claims := map[string]interface{}{}
+			if err := token.Claims(s.Public(), &claims); err != nil {
+				t.Fatalf("unable to verify token: %v", err)
+			}
+			if claims["iss"] != "http://127.0.0.1:8080" {
+				t.Errorf("unexpected claims: %v", claims)
+			}
+		})
+	}
+}
+
+func TestRemote_Unavailable(t *testing.T) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stop signing service
+	srv := signertest.NewServer(pk)
+	remote := srv.Signer()
+	srv.Close()
+
+	s, err := signer.Opaque(remote, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SignPayload([]byte("foo"), jose.ES256); err == nil {
+		t.Fatal("signing service error must be raised")
+	}
+}
@@ -0,0 +1,46 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package signertest
+
+import (
+	"crypto"
+	"net/http/httptest"
+
+	"zntr.io/solid/pkg/sdk/signer"
+)
+
+// Server is a local signing service standing in for an external signer
+// (HSM, KMS) in tests.
+type Server struct {
+	*httptest.Server
+	publicKey crypto.PublicKey
+}
+
+// NewServer starts a signing service holding the given private key.
+// The caller should call Close when finished.
+func NewServer(s crypto.Signer) *Server {
+	return &Server{
+		Server:    httptest.NewServer(signer.Handler(s)),
+		publicKey: s.Public(),
+	}
+}
+
+// Signer returns a remote signer bound to the signing service.
+func (s *Server) Signer() crypto.Signer {
+	return signer.Remote(s.Client(), s.URL, s.publicKey)
+}
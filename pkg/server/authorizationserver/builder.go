@@ -21,7 +21,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sync"
 
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
 	"zntr.io/solid/internal/services"
 	"zntr.io/solid/internal/services/authorization"
 	"zntr.io/solid/internal/services/client"
@@ -39,6 +41,12 @@ type AuthorizationServer interface {
 	Issuer() *url.URL
 	Enable(features.Feature)
 	Do(ctx context.Context, req interface{}) (interface{}, error)
+	// Metadata returns the server metadata built from enabled features and
+	// configured components.
+	Metadata(ctx context.Context) (*discoveryv1.ServerMetadata, error)
+	// Profile returns the server profile enforced on client registrations and
+	// requests.
+	Profile() profile.Server
 }
 
 // -----------------------------------------------------------------------------
@@ -50,11 +58,13 @@ func New(ctx context.Context, issuer string, opts ...Option) (AuthorizationServe
 		authorizationCodeGenerator:      generator.DefaultAuthorizationCode(),
 		accessTokenGenerator:            generator.DefaultToken(),
 		refreshTokenGenerator:           generator.DefaultToken(),
+		initialAccessTokenGenerator:     generator.DefaultInitialAccessToken(),
 		clientReader:                    nil,
-		clientWriter:                    nil,
+		clientManager:                   nil,
 		tokenManager:                    nil,
 		authorizationCodeSessionManager: nil,
 		deviceCodeSessionManager:        nil,
+		serverProfile:                   profile.Strict(),
 	}
 
 	// Parse issuer
@@ -69,10 +79,10 @@ func New(ctx context.Context, issuer string, opts ...Option) (AuthorizationServe
 	}
 
 	// Initialize services
-	authorizations := authorization.New(defaultOptions.clientReader, defaultOptions.authorizationRequestManager, defaultOptions.authorizationCodeSessionManager)
-	devices := device.New(defaultOptions.clientReader, defaultOptions.deviceCodeSessionManager)
-	tokens := token.New(defaultOptions.accessTokenGenerator, defaultOptions.idTokenGenerator, defaultOptions.clientReader, defaultOptions.authorizationRequestManager, defaultOptions.authorizationCodeSessionManager, defaultOptions.deviceCodeSessionManager, defaultOptions.tokenManager)
-	clients := client.New(defaultOptions.clientWriter, profile.Strict())
+	authorizations := authorization.New(defaultOptions.clientReader, defaultOptions.authorizationRequestManager, defaultOptions.authorizationCodeSessionManager, defaultOptions.serverProfile)
+	devices := device.New(defaultOptions.clientReader, defaultOptions.deviceCodeSessionManager, defaultOptions.serverProfile)
+	tokens := token.New(defaultOptions.accessTokenGenerator, defaultOptions.idTokenGenerator, defaultOptions.clientReader, defaultOptions.authorizationRequestManager, defaultOptions.authorizationCodeSessionManager, defaultOptions.deviceCodeSessionManager, defaultOptions.tokenManager, defaultOptions.serverProfile)
+	clients := client.New(fmt.Sprintf("%s%s", issuer, oidc.RegistrationEndpoint), defaultOptions.clientManager, defaultOptions.tokenManager, defaultOptions.initialAccessTokenManager, defaultOptions.initialAccessTokenGenerator, defaultOptions.serverProfile, defaultOptions.softwareStatementVerifier, defaultOptions.openRegistration, defaultOptions.clientAutoApproval)
 
 	// Wire message
 	as := &authorizationServer{
@@ -83,6 +93,9 @@ func New(ctx context.Context, issuer string, opts ...Option) (AuthorizationServe
 		clients:        clients,
 		r:              reactor.New(issuer),
 		dopts:          defaultOptions,
+		meta: &discoveryv1.ServerMetadata{
+			Issuer: issuer,
+		},
 	}
 
 	// Enable default features
@@ -98,6 +111,7 @@ func New(ctx context.Context, issuer string, opts ...Option) (AuthorizationServe
 }
 
 type authorizationServer struct {
+	sync.RWMutex
 	issuer         *url.URL
 	authorizations services.Authorization
 	tokens         services.Token
@@ -105,14 +119,22 @@ type authorizationServer struct {
 	clients        services.Client
 	r              reactor.Reactor
 	dopts          *options
+	meta           *discoveryv1.ServerMetadata
 }
 
 func (as *authorizationServer) Issuer() *url.URL {
 	return as.issuer
 }
 
+func (as *authorizationServer) Profile() profile.Server {
+	return as.dopts.serverProfile
+}
+
 func (as *authorizationServer) Enable(f features.Feature) {
-	f(as.r, as.authorizations, as.tokens, as.devices, as.clients)
+	as.Lock()
+	defer as.Unlock()
+
+	f(as.r, as.meta, as.authorizations, as.tokens, as.devices, as.clients)
 }
 
 func (as *authorizationServer) Do(ctx context.Context, req interface{}) (interface{}, error) {
@@ -18,9 +18,11 @@
 package features
 
 import (
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
 	"zntr.io/solid/internal/services"
 	"zntr.io/solid/pkg/server/reactor"
 )
 
-// Feature represents authorization server feature enabler.
-type Feature func(r reactor.Reactor, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client)
+// Feature represents authorization server feature enabler. It registers its
+// request handlers and advertises its capabilities in the server metadata.
+type Feature func(r reactor.Reactor, meta *discoveryv1.ServerMetadata, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client)
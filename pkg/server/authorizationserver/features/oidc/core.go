@@ -19,52 +19,104 @@ package oidc
 
 import (
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
+	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/internal/reactor/oidc/core"
 	"zntr.io/solid/internal/services"
+	"zntr.io/solid/pkg/sdk/jwe"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/types"
 	"zntr.io/solid/pkg/server/authorizationserver/features"
 	"zntr.io/solid/pkg/server/reactor"
 )
 
 // Core enable basic features.
 func Core() features.Feature {
-	return func(r reactor.Reactor, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
+	return func(r reactor.Reactor, meta *discoveryv1.ServerMetadata, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
 		// Register authorization request handler.
 		r.RegisterHandler(&corev1.AuthorizationCodeRequest{}, core.AuthorizeHandler(authorizations))
 		// REgister token request handler.
 		r.RegisterHandler(&corev1.TokenRequest{}, core.GetTokenHandler(tokens))
+
+		// Advertise capabilities
+		meta.AuthorizationEndpoint = endpoint(meta.Issuer, AuthorizationEndpoint)
+		meta.TokenEndpoint = endpoint(meta.Issuer, TokenEndpoint)
+		meta.ResponseTypesSupported = []string{oidc.ResponseTypeCode}
+		meta.ResponseModesSupported = []string{
+			oidc.ResponseModeQuery, oidc.ResponseModeFragment, oidc.ResponseModeFormPost,
+			oidc.ResponseModeQueryJWT, oidc.ResponseModeFragmentJWT, oidc.ResponseModeFormPostJWT, oidc.ResponseModeJWT,
+		}
+		meta.SubjectTypesSupported = []string{oidc.SubjectTypePublic}
+		meta.CodeChallengeMethodsSupported = []string{oidc.CodeChallengeMethodSha256}
+		meta.GrantTypesSupported = addAll(meta.GrantTypesSupported, oidc.GrantTypeAuthorizationCode, oidc.GrantTypeClientCredentials, oidc.GrantTypeRefreshToken)
+		meta.AuthorizationResponseIssParameterSupported = true
+		meta.RequestObjectSigningAlgValuesSupported = jwk.SupportedSignatureAlgorithms
 	}
 }
 
 // Introspection enable token introspection features.
 func Introspection() features.Feature {
-	return func(r reactor.Reactor, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
+	return func(r reactor.Reactor, meta *discoveryv1.ServerMetadata, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
 		// Register intropection request handler.
 		r.RegisterHandler(&corev1.TokenIntrospectionRequest{}, core.IntrospectionHandler(tokens))
+
+		// Advertise capabilities
+		meta.IntrospectionEndpoint = endpoint(meta.Issuer, IntrospectionEndpoint)
 	}
 }
 
 // Revocation enable token revocation features.
 func Revocation() features.Feature {
-	return func(r reactor.Reactor, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
+	return func(r reactor.Reactor, meta *discoveryv1.ServerMetadata, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
 		// Register revocation request handler.
 		r.RegisterHandler(&corev1.TokenRevocationRequest{}, core.RevocationHandler(tokens))
+
+		// Advertise capabilities
+		meta.RevocationEndpoint = endpoint(meta.Issuer, RevocationEndpoint)
 	}
 }
 
 // Device enable device grant flow features.
 func Device() features.Feature {
-	return func(r reactor.Reactor, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
+	return func(r reactor.Reactor, meta *discoveryv1.ServerMetadata, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
 		// Register device authorization request handler.
 		r.RegisterHandler(&corev1.DeviceAuthorizationRequest{}, core.DeviceAuthorizeHandler(devices))
 		// Register user code validation request handler.
 		r.RegisterHandler(&corev1.DeviceCodeValidationRequest{}, core.UserCodeValidationHandler(devices))
+
+		// Advertise capabilities
+		meta.DeviceAuthorizationEndpoint = endpoint(meta.Issuer, DeviceAuthorizationEndpoint)
+		meta.GrantTypesSupported = addAll(meta.GrantTypesSupported, oidc.GrantTypeDeviceCode)
 	}
 }
 
 // DCR enable dynamic client registration features.
 func DCR() features.Feature {
-	return func(r reactor.Reactor, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
+	return func(r reactor.Reactor, meta *discoveryv1.ServerMetadata, authorizations services.Authorization, tokens services.Token, devices services.Device, clients services.Client) {
 		// Register device authorization request handler.
 		r.RegisterHandler(&corev1.ClientRegistrationRequest{}, core.ClientRegistrationHandler(clients))
+		// Register initial access token request handler.
+		r.RegisterHandler(&corev1.InitialAccessTokenRequest{}, core.InitialAccessTokenHandler(clients))
+		// Register client configuration request handlers.
+		r.RegisterHandler(&corev1.ClientReadRequest{}, core.ClientReadHandler(clients))
+		r.RegisterHandler(&corev1.ClientUpdateRequest{}, core.ClientUpdateHandler(clients))
+		r.RegisterHandler(&corev1.ClientDeleteRequest{}, core.ClientDeleteHandler(clients))
+		// Register client approval request handler.
+		r.RegisterHandler(&corev1.ClientStatusRequest{}, core.ClientStatusHandler(clients))
+
+		// Advertise capabilities
+		meta.RegistrationEndpoint = endpoint(meta.Issuer, RegistrationEndpoint)
+		meta.AuthorizationEncryptionAlgValuesSupported = jwe.DefaultKeyAlgorithms
+		meta.AuthorizationEncryptionEncValuesSupported = jwe.DefaultContentEncryptions
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func addAll(values []string, items ...string) []string {
+	result := types.StringArray(values)
+	for _, item := range items {
+		result.AddIfNotContains(item)
 	}
+	return result
 }
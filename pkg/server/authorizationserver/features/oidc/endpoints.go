@@ -0,0 +1,44 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package oidc
+
+import "fmt"
+
+// Endpoint paths relative to the issuer, advertised in server metadata.
+const (
+	// AuthorizationEndpoint is the user-agent authorization endpoint path.
+	AuthorizationEndpoint = "/authorize"
+	// TokenEndpoint is the token endpoint path.
+	TokenEndpoint = "/token"
+	// IntrospectionEndpoint is the token introspection endpoint path.
+	IntrospectionEndpoint = "/token/introspect"
+	// RevocationEndpoint is the token revocation endpoint path.
+	RevocationEndpoint = "/token/revoke"
+	// PushedAuthorizationRequestEndpoint is the PAR endpoint path.
+	PushedAuthorizationRequestEndpoint = "/par"
+	// DeviceAuthorizationEndpoint is the device authorization endpoint path.
+	DeviceAuthorizationEndpoint = "/device_authorization"
+	// RegistrationEndpoint is the dynamic client registration endpoint path.
+	RegistrationEndpoint = "/register"
+	// JWKSEndpoint is the server public key set endpoint path.
+	JWKSEndpoint = "/.well-known/jwks.json"
+)
+
+func endpoint(issuer, path string) string {
+	return fmt.Sprintf("%s%s", issuer, path)
+}
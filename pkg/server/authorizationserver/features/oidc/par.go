@@ -19,6 +19,7 @@ package oidc
 
 import (
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
 	"zntr.io/solid/internal/reactor/oidc/par"
 	"zntr.io/solid/internal/services"
 	"zntr.io/solid/pkg/server/authorizationserver/features"
@@ -27,8 +28,11 @@ import (
 
 // PushedAuthorizationRequest enables pushed authorization requetst related features.
 func PushedAuthorizationRequest() features.Feature {
-	return func(r reactor.Reactor, authorizations services.Authorization, _ services.Token, _ services.Device, _ services.Client) {
+	return func(r reactor.Reactor, meta *discoveryv1.ServerMetadata, authorizations services.Authorization, _ services.Token, _ services.Device, _ services.Client) {
 		// Register authorization registration handler.
 		r.RegisterHandler(&corev1.RegistrationRequest{}, par.RegisterAuthorizationHandler(authorizations))
+
+		// Advertise capabilities
+		meta.PushedAuthorizationRequestEndpoint = endpoint(meta.Issuer, PushedAuthorizationRequestEndpoint)
 	}
 }
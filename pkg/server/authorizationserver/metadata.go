@@ -0,0 +1,200 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package authorizationserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/jwe"
+	"zntr.io/solid/pkg/sdk/jwt"
+	"zntr.io/solid/pkg/sdk/types"
+	featuresoidc "zntr.io/solid/pkg/server/authorizationserver/features/oidc"
+)
+
+var timeFunc = time.Now
+
+func (as *authorizationServer) Metadata(ctx context.Context) (*discoveryv1.ServerMetadata, error) {
+	// Copy metadata contributed by enabled features
+	as.RLock()
+	meta, ok := proto.Clone(as.meta).(*discoveryv1.ServerMetadata)
+	as.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unable to copy server metadata")
+	}
+
+	// Only advertise grant types allowed by the server profile
+	if !types.IsNil(as.dopts.serverProfile) {
+		allowed := types.StringArray{}
+		for _, name := range as.dopts.serverProfile.ApplicationTypes() {
+			if cp, ok := as.dopts.serverProfile.ApplicationType(name); ok {
+				for _, gt := range cp.GrantTypesSupported() {
+					allowed.AddIfNotContains(gt)
+				}
+			}
+		}
+
+		grantTypes := []string{}
+		for _, gt := range meta.GrantTypesSupported {
+			if allowed.Contains(gt) {
+				grantTypes = append(grantTypes, gt)
+			}
+		}
+		meta.GrantTypesSupported = grantTypes
+
+		// Pushed authorization requests
+		meta.RequirePushedAuthorizationRequests = as.dopts.serverProfile.PushedAuthorizationRequestsRequired()
+	}
+
+	// Client authentication methods
+	if !types.IsNil(as.dopts.clientAuthentication) {
+		methods := as.dopts.clientAuthentication.Methods()
+
+		var algs []string
+		if types.StringArray(methods).HasOneOf(oidc.AuthMethodPrivateKeyJWT, oidc.AuthMethodClientSecretJWT) {
+			algs = as.dopts.clientAuthentication.SupportedAlgorithms()
+		}
+
+		meta.TokenEndpointAuthMethodsSupported = methods
+		meta.TokenEndpointAuthSigningAlgValuesSupported = algs
+		if meta.IntrospectionEndpoint != "" {
+			meta.IntrospectionEndpointAuthMethodsSupported = methods
+			meta.IntrospectionEndpointAuthSigningAlgValuesSupported = algs
+		}
+		if meta.RevocationEndpoint != "" {
+			meta.RevocationEndpointAuthMethodsSupported = methods
+			meta.RevocationEndpointAuthSigningAlgValuesSupported = algs
+		}
+		if meta.PushedAuthorizationRequestEndpoint != "" {
+			meta.PushedAuthorizationRequestEndpointAuthMethodsSupported = methods
+		}
+	}
+
+	// Server keys
+	if as.dopts.keySetProvider != nil {
+		jwks, err := as.dopts.keySetProvider(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve server key set: %w", err)
+		}
+		if jwks == nil {
+			return nil, fmt.Errorf("key set provider returned a nil key set")
+		}
+
+		// Collect key algorithms
+		var sigAlgs, encAlgs types.StringArray
+		for _, k := range jwks.Keys {
+			if k.Algorithm == "" {
+				continue
+			}
+			if k.Use == "enc" {
+				encAlgs.AddIfNotContains(k.Algorithm)
+			} else {
+				sigAlgs.AddIfNotContains(k.Algorithm)
+			}
+		}
+
+		meta.JwksUri = fmt.Sprintf("%s%s", meta.Issuer, featuresoidc.JWKSEndpoint)
+		meta.IdTokenSigningAlgValuesSupported = sigAlgs
+		meta.AuthorizationSigningAlgValuesSupported = sigAlgs
+		if len(encAlgs) > 0 {
+			meta.RequestObjectEncryptionAlgValuesSupported = encAlgs
+			meta.RequestObjectEncryptionEncValuesSupported = jwe.DefaultContentEncryptions
+		}
+	}
+
+	// DPoP proofs
+	if !types.IsNil(as.dopts.dpopVerifier) {
+		meta.DpopSigningAlgValuesSupported = as.dopts.dpopVerifier.SupportedAlgorithms()
+	}
+
+	// Certificate-bound access tokens
+	meta.TlsClientCertificateBoundAccessTokens = as.dopts.tlsClientCertificateBound
+
+	// Only advertise signature algorithms allowed by the server profile
+	if !types.IsNil(as.dopts.serverProfile) {
+		allowed := as.dopts.serverProfile.SigningAlgorithmsSupported()
+		for _, algs := range []*[]string{
+			&meta.TokenEndpointAuthSigningAlgValuesSupported,
+			&meta.IntrospectionEndpointAuthSigningAlgValuesSupported,
+			&meta.RevocationEndpointAuthSigningAlgValuesSupported,
+			&meta.RequestObjectSigningAlgValuesSupported,
+			&meta.IdTokenSigningAlgValuesSupported,
+			&meta.AuthorizationSigningAlgValuesSupported,
+			&meta.DpopSigningAlgValuesSupported,
+		} {
+			*algs = filterAllowed(*algs, allowed)
+		}
+	}
+
+	// Signed metadata
+	if !types.IsNil(as.dopts.metadataSigner) {
+		signedMetadata, err := signMetadata(as.dopts.metadataSigner, meta)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign server metadata: %w", err)
+		}
+		meta.SignedMetadata = signedMetadata
+	}
+
+	// No error
+	return meta, nil
+}
+
+// -----------------------------------------------------------------------------
+
+// signMetadata produces a JWT containing metadata values as claims.
+// https://www.rfc-editor.org/rfc/rfc8414.html#section-2.1
+func signMetadata(signer jwt.Signer, meta *discoveryv1.ServerMetadata) (string, error) {
+	// Convert metadata as claims
+	payload, err := json.Marshal(meta)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode metadata: %w", err)
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("unable to decode metadata claims: %w", err)
+	}
+
+	// Add attesting party
+	claims["iss"] = meta.Issuer
+	claims["iat"] = timeFunc().Unix()
+
+	// Sign claims
+	return signer.Sign(claims)
+}
+
+// filterAllowed returns values contained in the allowed list.
+func filterAllowed(values []string, allowed types.StringArray) []string {
+	if len(values) == 0 {
+		return values
+	}
+
+	result := []string{}
+	for _, v := range values {
+		if allowed.Contains(v) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
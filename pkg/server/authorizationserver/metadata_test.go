@@ -0,0 +1,311 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package authorizationserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/square/go-jose/v3"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	discoveryv1 "zntr.io/solid/api/gen/go/oidc/discovery/v1"
+	"zntr.io/solid/api/oidc"
+	dpopmock "zntr.io/solid/pkg/sdk/dpop/mock"
+	"zntr.io/solid/pkg/sdk/jwe"
+	"zntr.io/solid/pkg/sdk/jwk"
+	jwtmock "zntr.io/solid/pkg/sdk/jwt/mock"
+	clientauthenticationmock "zntr.io/solid/pkg/server/clientauthentication/mock"
+)
+
+func Test_authorizationServer_Metadata(t *testing.T) {
+	// Freeze time
+	timeFunc = func() time.Time {
+		return time.Unix(1, 0)
+	}
+
+	defaultMetadata := func() *discoveryv1.ServerMetadata {
+		return &discoveryv1.ServerMetadata{
+			Issuer:                             "https://as.example.org",
+			AuthorizationEndpoint:              "https://as.example.org/authorize",
+			TokenEndpoint:                      "https://as.example.org/token",
+			IntrospectionEndpoint:              "https://as.example.org/token/introspect",
+			RevocationEndpoint:                 "https://as.example.org/token/revoke",
+			PushedAuthorizationRequestEndpoint: "https://as.example.org/par",
+			DeviceAuthorizationEndpoint:        "https://as.example.org/device_authorization",
+			RegistrationEndpoint:               "https://as.example.org/register",
+			ResponseTypesSupported:             []string{"code"},
+			ResponseModesSupported:             []string{"query", "fragment", "form_post", "query.jwt", "fragment.jwt", "form_post.jwt", "jwt"},
+			SubjectTypesSupported:              []string{"public"},
+			CodeChallengeMethodsSupported:      []string{"S256"},
+			GrantTypesSupported: []string{
+				oidc.GrantTypeAuthorizationCode, oidc.GrantTypeClientCredentials,
+				oidc.GrantTypeRefreshToken, oidc.GrantTypeDeviceCode,
+			},
+			AuthorizationResponseIssParameterSupported: true,
+			RequestObjectSigningAlgValuesSupported:     jwk.SupportedSignatureAlgorithms,
+			AuthorizationEncryptionAlgValuesSupported:  jwe.DefaultKeyAlgorithms,
+			AuthorizationEncryptionEncValuesSupported:  jwe.DefaultContentEncryptions,
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*clientauthenticationmock.MockDispatcher, *dpopmock.MockVerifier, *jwtmock.MockSigner) []Option
+		want    func() *discoveryv1.ServerMetadata
+		wantErr bool
+	}{
+		{
+			name: "key set provider error",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(_ *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, _ *jwtmock.MockSigner) []Option {
+				return []Option{
+					KeySetProvider(func(_ context.Context) (*jose.JSONWebKeySet, error) {
+						return nil, errors.New("test")
+					}),
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "key set provider returns nil",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(_ *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, _ *jwtmock.MockSigner) []Option {
+				return []Option{
+					KeySetProvider(func(_ context.Context) (*jose.JSONWebKeySet, error) {
+						return nil, nil
+					}),
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "metadata signer error",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(_ *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, signer *jwtmock.MockSigner) []Option {
+				signer.EXPECT().Sign(gomock.Any()).Return("", errors.New("test"))
+				return []Option{
+					MetadataSigner(signer),
+				}
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "features only",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    defaultMetadata,
+			wantErr: false,
+		},
+		{
+			name: "with client authentication",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(dispatcher *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, _ *jwtmock.MockSigner) []Option {
+				dispatcher.EXPECT().Methods().Return([]string{oidc.AuthMethodPrivateKeyJWT, oidc.AuthMethodNone})
+				dispatcher.EXPECT().SupportedAlgorithms().Return([]string{"ES256", "PS256"})
+				return []Option{
+					ClientAuthentication(dispatcher),
+				}
+			},
+			want: func() *discoveryv1.ServerMetadata {
+				meta := defaultMetadata()
+				meta.TokenEndpointAuthMethodsSupported = []string{"private_key_jwt", "none"}
+				meta.TokenEndpointAuthSigningAlgValuesSupported = []string{"ES256", "PS256"}
+				meta.IntrospectionEndpointAuthMethodsSupported = []string{"private_key_jwt", "none"}
+				meta.IntrospectionEndpointAuthSigningAlgValuesSupported = []string{"ES256", "PS256"}
+				meta.RevocationEndpointAuthMethodsSupported = []string{"private_key_jwt", "none"}
+				meta.RevocationEndpointAuthSigningAlgValuesSupported = []string{"ES256", "PS256"}
+				meta.PushedAuthorizationRequestEndpointAuthMethodsSupported = []string{"private_key_jwt", "none"}
+				return meta
+			},
+			wantErr: false,
+		},
+		{
+			name: "with client authentication without assertion",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(dispatcher *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, _ *jwtmock.MockSigner) []Option {
+				dispatcher.EXPECT().Methods().Return([]string{oidc.AuthMethodNone})
+				return []Option{
+					ClientAuthentication(dispatcher),
+				}
+			},
+			want: func() *discoveryv1.ServerMetadata {
+				meta := defaultMetadata()
+				meta.TokenEndpointAuthMethodsSupported = []string{"none"}
+				meta.IntrospectionEndpointAuthMethodsSupported = []string{"none"}
+				meta.RevocationEndpointAuthMethodsSupported = []string{"none"}
+				meta.PushedAuthorizationRequestEndpointAuthMethodsSupported = []string{"none"}
+				return meta
+			},
+			wantErr: false,
+		},
+		{
+			name: "with server keys",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(_ *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, _ *jwtmock.MockSigner) []Option {
+				return []Option{
+					KeySetProvider(jwk.KeySetProviderFunc(func(_ context.Context) (*jose.JSONWebKeySet, error) {
+						return &jose.JSONWebKeySet{
+							Keys: []jose.JSONWebKey{
+								{KeyID: "1", Use: "sig", Algorithm: "ES384"},
+								{KeyID: "2", Algorithm: "PS256"},
+								{KeyID: "3", Use: "enc", Algorithm: "ECDH-ES+A256KW"},
+								{KeyID: "4"},
+							},
+						}, nil
+					})),
+				}
+			},
+			want: func() *discoveryv1.ServerMetadata {
+				meta := defaultMetadata()
+				meta.JwksUri = "https://as.example.org/.well-known/jwks.json"
+				meta.IdTokenSigningAlgValuesSupported = []string{"ES384", "PS256"}
+				meta.AuthorizationSigningAlgValuesSupported = []string{"ES384", "PS256"}
+				meta.RequestObjectEncryptionAlgValuesSupported = []string{"ECDH-ES+A256KW"}
+				meta.RequestObjectEncryptionEncValuesSupported = jwe.DefaultContentEncryptions
+				return meta
+			},
+			wantErr: false,
+		},
+		{
+			name: "with dpop verifier",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(_ *clientauthenticationmock.MockDispatcher, verifier *dpopmock.MockVerifier, _ *jwtmock.MockSigner) []Option {
+				verifier.EXPECT().SupportedAlgorithms().Return([]string{"ES256"})
+				return []Option{
+					DPoPVerifier(verifier),
+				}
+			},
+			want: func() *discoveryv1.ServerMetadata {
+				meta := defaultMetadata()
+				meta.DpopSigningAlgValuesSupported = []string{"ES256"}
+				return meta
+			},
+			wantErr: false,
+		},
+		{
+			name: "with certificate-bound access tokens",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(_ *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, _ *jwtmock.MockSigner) []Option {
+				return []Option{
+					TLSClientCertificateBoundAccessTokens(),
+				}
+			},
+			want: func() *discoveryv1.ServerMetadata {
+				meta := defaultMetadata()
+				meta.TlsClientCertificateBoundAccessTokens = true
+				return meta
+			},
+			wantErr: false,
+		},
+		{
+			name: "with metadata signer",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(_ *clientauthenticationmock.MockDispatcher, _ *dpopmock.MockVerifier, signer *jwtmock.MockSigner) []Option {
+				signer.EXPECT().Sign(gomock.Any()).DoAndReturn(func(claims interface{}) (string, error) {
+					values, ok := claims.(map[string]interface{})
+					if !ok {
+						return "", errors.New("invalid claims type")
+					}
+					if values["iss"] != "https://as.example.org" || values["issuer"] != "https://as.example.org" {
+						return "", errors.New("invalid issuer claims")
+					}
+					if values["iat"] != int64(1) {
+						return "", errors.New("invalid iat claim")
+					}
+					if values["token_endpoint"] != "https://as.example.org/token" {
+						return "", errors.New("invalid metadata claims")
+					}
+					return "eyJhbGciOiJFUzM4NCJ9.eyJpc3MiOiJodHRwczovL2FzLmV4YW1wbGUub3JnIn0.c2ln", nil
+				})
+				return []Option{
+					MetadataSigner(signer),
+				}
+			},
+			want: func() *discoveryv1.ServerMetadata {
+				meta := defaultMetadata()
+				meta.SignedMetadata = "eyJhbGciOiJFUzM4NCJ9.eyJpc3MiOiJodHRwczovL2FzLmV4YW1wbGUub3JnIn0.c2ln"
+				return meta
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			dispatcher := clientauthenticationmock.NewMockDispatcher(ctrl)
+			verifier := dpopmock.NewMockVerifier(ctrl)
+			signer := jwtmock.NewMockSigner(ctrl)
+
+			// Prepare options
+			var opts []Option
+			if tt.prepare != nil {
+				opts = tt.prepare(dispatcher, verifier, signer)
+			}
+
+			// Prepare authorization server
+			as, err := New(tt.args.ctx, "https://as.example.org", opts...)
+			if err != nil {
+				t.Fatalf("unable to initialize authorization server: %v", err)
+			}
+
+			got, err := as.Metadata(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authorizationServer.Metadata() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want(), protocmp.Transform()); diff != "" {
+				t.Errorf("authorizationServer.Metadata() res = %s", diff)
+			}
+		})
+	}
+}
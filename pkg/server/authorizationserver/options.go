@@ -18,7 +18,13 @@
 package authorizationserver
 
 import (
+	"zntr.io/solid/pkg/sdk/dpop"
 	"zntr.io/solid/pkg/sdk/generator"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/jwt"
+	"zntr.io/solid/pkg/server/clientauthentication"
+	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/softwarestatement"
 	"zntr.io/solid/pkg/server/storage"
 )
 
@@ -29,11 +35,22 @@ type options struct {
 	refreshTokenGenerator           generator.Token
 	idTokenGenerator                generator.Identity
 	clientReader                    storage.ClientReader
-	clientWriter                    storage.ClientWriter
+	clientManager                   storage.Client
 	authorizationRequestManager     storage.AuthorizationRequest
 	authorizationCodeSessionManager storage.AuthorizationCodeSession
 	deviceCodeSessionManager        storage.DeviceCodeSession
 	tokenManager                    storage.Token
+	serverProfile                   profile.Server
+	clientAuthentication            clientauthentication.Dispatcher
+	keySetProvider                  jwk.KeySetProviderFunc
+	dpopVerifier                    dpop.Verifier
+	tlsClientCertificateBound       bool
+	metadataSigner                  jwt.Signer
+	softwareStatementVerifier       softwarestatement.Verifier
+	initialAccessTokenManager       storage.InitialAccessToken
+	initialAccessTokenGenerator     generator.InitialAccessToken
+	openRegistration                bool
+	clientAutoApproval              bool
 }
 
 // Option defines functional pattern function type contract.
@@ -91,7 +108,95 @@ func RefreshTokenGenerator(g generator.Token) Option {
 // ClientManager defines the client manager instance to use.
 func ClientManager(store storage.Client) Option {
 	return func(opts *options) {
-		opts.clientWriter = store
+		opts.clientManager = store
 		opts.clientReader = store
 	}
 }
+
+// ServerProfile defines the server profile used to validate client registrations.
+func ServerProfile(p profile.Server) Option {
+	return func(opts *options) {
+		opts.serverProfile = p
+	}
+}
+
+// ClientAuthentication defines the client authentication dispatcher used to
+// advertise supported client authentication methods.
+func ClientAuthentication(dispatcher clientauthentication.Dispatcher) Option {
+	return func(opts *options) {
+		opts.clientAuthentication = dispatcher
+	}
+}
+
+// KeySetProvider defines the server public key set provider used to advertise
+// supported signature and encryption algorithms.
+func KeySetProvider(provider jwk.KeySetProviderFunc) Option {
+	return func(opts *options) {
+		opts.keySetProvider = provider
+	}
+}
+
+// DPoPVerifier defines the DPoP proof verifier used to advertise supported
+// proof signature algorithms.
+func DPoPVerifier(verifier dpop.Verifier) Option {
+	return func(opts *options) {
+		opts.dpopVerifier = verifier
+	}
+}
+
+// TLSClientCertificateBoundAccessTokens advertises certificate-bound access
+// tokens support. The integration must terminate mutual TLS and present the
+// client certificate thumbprint with token requests.
+func TLSClientCertificateBoundAccessTokens() Option {
+	return func(opts *options) {
+		opts.tlsClientCertificateBound = true
+	}
+}
+
+// MetadataSigner enables signed metadata publication using the given signer.
+// It should use the server signing key.
+func MetadataSigner(signer jwt.Signer) Option {
+	return func(opts *options) {
+		opts.metadataSigner = signer
+	}
+}
+
+// SoftwareStatementVerifier enables software statement processing during
+// dynamic client registration. Statement claims override the client supplied
+// metadata.
+func SoftwareStatementVerifier(verifier softwarestatement.Verifier) Option {
+	return func(opts *options) {
+		opts.softwareStatementVerifier = verifier
+	}
+}
+
+// InitialAccessTokenManager defines the storage of initial access tokens
+// authorizing dynamic client registrations.
+func InitialAccessTokenManager(store storage.InitialAccessToken) Option {
+	return func(opts *options) {
+		opts.initialAccessTokenManager = store
+	}
+}
+
+// InitialAccessTokenGenerator defines the initial access token value generator.
+func InitialAccessTokenGenerator(g generator.InitialAccessToken) Option {
+	return func(opts *options) {
+		opts.initialAccessTokenGenerator = g
+	}
+}
+
+// OpenRegistration allows dynamic client registration without initial access
+// token.
+func OpenRegistration() Option {
+	return func(opts *options) {
+		opts.openRegistration = true
+	}
+}
+
+// ClientAutoApproval activates dynamically registered clients without
+// administrator approval.
+func ClientAutoApproval() Option {
+	return func(opts *options) {
+		opts.clientAutoApproval = true
+	}
+}
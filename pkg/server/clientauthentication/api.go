@@ -29,3 +29,43 @@ import (
 type AuthenticationProcessor interface {
 	Authenticate(ctx context.Context, req *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error)
 }
+
+// AlgorithmProvider is implemented by authentication processors relying on
+// signed client assertions.
+type AlgorithmProvider interface {
+	// SupportedAlgorithms returns the accepted assertion signature algorithms.
+	SupportedAlgorithms() []string
+}
+
+// CredentialsMatcher is implemented by authentication processors registered
+// for a custom method. Built-in methods are matched by the dispatcher from the
+// presented credentials, custom methods without matcher are fully delegated
+// to their processor.
+type CredentialsMatcher interface {
+	// Accepts returns true when the request carries credentials handled by
+	// the processor.
+	Accepts(req *corev1.ClientAuthenticationRequest) bool
+}
+
+// AuthenticationProcessorFunc adapts a function to an AuthenticationProcessor.
+type AuthenticationProcessorFunc func(ctx context.Context, req *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error)
+
+// Authenticate calls f(ctx, req).
+func (f AuthenticationProcessorFunc) Authenticate(ctx context.Context, req *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error) {
+	return f(ctx, req)
+}
+
+//go:generate mockgen -destination mock/dispatcher.gen.go -package mock zntr.io/solid/pkg/server/clientauthentication Dispatcher
+
+// Dispatcher describes client authentication dispatcher contract. It selects
+// the authentication processor according to the client token_endpoint_auth_method.
+type Dispatcher interface {
+	AuthenticationProcessor
+	// Register an authentication processor for the given method.
+	Register(method string, processor AuthenticationProcessor)
+	// Methods returns supported authentication methods.
+	Methods() []string
+	// SupportedAlgorithms returns assertion signature algorithms accepted by
+	// registered authentication processors.
+	SupportedAlgorithms() []string
+}
@@ -0,0 +1,245 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientauthentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/square/go-jose/v3"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+// builtinMethods lists authentication methods detected by the dispatcher from
+// the presented credentials.
+var builtinMethods = types.StringArray{
+	oidc.AuthMethodPrivateKeyJWT,
+	oidc.AuthMethodClientSecretJWT,
+	oidc.AuthMethodClientSecretBasic,
+	oidc.AuthMethodClientSecretPost,
+	oidc.AuthMethodNone,
+}
+
+// DefaultDispatcher returns a client authentication dispatcher without any
+// registered authentication processor.
+//
+// The client authentication method is validated against the client
+// application type settings declared by the given server profile.
+func DefaultDispatcher(clients storage.ClientReader, serverProfile profile.Server) Dispatcher {
+	return &dispatcher{
+		clients:       clients,
+		serverProfile: serverProfile,
+		processors:    map[string]AuthenticationProcessor{},
+		methods:       types.StringArray{},
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type dispatcher struct {
+	sync.RWMutex
+	clients       storage.ClientReader
+	serverProfile profile.Server
+	processors    map[string]AuthenticationProcessor
+	methods       types.StringArray
+}
+
+func (d *dispatcher) Register(method string, processor AuthenticationProcessor) {
+	d.Lock()
+	defer d.Unlock()
+
+	// Assign processor
+	d.processors[method] = processor
+	d.methods.AddIfNotContains(method)
+}
+
+func (d *dispatcher) Methods() []string {
+	d.RLock()
+	defer d.RUnlock()
+
+	// Return a copy
+	methods := make([]string, len(d.methods))
+	copy(methods, d.methods)
+
+	return methods
+}
+
+func (d *dispatcher) SupportedAlgorithms() []string {
+	d.RLock()
+	defer d.RUnlock()
+
+	// Collect algorithms from assertion based processors
+	algs := types.StringArray{}
+	for _, method := range d.methods {
+		if ap, ok := d.processors[method].(AlgorithmProvider); ok {
+			for _, alg := range ap.SupportedAlgorithms() {
+				algs.AddIfNotContains(alg)
+			}
+		}
+	}
+
+	return algs
+}
+
+//nolint:funlen,gocyclo // to refactor
+func (d *dispatcher) Authenticate(ctx context.Context, req *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error) {
+	res := &corev1.ClientAuthenticationResponse{}
+
+	// Validate request
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Detect presented authentication methods
+	var (
+		hasSecret    = req.ClientSecret.GetValue() != ""
+		hasAssertion = req.ClientAssertion.GetValue() != "" || req.ClientAssertionType.GetValue() != ""
+	)
+
+	// Client must use only one authentication method
+	// https://tools.ietf.org/html/rfc6749#section-2.3
+	if hasSecret && hasAssertion {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("client must not use more than one authentication method")
+	}
+
+	// Resolve client identifier
+	clientID := req.ClientId.GetValue()
+
+	// Identify presented method
+	var presented types.StringArray
+	switch {
+	case hasAssertion:
+		presented = types.StringArray{oidc.AuthMethodPrivateKeyJWT, oidc.AuthMethodClientSecretJWT}
+
+		// Extract assertion issuer
+		issuer, err := assertionIssuer(req)
+		if err != nil {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("unable to extract client identifier from assertion: %w", err)
+		}
+		if clientID != "" && clientID != issuer {
+			res.Error = rfcerrors.InvalidRequest().Build()
+			return res, fmt.Errorf("client_id and assertion issuer mismatch")
+		}
+		clientID = issuer
+	case hasSecret && req.BasicAuthentication:
+		presented = types.StringArray{oidc.AuthMethodClientSecretBasic}
+	case hasSecret:
+		presented = types.StringArray{oidc.AuthMethodClientSecretPost}
+	default:
+		presented = types.StringArray{oidc.AuthMethodNone}
+	}
+
+	// Check client identifier
+	if clientID == "" {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("unable to identify client")
+	}
+
+	// Retrieve client
+	client, err := d.clients.Get(ctx, clientID)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("error during client retrieval: %w", err)
+		}
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client not found")
+	}
+	if client == nil {
+		res.Error = rfcerrors.ServerError().Build()
+		return res, fmt.Errorf("client storage returned nil client")
+	}
+	if storage.ClientStatus(client) != corev1.ClientStatus_CLIENT_STATUS_ACTIVE {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client '%s' is not active", client.ClientId)
+	}
+
+	// Default client authentication method
+	// https://tools.ietf.org/html/rfc7591#section-2
+	method := client.TokenEndpointAuthMethod
+	if method == "" {
+		method = oidc.AuthMethodClientSecretBasic
+	}
+
+	// Retrieve processor
+	d.RLock()
+	processor, ok := d.processors[method]
+	d.RUnlock()
+	if !ok || types.IsNil(processor) {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("authentication method '%s' is not supported", method)
+	}
+
+	// Check presented credentials against client registration
+	if builtinMethods.Contains(method) {
+		if !presented.Contains(method) {
+			res.Error = rfcerrors.InvalidClient().Build()
+			return res, fmt.Errorf("client is registered with '%s' authentication method", method)
+		}
+	} else if matcher, ok := processor.(CredentialsMatcher); ok && !matcher.Accepts(req) {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client is registered with '%s' authentication method", method)
+	}
+
+	// Check method against client application type profile
+	if err := profile.CheckAuthMethod(d.serverProfile, client, method); err != nil {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
+
+	// Delegate to processor
+	return processor.Authenticate(ctx, req)
+}
+
+// -----------------------------------------------------------------------------
+
+func assertionIssuer(req *corev1.ClientAuthenticationRequest) (string, error) {
+	// Check assertion
+	if req.ClientAssertion.GetValue() == "" {
+		return "", fmt.Errorf("client_assertion must not be empty")
+	}
+
+	// Decode assertion without validation
+	rawAssertion, err := jose.ParseSigned(req.ClientAssertion.Value)
+	if err != nil {
+		return "", fmt.Errorf("assertion is syntaxically invalid: %w", err)
+	}
+
+	// Retrieve payload claims
+	var claims privateJWTClaims
+	if err := json.Unmarshal(rawAssertion.UnsafePayloadWithoutVerification(), &claims); err != nil {
+		return "", fmt.Errorf("unable to decode payload claims: %w", err)
+	}
+	if claims.Issuer == "" {
+		return "", fmt.Errorf("assertion issuer must not be blank")
+	}
+
+	// No error
+	return claims.Issuer, nil
+}
@@ -0,0 +1,460 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientauthentication
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/square/go-jose/v3/jwt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
+	profilemock "zntr.io/solid/pkg/server/profile/mock"
+	"zntr.io/solid/pkg/server/storage"
+	storagemock "zntr.io/solid/pkg/server/storage/mock"
+)
+
+func Test_dispatcher_Methods(t *testing.T) {
+	underTest := DefaultDispatcher(nil, nil)
+	underTest.Register(oidc.AuthMethodPrivateKeyJWT, nil)
+	underTest.Register(oidc.AuthMethodNone, nil)
+	underTest.Register(oidc.AuthMethodPrivateKeyJWT, nil)
+
+	want := []string{oidc.AuthMethodPrivateKeyJWT, oidc.AuthMethodNone}
+	if got := underTest.Methods(); !reflect.DeepEqual(got, want) {
+		t.Errorf("dispatcher.Methods() = %v, want %v", got, want)
+	}
+}
+
+func Test_dispatcher_SupportedAlgorithms(t *testing.T) {
+	underTest := DefaultDispatcher(nil, nil)
+	underTest.Register(oidc.AuthMethodNone, None(nil, nil))
+	underTest.Register(oidc.AuthMethodPrivateKeyJWT, PrivateKeyJWT(nil, nil, nil, nil, SupportedAlgorithms("ES256", "EdDSA")))
+
+	want := []string{"ES256", "EdDSA"}
+	if got := underTest.SupportedAlgorithms(); !reflect.DeepEqual(got, want) {
+		t.Errorf("dispatcher.SupportedAlgorithms() = %v, want %v", got, want)
+	}
+}
+
+type customProcessor struct {
+	AuthenticationProcessorFunc
+}
+
+func (customProcessor) Accepts(req *corev1.ClientAuthenticationRequest) bool {
+	return req.ClientSecret.GetValue() != "" && !req.BasicAuthentication
+}
+
+func Test_dispatcher_Authenticate(t *testing.T) {
+	assertion := generateAssertion(t, &privateJWTClaims{
+		JTI:      "123456789",
+		Subject:  "38174623762",
+		Issuer:   "38174623762",
+		Audience: jwt.Audience{"http://localhost:8080/token"},
+		Expires:  uint64(time.Now().Add(2 * time.Minute).Unix()),
+		IssuedAt: uint64(time.Now().Unix()),
+	})
+
+	type args struct {
+		ctx context.Context
+		req *corev1.ClientAuthenticationRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockClientReader, *profilemock.MockServer, *profilemock.MockClient)
+		want    *corev1.ClientAuthenticationResponse
+		wantErr bool
+	}{
+		{
+			name:    "nil request",
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "multiple authentication methods",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientSecret:        &wrappers.StringValue{Value: "foo"},
+					ClientAssertionType: &wrappers.StringValue{Value: oidc.AssertionTypeJWTBearer},
+					ClientAssertion:     &wrappers.StringValue{Value: assertion},
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "invalid assertion",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientAssertionType: &wrappers.StringValue{Value: oidc.AssertionTypeJWTBearer},
+					ClientAssertion:     &wrappers.StringValue{Value: "foo"},
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "client_id and assertion mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId:            &wrappers.StringValue{Value: "45678941561"},
+					ClientAssertionType: &wrappers.StringValue{Value: oidc.AssertionTypeJWTBearer},
+					ClientAssertion:     &wrappers.StringValue{Value: assertion},
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "missing client identifier",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{},
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "client not found",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "client storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "client suspended",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_SUSPENDED,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "method mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                "s6BhdRkqt3",
+					TokenEndpointAuthMethod: oidc.AuthMethodPrivateKeyJWT,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "default method mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId:     &wrappers.StringValue{Value: "s6BhdRkqt3"},
+					ClientSecret: &wrappers.StringValue{Value: "foo"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "unsupported method",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId:            &wrappers.StringValue{Value: "s6BhdRkqt3"},
+					ClientSecret:        &wrappers.StringValue{Value: "foo"},
+					BasicAuthentication: true,
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId: "s6BhdRkqt3",
+					Status:   corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "method not allowed by server profile",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, clientProfile *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                "s6BhdRkqt3",
+					ApplicationType:         oidc.ApplicationTypeServerSideWeb,
+					TokenEndpointAuthMethod: oidc.AuthMethodNone,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeServerSideWeb).Return(clientProfile, true)
+				clientProfile.EXPECT().TokenEndpointAuthMethodsSupported().Return(types.StringArray{oidc.AuthMethodPrivateKeyJWT})
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "custom method credentials mismatch",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                "s6BhdRkqt3",
+					ApplicationType:         oidc.ApplicationTypeServerSideWeb,
+					TokenEndpointAuthMethod: "custom_secret",
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid: none",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, clientProfile *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                "s6BhdRkqt3",
+					ApplicationType:         oidc.ApplicationTypeNative,
+					TokenEndpointAuthMethod: oidc.AuthMethodNone,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeNative).Return(clientProfile, true)
+				clientProfile.EXPECT().TokenEndpointAuthMethodsSupported().Return(types.StringArray{oidc.AuthMethodNone})
+			},
+			wantErr: false,
+			want: &corev1.ClientAuthenticationResponse{
+				Client: &corev1.Client{
+					ClientId: oidc.AuthMethodNone,
+				},
+			},
+		},
+		{
+			name: "valid: legacy client without status",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, clientProfile *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                "s6BhdRkqt3",
+					ApplicationType:         oidc.ApplicationTypeNative,
+					TokenEndpointAuthMethod: oidc.AuthMethodNone,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeNative).Return(clientProfile, true)
+				clientProfile.EXPECT().TokenEndpointAuthMethodsSupported().Return(types.StringArray{oidc.AuthMethodNone})
+			},
+			wantErr: false,
+			want: &corev1.ClientAuthenticationResponse{
+				Client: &corev1.Client{
+					ClientId: oidc.AuthMethodNone,
+				},
+			},
+		},
+		{
+			name: "valid: private_key_jwt",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientAssertionType: &wrappers.StringValue{Value: oidc.AssertionTypeJWTBearer},
+					ClientAssertion:     &wrappers.StringValue{Value: assertion},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, clientProfile *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "38174623762").Return(&corev1.Client{
+					ClientId:                "38174623762",
+					ApplicationType:         oidc.ApplicationTypeServerSideWeb,
+					TokenEndpointAuthMethod: oidc.AuthMethodPrivateKeyJWT,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeServerSideWeb).Return(clientProfile, true)
+				clientProfile.EXPECT().TokenEndpointAuthMethodsSupported().Return(types.StringArray{oidc.AuthMethodPrivateKeyJWT})
+			},
+			wantErr: false,
+			want: &corev1.ClientAuthenticationResponse{
+				Client: &corev1.Client{
+					ClientId: oidc.AuthMethodPrivateKeyJWT,
+				},
+			},
+		},
+		{
+			name: "valid: custom method",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId:     &wrappers.StringValue{Value: "s6BhdRkqt3"},
+					ClientSecret: &wrappers.StringValue{Value: "foo"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, clientProfile *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ClientId:                "s6BhdRkqt3",
+					ApplicationType:         oidc.ApplicationTypeServerSideWeb,
+					TokenEndpointAuthMethod: "custom_secret",
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeServerSideWeb).Return(clientProfile, true)
+				clientProfile.EXPECT().TokenEndpointAuthMethodsSupported().Return(types.StringArray{"custom_secret"})
+			},
+			wantErr: false,
+			want: &corev1.ClientAuthenticationResponse{
+				Client: &corev1.Client{
+					ClientId: "custom_secret",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			clients := storagemock.NewMockClientReader(ctrl)
+			serverProfile := profilemock.NewMockServer(ctrl)
+			clientProfile := profilemock.NewMockClient(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(clients, serverProfile, clientProfile)
+			}
+
+			// Prepare dispatcher with processors returning their method
+			underTest := DefaultDispatcher(clients, serverProfile)
+			for _, method := range []string{oidc.AuthMethodNone, oidc.AuthMethodPrivateKeyJWT} {
+				m := method
+				underTest.Register(m, AuthenticationProcessorFunc(func(_ context.Context, _ *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error) {
+					return &corev1.ClientAuthenticationResponse{
+						Client: &corev1.Client{
+							ClientId: m,
+						},
+					}, nil
+				}))
+			}
+			underTest.Register("custom_secret", customProcessor{
+				AuthenticationProcessorFunc: func(_ context.Context, _ *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error) {
+					return &corev1.ClientAuthenticationResponse{
+						Client: &corev1.Client{
+							ClientId: "custom_secret",
+						},
+					}, nil
+				},
+			})
+
+			got, err := underTest.Authenticate(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("dispatcher.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dispatcher.Authenticate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientauthentication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/server/profile"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+// None authentication method used by public clients.
+func None(clients storage.ClientReader, serverProfile profile.Server) AuthenticationProcessor {
+	return &noneAuthentication{
+		clients:       clients,
+		serverProfile: serverProfile,
+	}
+}
+
+type noneAuthentication struct {
+	clients       storage.ClientReader
+	serverProfile profile.Server
+}
+
+func (p *noneAuthentication) Authenticate(ctx context.Context, req *corev1.ClientAuthenticationRequest) (*corev1.ClientAuthenticationResponse, error) {
+	res := &corev1.ClientAuthenticationResponse{}
+
+	// Validate request
+	if req == nil {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("unable to process nil request")
+	}
+
+	// Validate required fields for this authentication method
+	if req.ClientId == nil || req.ClientId.Value == "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("client_id must be defined")
+	}
+	if req.ClientSecret.GetValue() != "" || req.ClientAssertion.GetValue() != "" || req.ClientAssertionType.GetValue() != "" {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("public client must not use credentials")
+	}
+
+	// Check client in storage
+	client, err := p.clients.Get(ctx, req.ClientId.Value)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			res.Error = rfcerrors.ServerError().Build()
+			return res, fmt.Errorf("error during client retrieval: %w", err)
+		}
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client not found")
+	}
+	if storage.ClientStatus(client) != corev1.ClientStatus_CLIENT_STATUS_ACTIVE {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client '%s' is not active", client.ClientId)
+	}
+
+	// Check client authentication method
+	if client.TokenEndpointAuthMethod != oidc.AuthMethodNone {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client must authenticate using '%s'", client.TokenEndpointAuthMethod)
+	}
+	if err := profile.CheckAuthMethod(p.serverProfile, client, oidc.AuthMethodNone); err != nil {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
+
+	// Assign client to result
+	res.Client = client
+
+	// No error
+	return res, nil
+}
@@ -0,0 +1,232 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientauthentication
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
+	profilemock "zntr.io/solid/pkg/server/profile/mock"
+	"zntr.io/solid/pkg/server/storage"
+	storagemock "zntr.io/solid/pkg/server/storage/mock"
+)
+
+func Test_noneAuthentication_Authenticate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *corev1.ClientAuthenticationRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockClientReader, *profilemock.MockServer, *profilemock.MockClient)
+		want    *corev1.ClientAuthenticationResponse
+		wantErr bool
+	}{
+		{
+			name:    "nil request",
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "empty client_id",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{},
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "client with secret",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId:     &wrappers.StringValue{Value: "s6BhdRkqt3"},
+					ClientSecret: &wrappers.StringValue{Value: "foo"},
+				},
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidRequest().Build(),
+			},
+		},
+		{
+			name: "client not found",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, storage.ErrNotFound)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "client storage error",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.ServerError().Build(),
+			},
+		},
+		{
+			name: "confidential client",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, _ *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					TokenEndpointAuthMethod: oidc.AuthMethodPrivateKeyJWT,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "application_type not supported by server profile",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, _ *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType:         oidc.ApplicationTypeClientSideWeb,
+					TokenEndpointAuthMethod: oidc.AuthMethodNone,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeClientSideWeb).Return(nil, false)
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		{
+			name: "authentication method not allowed by server profile",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, clientProfile *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType:         oidc.ApplicationTypeNative,
+					TokenEndpointAuthMethod: oidc.AuthMethodNone,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeNative).Return(clientProfile, true)
+				clientProfile.EXPECT().TokenEndpointAuthMethodsSupported().Return(types.StringArray{oidc.AuthMethodPrivateKeyJWT})
+			},
+			wantErr: true,
+			want: &corev1.ClientAuthenticationResponse{
+				Error: rfcerrors.InvalidClient().Build(),
+			},
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+				req: &corev1.ClientAuthenticationRequest{
+					ClientId: &wrappers.StringValue{Value: "s6BhdRkqt3"},
+				},
+			},
+			prepare: func(clients *storagemock.MockClientReader, serverProfile *profilemock.MockServer, clientProfile *profilemock.MockClient) {
+				clients.EXPECT().Get(gomock.Any(), "s6BhdRkqt3").Return(&corev1.Client{
+					ApplicationType:         oidc.ApplicationTypeNative,
+					TokenEndpointAuthMethod: oidc.AuthMethodNone,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				}, nil)
+				serverProfile.EXPECT().ApplicationType(oidc.ApplicationTypeNative).Return(clientProfile, true)
+				clientProfile.EXPECT().TokenEndpointAuthMethodsSupported().Return(types.StringArray{oidc.AuthMethodNone})
+			},
+			wantErr: false,
+			want: &corev1.ClientAuthenticationResponse{
+				Client: &corev1.Client{
+					ApplicationType:         oidc.ApplicationTypeNative,
+					TokenEndpointAuthMethod: oidc.AuthMethodNone,
+					Status:                  corev1.ClientStatus_CLIENT_STATUS_ACTIVE,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			clients := storagemock.NewMockClientReader(ctrl)
+			serverProfile := profilemock.NewMockServer(ctrl)
+			clientProfile := profilemock.NewMockClient(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(clients, serverProfile, clientProfile)
+			}
+
+			// Prepare service
+			underTest := None(clients, serverProfile)
+
+			got, err := underTest.Authenticate(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("noneAuthentication.Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("noneAuthentication.Authenticate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientauthentication
+
+import (
+	"time"
+
+	"github.com/square/go-jose/v3"
+
+	"zntr.io/solid/pkg/server/clientkeys"
+)
+
+const (
+	// DefaultClockSkew defines default tolerated clock skew for assertion time claims.
+	DefaultClockSkew = 15 * time.Second
+	// DefaultMaxAssertionLifetime defines default maximum accepted assertion
+	// lifetime, it also bounds the replay cache retention.
+	DefaultMaxAssertionLifetime = 5 * time.Minute
+)
+
+// DefaultSupportedAlgorithms defines default assertion signature algorithms allowlist.
+var DefaultSupportedAlgorithms = []string{
+	string(jose.ES256), string(jose.ES384), string(jose.PS256), string(jose.EdDSA),
+}
+
+// Private key jwt authentication options holder
+type privateKeyJWTOptions struct {
+	supportedAlgorithms []string
+	clockSkew           time.Duration
+	maxLifetime         time.Duration
+	keyResolver         clientkeys.Resolver
+}
+
+// PrivateKeyJWTOption defines functional pattern function type contract.
+type PrivateKeyJWTOption func(*privateKeyJWTOptions)
+
+// SupportedAlgorithms defines the allowed assertion signature algorithms.
+func SupportedAlgorithms(algs ...string) PrivateKeyJWTOption {
+	return func(opts *privateKeyJWTOptions) {
+		opts.supportedAlgorithms = algs
+	}
+}
+
+// ClockSkew defines the tolerated clock skew used to validate exp, iat and nbf claims.
+func ClockSkew(d time.Duration) PrivateKeyJWTOption {
+	return func(opts *privateKeyJWTOptions) {
+		opts.clockSkew = d
+	}
+}
+
+// MaxAssertionLifetime defines the maximum accepted delay between now and the
+// assertion expiration.
+func MaxAssertionLifetime(d time.Duration) PrivateKeyJWTOption {
+	return func(opts *privateKeyJWTOptions) {
+		opts.maxLifetime = d
+	}
+}
+
+// KeyResolver defines the client public keys resolver.
+func KeyResolver(resolver clientkeys.Resolver) PrivateKeyJWTOption {
+	return func(opts *privateKeyJWTOptions) {
+		opts.keyResolver = resolver
+	}
+}
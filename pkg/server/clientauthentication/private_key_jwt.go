@@ -19,37 +19,101 @@ package clientauthentication
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/square/go-jose/v3"
+	"github.com/square/go-jose/v3/jwt"
+	"golang.org/x/crypto/blake2b"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 	"zntr.io/solid/api/oidc"
 	"zntr.io/solid/pkg/sdk/jwk"
 	"zntr.io/solid/pkg/sdk/rfcerrors"
+	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/clientkeys"
+	"zntr.io/solid/pkg/server/profile"
 	"zntr.io/solid/pkg/server/storage"
 )
 
+var timeFunc = time.Now
+
 // PrivateKeyJWT authentication method.
-func PrivateKeyJWT(clients storage.ClientReader) AuthenticationProcessor {
+//
+// Given audiences are the accepted `aud` claim values, usually the issuer and
+// the endpoint URLs where the client authentication is processed. Assertion
+// identifiers are registered in the given storage to prevent replay attacks.
+// The client must be allowed to use this method by its application type
+// settings declared in the given server profile, and assertions must be signed
+// with an algorithm allowed by both the options and the server profile.
+func PrivateKeyJWT(clients storage.ClientReader, serverProfile profile.Server, assertions storage.ClientAssertion, audiences []string, opts ...PrivateKeyJWTOption) AuthenticationProcessor {
+	// Default options
+	defaultOptions := &privateKeyJWTOptions{
+		supportedAlgorithms: DefaultSupportedAlgorithms,
+		clockSkew:           DefaultClockSkew,
+		maxLifetime:         DefaultMaxAssertionLifetime,
+		keyResolver:         clientkeys.DefaultResolver(jwk.DefaultFetcher()),
+	}
+
+	// Parse options
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	// Restrict algorithms to the server profile
+	supportedAlgorithms := types.StringArray(defaultOptions.supportedAlgorithms)
+	if !types.IsNil(serverProfile) {
+		allowed := types.StringArray{}
+		for _, alg := range supportedAlgorithms {
+			if serverProfile.SigningAlgorithmsSupported().Contains(alg) {
+				allowed = append(allowed, alg)
+			}
+		}
+		supportedAlgorithms = allowed
+	}
+
 	return &privateKeyJWTAuthentication{
-		clients: clients,
+		clients:             clients,
+		serverProfile:       serverProfile,
+		assertions:          assertions,
+		audiences:           types.StringArray(audiences),
+		supportedAlgorithms: supportedAlgorithms,
+		clockSkew:           defaultOptions.clockSkew,
+		maxLifetime:         defaultOptions.maxLifetime,
+		keyResolver:         defaultOptions.keyResolver,
 	}
 }
 
 type privateJWTClaims struct {
-	JTI      string `json:"jti"`
-	Subject  string `json:"sub"`
-	Issuer   string `json:"iss"`
-	Audience string `json:"aud"`
-	Expires  uint64 `json:"exp"`
-	IssuedAt uint64 `json:"iat"`
+	JTI       string       `json:"jti"`
+	Subject   string       `json:"sub"`
+	Issuer    string       `json:"iss"`
+	Audience  jwt.Audience `json:"aud"`
+	Expires   uint64       `json:"exp"`
+	IssuedAt  uint64       `json:"iat,omitempty"`
+	NotBefore uint64       `json:"nbf,omitempty"`
 }
 
 type privateKeyJWTAuthentication struct {
-	clients storage.ClientReader
+	clients             storage.ClientReader
+	serverProfile       profile.Server
+	assertions          storage.ClientAssertion
+	audiences           types.StringArray
+	supportedAlgorithms types.StringArray
+	clockSkew           time.Duration
+	maxLifetime         time.Duration
+	keyResolver         clientkeys.Resolver
+}
+
+func (p *privateKeyJWTAuthentication) SupportedAlgorithms() []string {
+	// Return a copy
+	algs := make([]string, len(p.supportedAlgorithms))
+	copy(algs, p.supportedAlgorithms)
+
+	return algs
 }
 
 //nolint:funlen,gocyclo // to refactor
@@ -87,6 +151,17 @@ func (p *privateKeyJWTAuthentication) Authenticate(ctx context.Context, req *cor
 		return res, fmt.Errorf("assertion is syntaxically invalid: %w", err)
 	}
 
+	// Check signature algorithm
+	if len(rawAssertion.Signatures) != 1 {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("assertion must have exactly one signature")
+	}
+	header := rawAssertion.Signatures[0].Header
+	if !p.supportedAlgorithms.Contains(header.Algorithm) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("assertion is signed with an unsupported algorithm '%s'", header.Algorithm)
+	}
+
 	// Retrieve payload claims
 	var claims privateJWTClaims
 	if errDecode := json.Unmarshal(rawAssertion.UnsafePayloadWithoutVerification(), &claims); errDecode != nil {
@@ -95,7 +170,7 @@ func (p *privateKeyJWTAuthentication) Authenticate(ctx context.Context, req *cor
 	}
 
 	// Validate claims
-	if claims.Issuer == "" || claims.Subject == "" || claims.Audience == "" || claims.JTI == "" || claims.Expires == 0 {
+	if claims.Issuer == "" || claims.Subject == "" || len(claims.Audience) == 0 || claims.JTI == "" || claims.Expires == 0 {
 		res.Error = rfcerrors.InvalidRequest().Build()
 		return res, fmt.Errorf("iss, sub, aud, jti, exp are mandatory and not empty")
 	}
@@ -103,10 +178,31 @@ func (p *privateKeyJWTAuthentication) Authenticate(ctx context.Context, req *cor
 		res.Error = rfcerrors.InvalidRequest().Build()
 		return res, fmt.Errorf("iss and sub must be identic")
 	}
-	if claims.Expires < uint64(time.Now().Unix()) {
+
+	// Check audience
+	if !p.audiences.HasOneOf(claims.Audience...) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("assertion audience '%s' is not accepted", claims.Audience)
+	}
+
+	// Check time claims
+	now := timeFunc()
+	if claims.Expires < uint64(now.Add(-p.clockSkew).Unix()) {
 		res.Error = rfcerrors.InvalidRequest().Build()
 		return res, fmt.Errorf("expired token")
 	}
+	if claims.Expires > uint64(now.Add(p.maxLifetime+p.clockSkew).Unix()) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("token lifetime exceeds %s", p.maxLifetime)
+	}
+	if claims.NotBefore > uint64(now.Add(p.clockSkew).Unix()) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("token is not valid yet")
+	}
+	if claims.IssuedAt > uint64(now.Add(p.clockSkew).Unix()) {
+		res.Error = rfcerrors.InvalidRequest().Build()
+		return res, fmt.Errorf("token is issued in the future")
+	}
 
 	// Check client in storage
 	client, err := p.clients.Get(ctx, claims.Issuer)
@@ -118,29 +214,90 @@ func (p *privateKeyJWTAuthentication) Authenticate(ctx context.Context, req *cor
 		res.Error = rfcerrors.InvalidClient().Build()
 		return res, fmt.Errorf("client not found")
 	}
+	if storage.ClientStatus(client) != corev1.ClientStatus_CLIENT_STATUS_ACTIVE {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("client '%s' is not active", client.ClientId)
+	}
+	if err := profile.CheckAuthMethod(p.serverProfile, client, oidc.AuthMethodPrivateKeyJWT); err != nil {
+		res.Error = rfcerrors.InvalidClient().Build()
+		return res, fmt.Errorf("unable to validate client against server profile: %w", err)
+	}
 
-	// Retrieve JWK associated to the client
-	if len(client.Jwks) == 0 {
+	// Check client registered signature algorithm
+	if client.TokenEndpointAuthSigningAlg != "" && client.TokenEndpointAuthSigningAlg != header.Algorithm {
 		res.Error = rfcerrors.InvalidClient().Build()
-		return res, fmt.Errorf("client jwks is nil")
+		return res, fmt.Errorf("assertion is signed with '%s' instead of the registered '%s' algorithm", header.Algorithm, client.TokenEndpointAuthSigningAlg)
 	}
 
-	// Parse JWKS
-	var jwks jose.JSONWebKeySet
-	if err := json.Unmarshal(client.Jwks, &jwks); err != nil {
+	// Retrieve JWKS associated to the client
+	keyCtx := ctx
+	if header.KeyID != "" {
+		keyCtx = jwk.WithKeyID(ctx, header.KeyID)
+	}
+	jwks, err := p.keyResolver.KeySet(keyCtx, client)
+	if err != nil {
 		res.Error = rfcerrors.InvalidClient().Build()
-		return res, fmt.Errorf("client jwks is invalid: %w", err)
+		return res, fmt.Errorf("unable to retrieve client keys: %w", err)
+	}
+
+	// Select key by identifier if specified
+	if header.KeyID != "" {
+		keys := jwks.Key(header.KeyID)
+		if len(keys) == 0 {
+			res.Error = rfcerrors.InvalidClient().Build()
+			return res, fmt.Errorf("client jwks doesn't contain key '%s'", header.KeyID)
+		}
+
+		// Restrict keyset
+		jwks = &jose.JSONWebKeySet{Keys: keys}
 	}
 
 	// Try to validate assertion with one of keys
-	if err := jwk.ValidateSignature(&jwks, rawAssertion); err != nil {
+	if err := jwk.ValidateSignature(jwks, rawAssertion); err != nil {
 		res.Error = rfcerrors.InvalidClient().Build()
 		return res, fmt.Errorf("client assertion is invalid: %w", err)
 	}
 
+	// Check assertion replay
+	if publicErr, err := p.checkAssertionReplay(ctx, &claims, now); err != nil {
+		res.Error = publicErr
+		return res, err
+	}
+
 	// Assign client to result
 	res.Client = client
 
 	// No error
 	return res, nil
 }
+
+// -----------------------------------------------------------------------------
+
+func (p *privateKeyJWTAuthentication) checkAssertionReplay(ctx context.Context, claims *privateJWTClaims, now time.Time) (*corev1.Error, error) {
+	// Check arguments
+	if types.IsNil(p.assertions) {
+		return rfcerrors.ServerError().Build(), fmt.Errorf("unable to check assertion replay with nil storage")
+	}
+
+	// Compute jti hash scoped to client
+	jtiHashRaw := blake2b.Sum256([]byte(fmt.Sprintf("%s:%s", claims.Issuer, claims.JTI)))
+	jtiStorage := base64.RawURLEncoding.EncodeToString(jtiHashRaw[:])
+
+	// Keep the identifier until the assertion expires, bounded by the maximum
+	// accepted lifetime
+	ttl := time.Unix(int64(claims.Expires), 0).Sub(now) + p.clockSkew
+	if maxTTL := p.maxLifetime + 2*p.clockSkew; ttl > maxTTL {
+		ttl = maxTTL
+	}
+
+	// Atomically register the identifier
+	if err := p.assertions.Register(ctx, jtiStorage, ttl); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return rfcerrors.InvalidClient().Build(), fmt.Errorf("assertion '%s' has already been used", claims.JTI)
+		}
+		return rfcerrors.ServerError().Build(), fmt.Errorf("unable to register assertion: %w", err)
+	}
+
+	// No error
+	return nil, nil
+}
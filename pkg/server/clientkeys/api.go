@@ -0,0 +1,40 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientkeys
+
+import (
+	"context"
+	"errors"
+
+	"github.com/square/go-jose/v3"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+// ErrNoKeys is raised when the client doesn't have any registered key.
+var ErrNoKeys = errors.New("client doesn't have registered keys")
+
+//go:generate mockgen -destination mock/resolver.gen.go -package mock zntr.io/solid/pkg/server/clientkeys Resolver
+
+// Resolver describes client public keys resolution contract.
+type Resolver interface {
+	// KeySet returns the client key set from inline jwks or remote jwks_uri.
+	KeySet(ctx context.Context, client *corev1.Client) (*jose.JSONWebKeySet, error)
+	// EncryptionKey returns the client public key to use for response encryption.
+	EncryptionKey(ctx context.Context, client *corev1.Client) (*jose.JSONWebKey, error)
+}
@@ -0,0 +1,130 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientkeys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/square/go-jose/v3"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+// DefaultResolver returns a client key resolver using inline client jwks or
+// the given fetcher to retrieve keys published at client jwks_uri.
+func DefaultResolver(fetcher jwk.Fetcher) Resolver {
+	return &defaultResolver{
+		fetcher: fetcher,
+	}
+}
+
+// KeySetProvider returns a key set provider bound to the given client.
+func KeySetProvider(resolver Resolver, client *corev1.Client) jwk.KeySetProviderFunc {
+	return func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		return resolver.KeySet(ctx, client)
+	}
+}
+
+// EncryptionKeyProvider returns a key provider bound to the given client
+// encryption key.
+func EncryptionKeyProvider(resolver Resolver, client *corev1.Client) jwk.KeyProviderFunc {
+	return func(ctx context.Context) (*jose.JSONWebKey, error) {
+		return resolver.EncryptionKey(ctx, client)
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type defaultResolver struct {
+	fetcher jwk.Fetcher
+}
+
+func (r *defaultResolver) KeySet(ctx context.Context, client *corev1.Client) (*jose.JSONWebKeySet, error) {
+	// Check arguments
+	if client == nil {
+		return nil, fmt.Errorf("unable to resolve keys of nil client")
+	}
+
+	switch {
+	case len(client.Jwks) > 0:
+		// Decode inline key set
+		var jwks jose.JSONWebKeySet
+		if err := json.Unmarshal(client.Jwks, &jwks); err != nil {
+			return nil, fmt.Errorf("client jwks is invalid: %w", err)
+		}
+		if len(jwks.Keys) == 0 {
+			return nil, ErrNoKeys
+		}
+
+		// No error
+		return &jwks, nil
+	case client.JwksUri != "":
+		// Check fetcher
+		if types.IsNil(r.fetcher) {
+			return nil, fmt.Errorf("unable to retrieve client jwks_uri with nil fetcher")
+		}
+
+		// Delegate to fetcher
+		jwks, err := r.fetcher.Fetch(ctx, client.JwksUri)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve client jwks_uri: %w", err)
+		}
+
+		// No error
+		return jwks, nil
+	}
+
+	return nil, ErrNoKeys
+}
+
+func (r *defaultResolver) EncryptionKey(ctx context.Context, client *corev1.Client) (*jose.JSONWebKey, error) {
+	// Retrieve client key set
+	jwks, err := r.KeySet(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restrict to expected key
+	keys := jwks.Keys
+	if kid, ok := jwk.KeyIDFromContext(ctx); ok && kid != "" {
+		keys = jwks.Key(kid)
+	}
+
+	// Select first encryption key
+	for i := range keys {
+		k := keys[i]
+		if k.Use != "enc" {
+			continue
+		}
+
+		// Export public key only
+		pub := k.Public()
+		if !pub.Valid() {
+			return nil, fmt.Errorf("client encryption key '%s' is invalid", k.KeyID)
+		}
+
+		// No error
+		return &pub, nil
+	}
+
+	return nil, fmt.Errorf("client doesn't have an encryption key")
+}
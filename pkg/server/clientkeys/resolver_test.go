@@ -0,0 +1,169 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientkeys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/square/go-jose/v3"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	jwkmock "zntr.io/solid/pkg/sdk/jwk/mock"
+)
+
+var (
+	clientJWKS       = []byte(`{"keys": [{"kid": "sig-1", "kty": "EC","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ES256"},{"kid": "enc-1", "kty": "EC","use": "enc","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ECDH-ES"}]}`)
+	clientJWKSNoEnc  = []byte(`{"keys": [{"kid": "sig-1", "kty": "EC","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ES256"}]}`)
+	clientJWKSEmpty  = []byte(`{"keys": []}`)
+	clientJWKSBroken = []byte(`{"keys": [`)
+)
+
+func decodeJWKS(t *testing.T, raw []byte) *jose.JSONWebKeySet {
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(raw, &jwks); err != nil {
+		t.Fatalf("unable to decode jwks: %v", err)
+	}
+	return &jwks
+}
+
+func Test_defaultResolver_KeySet(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *corev1.Client
+		prepare  func(*jwkmock.MockFetcher)
+		wantKeys int
+		wantErr  bool
+	}{
+		{
+			name:    "nil client",
+			wantErr: true,
+		},
+		{
+			name:    "client without keys",
+			client:  &corev1.Client{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid inline jwks",
+			client:  &corev1.Client{Jwks: clientJWKSBroken},
+			wantErr: true,
+		},
+		{
+			name:    "empty inline jwks",
+			client:  &corev1.Client{Jwks: clientJWKSEmpty},
+			wantErr: true,
+		},
+		{
+			name:   "remote jwks error",
+			client: &corev1.Client{JwksUri: "https://client.example.org/jwks.json"},
+			prepare: func(fetcher *jwkmock.MockFetcher) {
+				fetcher.EXPECT().Fetch(gomock.Any(), "https://client.example.org/jwks.json").Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:     "valid inline jwks",
+			client:   &corev1.Client{Jwks: clientJWKS},
+			wantKeys: 2,
+		},
+		{
+			name:   "valid remote jwks",
+			client: &corev1.Client{JwksUri: "https://client.example.org/jwks.json"},
+			prepare: func(fetcher *jwkmock.MockFetcher) {
+				fetcher.EXPECT().Fetch(gomock.Any(), "https://client.example.org/jwks.json").Return(decodeJWKS(t, clientJWKSNoEnc), nil)
+			},
+			wantKeys: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			fetcher := jwkmock.NewMockFetcher(ctrl)
+
+			// Prepare them
+			if tt.prepare != nil {
+				tt.prepare(fetcher)
+			}
+
+			underTest := DefaultResolver(fetcher)
+
+			got, err := underTest.KeySet(context.Background(), tt.client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("defaultResolver.KeySet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Keys) != tt.wantKeys {
+				t.Errorf("defaultResolver.KeySet() keys = %d, want %d", len(got.Keys), tt.wantKeys)
+			}
+		})
+	}
+}
+
+func Test_defaultResolver_EncryptionKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *corev1.Client
+		wantKid string
+		wantErr bool
+	}{
+		{
+			name:    "client without keys",
+			client:  &corev1.Client{},
+			wantErr: true,
+		},
+		{
+			name:    "client without encryption key",
+			client:  &corev1.Client{Jwks: clientJWKSNoEnc},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:    "valid",
+			client:  &corev1.Client{Jwks: clientJWKS},
+			wantKid: "enc-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			underTest := DefaultResolver(nil)
+
+			got, err := underTest.EncryptionKey(context.Background(), tt.client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("defaultResolver.EncryptionKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.KeyID != tt.wantKid {
+				t.Errorf("defaultResolver.EncryptionKey() kid = %v, want %v", got.KeyID, tt.wantKid)
+			}
+			if !got.IsPublic() {
+				t.Errorf("defaultResolver.EncryptionKey() must return a public key")
+			}
+		})
+	}
+}
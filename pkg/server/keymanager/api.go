@@ -0,0 +1,84 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keymanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/square/go-jose/v3"
+
+	"zntr.io/solid/pkg/sdk/jwk"
+)
+
+const (
+	// DefaultRotationPeriod defines the default active key lifetime.
+	DefaultRotationPeriod = 30 * 24 * time.Hour
+	// DefaultRetention defines how long a retired key is kept for
+	// verification. It must be greater than the longest signed token lifetime.
+	DefaultRetention = 24 * time.Hour
+	// DefaultCacheTTL defines how long keys are served without reading the
+	// storage.
+	DefaultCacheTTL = 1 * time.Minute
+	// DefaultPublicationDelay defines how long a pending key is published
+	// before being used for signing.
+	DefaultPublicationDelay = jwk.DefaultCacheTTL
+)
+
+var (
+	// ErrNoActiveKey is raised when no key can be used for signing.
+	ErrNoActiveKey = errors.New("no active key")
+	// ErrKeyNotPublished is raised when a rotation is requested before the
+	// pending key has been published long enough.
+	ErrKeyNotPublished = errors.New("pending key is not published long enough")
+)
+
+//go:generate mockgen -destination mock/manager.gen.go -package mock zntr.io/solid/pkg/server/keymanager Manager
+
+// Manager describes server signing key lifecycle contract.
+//
+// A key is generated as pending and published in the key set, it becomes
+// active at the next rotation and is used for signing. A pending key is never
+// promoted before it has been published for the publication delay. The
+// previous active key is retired and kept in the key set until the tokens it
+// signed are expired.
+type Manager interface {
+	// SigningKey returns the active private key.
+	SigningKey(ctx context.Context) (*jose.JSONWebKey, error)
+	// PublicKeys returns public keys of pending, active and retired keys.
+	PublicKeys(ctx context.Context) (*jose.JSONWebKeySet, error)
+	// Rotate promotes the pending key without waiting for the end of the
+	// rotation period, it should be used when the active key must be revoked.
+	// It returns ErrKeyNotPublished when the pending key has not been
+	// published long enough.
+	Rotate(ctx context.Context) error
+}
+
+// KeyGenerator describes private key generation contract.
+type KeyGenerator func(ctx context.Context) (*jose.JSONWebKey, error)
+
+// KeyProvider returns a key provider returning the manager active key.
+func KeyProvider(m Manager) jwk.KeyProviderFunc {
+	return m.SigningKey
+}
+
+// KeySetProvider returns a key set provider returning the manager public keys.
+func KeySetProvider(m Manager) jwk.KeySetProviderFunc {
+	return m.PublicKeys
+}
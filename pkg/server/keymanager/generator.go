@@ -0,0 +1,83 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keymanager
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/square/go-jose/v3"
+)
+
+// DefaultAlgorithm defines the signature algorithm of the default managed key.
+const DefaultAlgorithm = string(jose.ES384)
+
+// DefaultGenerator returns a key generator for the given signature algorithm.
+// ES256, ES384, PS256 and EdDSA are supported.
+func DefaultGenerator(alg string) KeyGenerator {
+	return func(_ context.Context) (*jose.JSONWebKey, error) {
+		var (
+			pk  crypto.Signer
+			err error
+		)
+
+		// Generate a key pair
+		switch jose.SignatureAlgorithm(alg) {
+		case jose.ES256:
+			pk, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		case jose.ES384:
+			pk, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		case jose.PS256:
+			pk, err = rsa.GenerateKey(rand.Reader, 2048)
+		case jose.EdDSA:
+			_, pk, err = ed25519.GenerateKey(rand.Reader)
+		default:
+			return nil, fmt.Errorf("unable to generate key for unsupported algorithm '%s'", alg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate key: %w", err)
+		}
+
+		// No error
+		return &jose.JSONWebKey{
+			Key:       pk,
+			Use:       "sig",
+			Algorithm: alg,
+		}, nil
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// thumbprint returns the key identifier derived from the public key.
+// https://www.rfc-editor.org/rfc/rfc7638.html
+func thumbprint(k *jose.JSONWebKey) (string, error) {
+	h, err := k.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("unable to compute key thumbprint: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(h), nil
+}
@@ -0,0 +1,442 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keymanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/square/go-jose/v3"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/types"
+	"zntr.io/solid/pkg/server/storage"
+)
+
+var timeFunc = time.Now
+
+// DefaultManager returns a key manager persisting keys in the given storage.
+// Scheduled rotations are applied when the key cache is refreshed.
+func DefaultManager(keys storage.Key, opts ...Option) (Manager, error) {
+	// Check arguments
+	if types.IsNil(keys) {
+		return nil, fmt.Errorf("key storage is mandatory and couldn't be nil")
+	}
+
+	// Default options
+	defaultOptions := &options{
+		algorithms:       []string{DefaultAlgorithm},
+		generators:       map[string]KeyGenerator{},
+		rotationPeriod:   DefaultRotationPeriod,
+		retention:        DefaultRetention,
+		cacheTTL:         DefaultCacheTTL,
+		publicationDelay: DefaultPublicationDelay,
+	}
+
+	// Apply param functions
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	// Check options
+	if len(defaultOptions.algorithms) == 0 {
+		return nil, fmt.Errorf("at least one signature algorithm must be managed")
+	}
+	for _, alg := range defaultOptions.algorithms {
+		if defaultOptions.generators[alg] != nil {
+			continue
+		}
+		if !types.StringArray(jwk.SupportedSignatureAlgorithms).Contains(alg) {
+			return nil, fmt.Errorf("signature algorithm '%s' is not supported", alg)
+		}
+		defaultOptions.generators[alg] = DefaultGenerator(alg)
+	}
+	if defaultOptions.rotationPeriod <= 0 {
+		return nil, fmt.Errorf("rotation period must be positive")
+	}
+	if defaultOptions.retention < 0 {
+		return nil, fmt.Errorf("retention must not be negative")
+	}
+	if defaultOptions.cacheTTL < 0 {
+		return nil, fmt.Errorf("cache TTL must not be negative")
+	}
+	if defaultOptions.publicationDelay < 0 {
+		return nil, fmt.Errorf("publication delay must not be negative")
+	}
+
+	// No error
+	return &defaultManager{
+		keys:             keys,
+		algorithms:       types.StringArray(defaultOptions.algorithms),
+		generators:       defaultOptions.generators,
+		rotationPeriod:   uint64(defaultOptions.rotationPeriod / time.Second),
+		retention:        uint64(defaultOptions.retention / time.Second),
+		publicationDelay: uint64(defaultOptions.publicationDelay / time.Second),
+		cacheTTL:         defaultOptions.cacheTTL,
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+
+type defaultManager struct {
+	sync.RWMutex
+	keys             storage.Key
+	algorithms       types.StringArray
+	generators       map[string]KeyGenerator
+	rotationPeriod   uint64
+	retention        uint64
+	publicationDelay uint64
+	cacheTTL         time.Duration
+	cache            *keyCache
+}
+
+// keyCache holds decoded keys between two storage refreshes.
+type keyCache struct {
+	signing   map[string]*jose.JSONWebKey
+	public    *jose.JSONWebKeySet
+	expiresAt time.Time
+}
+
+func (m *defaultManager) SigningKey(ctx context.Context) (*jose.JSONWebKey, error) {
+	// Resolve requested algorithm
+	alg := m.algorithms[0]
+	if hint, ok := jwk.AlgorithmFromContext(ctx); ok && hint != "" {
+		alg = hint
+	}
+
+	// Retrieve keys
+	cache, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find active key
+	if key, ok := cache.signing[alg]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("algorithm '%s': %w", alg, ErrNoActiveKey)
+}
+
+func (m *defaultManager) PublicKeys(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	// Retrieve keys
+	cache, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a copy to protect the cached key set
+	return &jose.JSONWebKeySet{
+		Keys: append([]jose.JSONWebKey{}, cache.public.Keys...),
+	}, nil
+}
+
+func (m *defaultManager) Rotate(ctx context.Context) error {
+	m.Lock()
+	defer m.Unlock()
+
+	// Force transitions
+	keys, err := m.refresh(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	// Replace cached keys
+	return m.store(keys)
+}
+
+// -----------------------------------------------------------------------------
+
+func (m *defaultManager) load(ctx context.Context) (*keyCache, error) {
+	// Serve from cache
+	m.RLock()
+	cache := m.cache
+	m.RUnlock()
+	if cache != nil && timeFunc().Before(cache.expiresAt) {
+		return cache, nil
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	// Cache may have been refreshed while waiting for the lock
+	if m.cache != nil && timeFunc().Before(m.cache.expiresAt) {
+		return m.cache, nil
+	}
+
+	// Apply scheduled transitions
+	keys, err := m.refresh(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.store(keys); err != nil {
+		return nil, err
+	}
+
+	// No error
+	return m.cache, nil
+}
+
+// store decodes the given keys and replaces the cached ones.
+func (m *defaultManager) store(keys []*corev1.Key) error {
+	cache := &keyCache{
+		signing:   map[string]*jose.JSONWebKey{},
+		public:    &jose.JSONWebKeySet{},
+		expiresAt: timeFunc().Add(m.cacheTTL),
+	}
+
+	// Publish all keys, active keys first
+	for _, status := range []corev1.KeyStatus{corev1.KeyStatus_KEY_STATUS_ACTIVE, corev1.KeyStatus_KEY_STATUS_PENDING, corev1.KeyStatus_KEY_STATUS_RETIRED} {
+		for _, k := range keys {
+			if k.Status != status {
+				continue
+			}
+
+			key, err := decodeKey(k)
+			if err != nil {
+				return err
+			}
+			if status == corev1.KeyStatus_KEY_STATUS_ACTIVE {
+				cache.signing[k.Alg] = key
+			}
+			cache.public.Keys = append(cache.public.Keys, key.Public())
+		}
+	}
+
+	// No error
+	m.cache = cache
+	return nil
+}
+
+//nolint:gocyclo // lifecycle state machine
+func (m *defaultManager) refresh(ctx context.Context, force bool) ([]*corev1.Key, error) {
+	now := uint64(timeFunc().Unix())
+
+	// Retrieve all keys
+	all, err := m.keys.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve keys: %w", err)
+	}
+
+	var (
+		keys    = []*corev1.Key{}
+		active  = map[string]*corev1.Key{}
+		pending = map[string]*corev1.Key{}
+	)
+	for _, k := range all {
+		if k == nil {
+			continue
+		}
+
+		switch k.Status {
+		case corev1.KeyStatus_KEY_STATUS_ACTIVE:
+			// Concurrent rotations may leave several active keys, keep the
+			// most recently activated one.
+			if current := active[k.Alg]; current != nil {
+				loser := k
+				if newer(k, current) {
+					active[k.Alg], loser = k, current
+				}
+				if err := m.retire(ctx, loser, now); err != nil {
+					return nil, err
+				}
+				break
+			}
+			active[k.Alg] = k
+		case corev1.KeyStatus_KEY_STATUS_PENDING:
+			// Drop keys of unmanaged algorithms
+			if !m.algorithms.Contains(k.Alg) {
+				if err := m.keys.Delete(ctx, k.Kid); err != nil {
+					return nil, fmt.Errorf("unable to delete unused key '%s': %w", k.Kid, err)
+				}
+				continue
+			}
+			if pending[k.Alg] == nil || older(k, pending[k.Alg]) {
+				pending[k.Alg] = k
+			}
+		case corev1.KeyStatus_KEY_STATUS_RETIRED:
+			// Delete keys which can't have valid signed tokens anymore
+			if k.ExpiresAt <= now {
+				if err := m.keys.Delete(ctx, k.Kid); err != nil {
+					return nil, fmt.Errorf("unable to delete expired key '%s': %w", k.Kid, err)
+				}
+				continue
+			}
+		default:
+			// Ignore invalid keys
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	// Retire active keys of unmanaged algorithms
+	for alg, k := range active {
+		if m.algorithms.Contains(alg) {
+			continue
+		}
+		if err := m.retire(ctx, k, now); err != nil {
+			return nil, err
+		}
+	}
+
+	// Relying parties must know the next key before it is used for signing
+	published := func(alg string) bool {
+		k := pending[alg]
+		return k != nil && now >= k.CreatedAt+m.publicationDelay
+	}
+	if force {
+		for _, alg := range m.algorithms {
+			if active[alg] != nil && !published(alg) {
+				return nil, fmt.Errorf("algorithm '%s': %w", alg, ErrKeyNotPublished)
+			}
+		}
+	}
+
+	for _, alg := range m.algorithms {
+		// Retire the active key at the end of its period, it is kept until
+		// the next key has been published long enough.
+		if k := active[alg]; k != nil && (force || now >= k.ActivatedAt+m.rotationPeriod) && published(alg) {
+			if err := m.retire(ctx, k, now); err != nil {
+				return nil, err
+			}
+			active[alg] = nil
+		}
+
+		// Promote the published key
+		if k := pending[alg]; active[alg] == nil && k != nil {
+			k.Status = corev1.KeyStatus_KEY_STATUS_ACTIVE
+			k.ActivatedAt = now
+			if err := m.keys.Update(ctx, k); err != nil {
+				return nil, fmt.Errorf("unable to activate key '%s': %w", k.Kid, err)
+			}
+			active[alg], pending[alg] = k, nil
+		}
+
+		// Bootstrap with an active key
+		if active[alg] == nil {
+			k, err := m.generate(ctx, alg, corev1.KeyStatus_KEY_STATUS_ACTIVE, now)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, k)
+		}
+
+		// Publish the next key
+		if pending[alg] == nil {
+			k, err := m.generate(ctx, alg, corev1.KeyStatus_KEY_STATUS_PENDING, now)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, k)
+		}
+	}
+
+	// No error
+	return keys, nil
+}
+
+func (m *defaultManager) retire(ctx context.Context, k *corev1.Key, now uint64) error {
+	k.Status = corev1.KeyStatus_KEY_STATUS_RETIRED
+	k.RetiredAt = now
+	k.ExpiresAt = now + m.retention
+	if err := m.keys.Update(ctx, k); err != nil {
+		return fmt.Errorf("unable to retire key '%s': %w", k.Kid, err)
+	}
+
+	// No error
+	return nil
+}
+
+func (m *defaultManager) generate(ctx context.Context, alg string, status corev1.KeyStatus, now uint64) (*corev1.Key, error) {
+	// Generate private key
+	key, err := m.generators[alg](ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate key: %w", err)
+	}
+	if key == nil || !key.Valid() || key.IsPublic() {
+		return nil, fmt.Errorf("key generator returned an invalid private key")
+	}
+
+	// Check algorithm and key consistency
+	if err := jwk.CheckAlgorithm(key, alg); err != nil {
+		return nil, fmt.Errorf("key generator returned an unusable key: %w", err)
+	}
+	key.Algorithm = alg
+
+	// Assign key identifier
+	kid, err := thumbprint(key)
+	if err != nil {
+		return nil, err
+	}
+	key.KeyID = kid
+
+	// Encode key
+	raw, err := json.Marshal(key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode key: %w", err)
+	}
+
+	k := &corev1.Key{
+		Kid:       kid,
+		Alg:       alg,
+		Status:    status,
+		Jwk:       raw,
+		CreatedAt: now,
+	}
+	if status == corev1.KeyStatus_KEY_STATUS_ACTIVE {
+		k.ActivatedAt = now
+	}
+
+	// Save key
+	if err := m.keys.Register(ctx, k); err != nil {
+		return nil, fmt.Errorf("unable to register key '%s': %w", kid, err)
+	}
+
+	// No error
+	return k, nil
+}
+
+// newer returns true when a has been activated after b.
+func newer(a, b *corev1.Key) bool {
+	if a.ActivatedAt != b.ActivatedAt {
+		return a.ActivatedAt > b.ActivatedAt
+	}
+	return a.Kid > b.Kid
+}
+
+// older returns true when a has been created before b.
+func older(a, b *corev1.Key) bool {
+	if a.CreatedAt != b.CreatedAt {
+		return a.CreatedAt < b.CreatedAt
+	}
+	return a.Kid < b.Kid
+}
+
+func decodeKey(k *corev1.Key) (*jose.JSONWebKey, error) {
+	var jwk jose.JSONWebKey
+	if err := json.Unmarshal(k.Jwk, &jwk); err != nil {
+		return nil, fmt.Errorf("unable to decode key '%s': %w", k.Kid, err)
+	}
+
+	return &jwk, nil
+}
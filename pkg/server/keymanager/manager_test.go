@@ -0,0 +1,427 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keymanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwk"
+	storagemock "zntr.io/solid/pkg/server/storage/mock"
+)
+
+type keyStorage struct {
+	backend map[string]*corev1.Key
+}
+
+func (s *keyStorage) All(_ context.Context) ([]*corev1.Key, error) {
+	res := []*corev1.Key{}
+	for _, k := range s.backend {
+		res = append(res, k)
+	}
+	return res, nil
+}
+
+func (s *keyStorage) Register(_ context.Context, k *corev1.Key) error {
+	s.backend[k.Kid] = k
+	return nil
+}
+
+func (s *keyStorage) Update(_ context.Context, k *corev1.Key) error {
+	s.backend[k.Kid] = k
+	return nil
+}
+
+func (s *keyStorage) Delete(_ context.Context, kid string) error {
+	delete(s.backend, kid)
+	return nil
+}
+
+func testKey(t *testing.T, kid string, status corev1.KeyStatus, at uint64) *corev1.Key {
+	t.Helper()
+
+	key, err := DefaultGenerator(DefaultAlgorithm)(context.Background())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	key.KeyID, key.Algorithm = kid, DefaultAlgorithm
+	raw, err := json.Marshal(key)
+	if err != nil {
+		t.Fatalf("unable to encode key: %v", err)
+	}
+
+	k := &corev1.Key{Kid: kid, Alg: DefaultAlgorithm, Status: status, Jwk: raw, CreatedAt: at}
+	if status == corev1.KeyStatus_KEY_STATUS_ACTIVE {
+		k.ActivatedAt = at
+	}
+
+	return k
+}
+
+// -----------------------------------------------------------------------------
+
+func Test_defaultManager_Lifecycle(t *testing.T) {
+	defer func() {
+		timeFunc = time.Now
+	}()
+
+	now := time.Unix(1600000000, 0)
+	timeFunc = func() time.Time { return now }
+
+	ctx := context.Background()
+	store := &keyStorage{backend: map[string]*corev1.Key{}}
+	underTest, err := DefaultManager(store, RotationPeriod(time.Hour), Retention(10*time.Minute), PublicationDelay(10*time.Minute))
+	if err != nil {
+		t.Fatalf("unable to initialize manager: %v", err)
+	}
+
+	// Bootstrap
+	first, err := underTest.SigningKey(ctx)
+	if err != nil {
+		t.Fatalf("unable to retrieve signing key: %v", err)
+	}
+	if first.IsPublic() || first.KeyID == "" {
+		t.Fatal("signing key must be a private key with an identifier")
+	}
+	jwks, err := underTest.PublicKeys(ctx)
+	if err != nil {
+		t.Fatalf("unable to retrieve public keys: %v", err)
+	}
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("key set length = %d, want 2", len(jwks.Keys))
+	}
+	if jwks.Keys[0].KeyID != first.KeyID || !jwks.Keys[0].IsPublic() {
+		t.Fatal("active public key must be published first")
+	}
+	next := jwks.Keys[1].KeyID
+
+	// Stable during period
+	now = now.Add(30 * time.Minute)
+	if again, _ := underTest.SigningKey(ctx); again.KeyID != first.KeyID {
+		t.Fatal("signing key must not be rotated before period")
+	}
+
+	// Scheduled rotation promotes the published key
+	now = now.Add(31 * time.Minute)
+	second, err := underTest.SigningKey(ctx)
+	if err != nil {
+		t.Fatalf("unable to retrieve signing key: %v", err)
+	}
+	if second.KeyID != next {
+		t.Fatal("pending key must be activated after period")
+	}
+	if k := store.backend[first.KeyID]; k == nil || k.Status != corev1.KeyStatus_KEY_STATUS_RETIRED {
+		t.Fatal("previous key must be retired")
+	}
+	if jwks, _ := underTest.PublicKeys(ctx); len(jwks.Keys) != 3 {
+		t.Fatalf("key set length = %d, want 3", len(jwks.Keys))
+	}
+
+	// Forced rotation requires a published key
+	if err := underTest.Rotate(ctx); !errors.Is(err, ErrKeyNotPublished) {
+		t.Fatalf("rotation error = %v, want %v", err, ErrKeyNotPublished)
+	}
+	if again, _ := underTest.SigningKey(ctx); again.KeyID != second.KeyID {
+		t.Fatal("signing key must not be rotated before the next key is published")
+	}
+
+	// Retired key is deleted after retention
+	now = now.Add(11 * time.Minute)
+	if jwks, _ := underTest.PublicKeys(ctx); len(jwks.Keys) != 2 {
+		t.Fatalf("key set length = %d, want 2", len(jwks.Keys))
+	}
+	if _, ok := store.backend[first.KeyID]; ok {
+		t.Fatal("expired key must be deleted")
+	}
+
+	// Forced rotation
+	if err := underTest.Rotate(ctx); err != nil {
+		t.Fatalf("unable to rotate keys: %v", err)
+	}
+	third, _ := underTest.SigningKey(ctx)
+	if third.KeyID == second.KeyID {
+		t.Fatal("signing key must be rotated")
+	}
+	if k := store.backend[second.KeyID]; k == nil || k.Status != corev1.KeyStatus_KEY_STATUS_RETIRED {
+		t.Fatal("revoked key must be retired")
+	}
+}
+
+func Test_defaultManager_SigningKey(t *testing.T) {
+	defer func() {
+		timeFunc = time.Now
+	}()
+	timeFunc = func() time.Time { return time.Unix(1600000000, 0) }
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(*storagemock.MockKey)
+		wantErr bool
+		wantKid string
+	}{
+		{
+			name: "storage error",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return(nil, fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple active keys: retire error",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return([]*corev1.Key{
+					{Kid: "1", Alg: "ES384", Status: corev1.KeyStatus_KEY_STATUS_ACTIVE, ActivatedAt: 1600000000},
+					{Kid: "2", Alg: "ES384", Status: corev1.KeyStatus_KEY_STATUS_ACTIVE, ActivatedAt: 1600000000},
+				}, nil)
+				keys.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete error",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return([]*corev1.Key{
+					{Kid: "1", Alg: "ES384", Status: corev1.KeyStatus_KEY_STATUS_RETIRED, ExpiresAt: 1500000000},
+				}, nil)
+				keys.EXPECT().Delete(gomock.Any(), "1").Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "retire error",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return([]*corev1.Key{
+					{Kid: "1", Alg: "ES384", Status: corev1.KeyStatus_KEY_STATUS_ACTIVE, ActivatedAt: 1500000000},
+					{Kid: "2", Alg: "ES384", Status: corev1.KeyStatus_KEY_STATUS_PENDING, CreatedAt: 1500000000},
+				}, nil)
+				keys.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "register error",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return([]*corev1.Key{}, nil)
+				keys.EXPECT().Register(gomock.Any(), gomock.Any()).Return(fmt.Errorf("foo"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid key encoding",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return([]*corev1.Key{
+					{Kid: "1", Alg: "ES384", Status: corev1.KeyStatus_KEY_STATUS_ACTIVE, ActivatedAt: 1600000000, Jwk: []byte("{")},
+					{Kid: "2", Alg: "ES384", Status: corev1.KeyStatus_KEY_STATUS_PENDING, CreatedAt: 1600000000},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "multiple active keys",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return([]*corev1.Key{
+					testKey(t, "2", corev1.KeyStatus_KEY_STATUS_ACTIVE, 1600000000),
+					testKey(t, "1", corev1.KeyStatus_KEY_STATUS_ACTIVE, 1599999000),
+					testKey(t, "3", corev1.KeyStatus_KEY_STATUS_PENDING, 1599999000),
+				}, nil)
+				keys.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *corev1.Key) error {
+					if k.Kid != "1" || k.Status != corev1.KeyStatus_KEY_STATUS_RETIRED {
+						t.Errorf("key '%s' must not be retired", k.Kid)
+					}
+					return nil
+				})
+			},
+			wantKid: "2",
+		},
+		{
+			name: "valid",
+			args: args{
+				ctx: context.Background(),
+			},
+			prepare: func(keys *storagemock.MockKey) {
+				keys.EXPECT().All(gomock.Any()).Return([]*corev1.Key{}, nil)
+				keys.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Arm mocks
+			keys := storagemock.NewMockKey(ctrl)
+
+			// Prepare mocks
+			if tt.prepare != nil {
+				tt.prepare(keys)
+			}
+
+			// Prepare service
+			underTest, err := DefaultManager(keys)
+			if err != nil {
+				t.Fatalf("unable to initialize manager: %v", err)
+			}
+
+			got, err := underTest.SigningKey(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("defaultManager.SigningKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantKid != "" && got.KeyID != tt.wantKid {
+				t.Errorf("defaultManager.SigningKey() kid = %v, want %v", got.KeyID, tt.wantKid)
+			}
+		})
+	}
+}
+
+func Test_defaultManager_Cache(t *testing.T) {
+	defer func() {
+		timeFunc = time.Now
+	}()
+
+	now := time.Unix(1600000000, 0)
+	timeFunc = func() time.Time { return now }
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	keys := storagemock.NewMockKey(ctrl)
+	stored := []*corev1.Key{
+		testKey(t, "1", corev1.KeyStatus_KEY_STATUS_ACTIVE, 1600000000),
+		testKey(t, "2", corev1.KeyStatus_KEY_STATUS_PENDING, 1600000000),
+	}
+	keys.EXPECT().All(gomock.Any()).Return(stored, nil).Times(2)
+
+	underTest, err := DefaultManager(keys, CacheTTL(time.Minute))
+	if err != nil {
+		t.Fatalf("unable to initialize manager: %v", err)
+	}
+
+	// Storage is read once during cache lifetime
+	for i := 0; i < 3; i++ {
+		if _, err := underTest.SigningKey(ctx); err != nil {
+			t.Fatalf("unable to retrieve signing key: %v", err)
+		}
+		if _, err := underTest.PublicKeys(ctx); err != nil {
+			t.Fatalf("unable to retrieve public keys: %v", err)
+		}
+	}
+
+	// Storage is read again once expired
+	now = now.Add(time.Minute)
+	if _, err := underTest.SigningKey(ctx); err != nil {
+		t.Fatalf("unable to retrieve signing key: %v", err)
+	}
+}
+
+func Test_defaultManager_Algorithms(t *testing.T) {
+	ctx := context.Background()
+	store := &keyStorage{backend: map[string]*corev1.Key{}}
+
+	// Unsupported algorithm
+	if _, err := DefaultManager(store, Algorithms("HS256")); err == nil {
+		t.Fatal("unsupported algorithm must be rejected")
+	}
+
+	underTest, err := DefaultManager(store, Algorithms(jwk.SupportedSignatureAlgorithms...))
+	if err != nil {
+		t.Fatalf("unable to initialize manager: %v", err)
+	}
+
+	// Default algorithm
+	k, err := underTest.SigningKey(ctx)
+	if err != nil {
+		t.Fatalf("unable to retrieve signing key: %v", err)
+	}
+	if k.Algorithm != jwk.SupportedSignatureAlgorithms[0] {
+		t.Errorf("alg = %v, want %v", k.Algorithm, jwk.SupportedSignatureAlgorithms[0])
+	}
+
+	// Requested algorithm
+	for _, alg := range jwk.SupportedSignatureAlgorithms {
+		k, err := underTest.SigningKey(jwk.WithAlgorithm(ctx, alg))
+		if err != nil {
+			t.Fatalf("unable to retrieve %s signing key: %v", alg, err)
+		}
+		if err := jwk.CheckAlgorithm(k, alg); err != nil {
+			t.Errorf("%s signing key is not usable: %v", alg, err)
+		}
+	}
+	if _, err := underTest.SigningKey(jwk.WithAlgorithm(ctx, "RS256")); !errors.Is(err, ErrNoActiveKey) {
+		t.Errorf("SigningKey() error = %v, want ErrNoActiveKey", err)
+	}
+
+	// Active and pending keys of each algorithm are published
+	jwks, err := underTest.PublicKeys(ctx)
+	if err != nil {
+		t.Fatalf("unable to retrieve public keys: %v", err)
+	}
+	if len(jwks.Keys) != 2*len(jwk.SupportedSignatureAlgorithms) {
+		t.Errorf("key set length = %d, want %d", len(jwks.Keys), 2*len(jwk.SupportedSignatureAlgorithms))
+	}
+
+	// Unmanaged algorithm keys are retired
+	reduced, err := DefaultManager(store, Algorithms("ES256"))
+	if err != nil {
+		t.Fatalf("unable to initialize manager: %v", err)
+	}
+	if _, err := reduced.SigningKey(jwk.WithAlgorithm(ctx, "PS256")); !errors.Is(err, ErrNoActiveKey) {
+		t.Errorf("SigningKey() error = %v, want ErrNoActiveKey", err)
+	}
+	for _, k := range store.backend {
+		if k.Alg != "ES256" && k.Status != corev1.KeyStatus_KEY_STATUS_RETIRED {
+			t.Errorf("key '%s' of unmanaged algorithm %s must be retired", k.Kid, k.Alg)
+		}
+	}
+}
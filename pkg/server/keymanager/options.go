@@ -0,0 +1,80 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keymanager
+
+import "time"
+
+// Manager options holder
+type options struct {
+	algorithms       []string
+	generators       map[string]KeyGenerator
+	rotationPeriod   time.Duration
+	retention        time.Duration
+	cacheTTL         time.Duration
+	publicationDelay time.Duration
+}
+
+// Option defines functional pattern function type contract.
+type Option func(*options)
+
+// Algorithms defines the signature algorithms for which a signing key is
+// managed. The first one is used when no algorithm is requested.
+func Algorithms(algs ...string) Option {
+	return func(opts *options) {
+		opts.algorithms = algs
+	}
+}
+
+// Generator overrides the private key generator of the given algorithm.
+func Generator(alg string, g KeyGenerator) Option {
+	return func(opts *options) {
+		opts.generators[alg] = g
+	}
+}
+
+// RotationPeriod defines how long a key is used for signing before being
+// replaced by the pending key.
+func RotationPeriod(d time.Duration) Option {
+	return func(opts *options) {
+		opts.rotationPeriod = d
+	}
+}
+
+// Retention defines how long a retired key is kept for token verification.
+func Retention(d time.Duration) Option {
+	return func(opts *options) {
+		opts.retention = d
+	}
+}
+
+// CacheTTL defines how long keys are served from memory before the storage is
+// read again and scheduled rotations are applied.
+func CacheTTL(d time.Duration) Option {
+	return func(opts *options) {
+		opts.cacheTTL = d
+	}
+}
+
+// PublicationDelay defines how long a pending key must be published before
+// being promoted. It must be greater than the key set cache duration of
+// relying parties.
+func PublicationDelay(d time.Duration) Option {
+	return func(opts *options) {
+		opts.publicationDelay = d
+	}
+}
@@ -17,7 +17,11 @@
 
 package profile
 
-import "zntr.io/solid/pkg/sdk/types"
+import (
+	"time"
+
+	"zntr.io/solid/pkg/sdk/types"
+)
 
 //go:generate mockgen -destination mock/client.gen.go -package mock zntr.io/solid/pkg/server/profile Client
 
@@ -27,6 +31,7 @@ type Client interface {
 	TokenEndpointAuthMethodsSupported() types.StringArray
 	ResponseTypesSupported() types.StringArray
 	DefaultScopes() types.StringArray
+	SenderConstrainedAccessTokensRequired() bool
 }
 
 //go:generate mockgen -destination mock/server.gen.go -package mock zntr.io/solid/pkg/server/profile Server
@@ -34,4 +39,17 @@ type Client interface {
 // Server defines server profile contract.
 type Server interface {
 	ApplicationType(name string) (Client, bool)
+	ApplicationTypes() types.StringArray
+	// PushedAuthorizationRequestsRequired returns true when authorization
+	// requests must be pushed before being processed.
+	PushedAuthorizationRequestsRequired() bool
+	// SigningAlgorithmsSupported returns JWS algorithms allowed for signed
+	// requests, client assertions and responses.
+	SigningAlgorithmsSupported() types.StringArray
+	// AuthorizationCodeLifetime returns the authorization code validity period.
+	AuthorizationCodeLifetime() time.Duration
+	// AccessTokenLifetime returns the access token validity period.
+	AccessTokenLifetime() time.Duration
+	// RefreshTokenLifetime returns the refresh token validity period.
+	RefreshTokenLifetime() time.Duration
 }
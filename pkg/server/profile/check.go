@@ -0,0 +1,107 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package profile
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+var (
+	// ErrUnsupportedApplicationType is raised when the client application_type
+	// is not declared by the server profile.
+	ErrUnsupportedApplicationType = errors.New("application_type not supported by server profile")
+	// ErrGrantTypeNotAllowed is raised when the grant type is not allowed for
+	// the client application_type.
+	ErrGrantTypeNotAllowed = errors.New("grant_type not allowed by server profile")
+	// ErrResponseTypeNotAllowed is raised when the response type is not
+	// allowed for the client application_type.
+	ErrResponseTypeNotAllowed = errors.New("response_type not allowed by server profile")
+	// ErrAuthMethodNotAllowed is raised when the client authentication method
+	// is not allowed for the client application_type.
+	ErrAuthMethodNotAllowed = errors.New("token_endpoint_auth_method not allowed by server profile")
+)
+
+// ClientProfile returns the application type profile matching the given
+// client registration.
+func ClientProfile(p Server, client *corev1.Client) (Client, error) {
+	// Check arguments
+	if types.IsNil(p) {
+		return nil, fmt.Errorf("unable to resolve client profile with nil server profile")
+	}
+	if client == nil {
+		return nil, fmt.Errorf("unable to resolve client profile with nil client")
+	}
+
+	// Resolve application type settings
+	clientSettings, ok := p.ApplicationType(client.ApplicationType)
+	if !ok || types.IsNil(clientSettings) {
+		return nil, fmt.Errorf("client '%s' uses '%s': %w", client.ClientId, client.ApplicationType, ErrUnsupportedApplicationType)
+	}
+
+	// No error
+	return clientSettings, nil
+}
+
+// CheckGrantType ensures that the given grant type is allowed for the client
+// application type.
+func CheckGrantType(p Server, client *corev1.Client, grantType string) error {
+	clientSettings, err := ClientProfile(p, client)
+	if err != nil {
+		return err
+	}
+	if !clientSettings.GrantTypesSupported().Contains(grantType) {
+		return fmt.Errorf("client '%s' uses '%s': %w", client.ClientId, grantType, ErrGrantTypeNotAllowed)
+	}
+
+	// No error
+	return nil
+}
+
+// CheckResponseType ensures that the given response type is allowed for the
+// client application type.
+func CheckResponseType(p Server, client *corev1.Client, responseType string) error {
+	clientSettings, err := ClientProfile(p, client)
+	if err != nil {
+		return err
+	}
+	if !clientSettings.ResponseTypesSupported().Contains(responseType) {
+		return fmt.Errorf("client '%s' uses '%s': %w", client.ClientId, responseType, ErrResponseTypeNotAllowed)
+	}
+
+	// No error
+	return nil
+}
+
+// CheckAuthMethod ensures that the given client authentication method is
+// allowed for the client application type.
+func CheckAuthMethod(p Server, client *corev1.Client, method string) error {
+	clientSettings, err := ClientProfile(p, client)
+	if err != nil {
+		return err
+	}
+	if !clientSettings.TokenEndpointAuthMethodsSupported().Contains(method) {
+		return fmt.Errorf("client '%s' uses '%s': %w", client.ClientId, method, ErrAuthMethodNotAllowed)
+	}
+
+	// No error
+	return nil
+}
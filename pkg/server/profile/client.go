@@ -24,6 +24,7 @@ type defaultClientProfile struct {
 	tokenEndpointAuthMethodsSupported []string
 	responseTypesSupported            []string
 	defaultScopes                     []string
+	senderConstrainedAccessTokens     bool
 }
 
 func (c *defaultClientProfile) GrantTypesSupported() types.StringArray {
@@ -41,3 +42,7 @@ func (c *defaultClientProfile) ResponseTypesSupported() types.StringArray {
 func (c *defaultClientProfile) DefaultScopes() types.StringArray {
 	return types.StringArray(c.defaultScopes)
 }
+
+func (c *defaultClientProfile) SenderConstrainedAccessTokensRequired() bool {
+	return c.senderConstrainedAccessTokens
+}
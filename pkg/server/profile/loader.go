@@ -0,0 +1,244 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package profile
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+var (
+	knownApplicationTypes = types.StringArray{
+		oidc.ApplicationTypeServerSideWeb,
+		oidc.ApplicationTypeClientSideWeb,
+		oidc.ApplicationTypeNative,
+		oidc.ApplicationTypeService,
+		oidc.ApplicationTypeDevice,
+	}
+	knownGrantTypes = types.StringArray{
+		oidc.GrantTypeAuthorizationCode,
+		oidc.GrantTypeClientCredentials,
+		oidc.GrantTypeDeviceCode,
+		oidc.GrantTypeRefreshToken,
+		oidc.GrantTypeJWTBearer,
+		oidc.GrantTypeSAML2Bearer,
+	}
+	knownResponseTypes = types.StringArray{
+		oidc.ResponseTypeCode,
+		oidc.ResponseTypeToken,
+	}
+	knownAuthMethods = types.StringArray{
+		oidc.AuthMethodNone,
+		oidc.AuthMethodClientSecretPost,
+		oidc.AuthMethodClientSecretBasic,
+		oidc.AuthMethodClientSecretJWT,
+		oidc.AuthMethodPrivateKeyJWT,
+	}
+	knownSigningAlgorithms = types.StringArray(jwk.SupportedSignatureAlgorithms)
+)
+
+// document describes the declarative server profile representation.
+type document struct {
+	PushedAuthorizationRequestsRequired bool                       `yaml:"pushed_authorization_requests_required"`
+	SigningAlgorithmsSupported          []string                   `yaml:"signing_algorithms_supported"`
+	Lifetimes                           lifetimesDocument          `yaml:"lifetimes"`
+	ApplicationTypes                    map[string]*clientDocument `yaml:"application_types"`
+}
+
+type lifetimesDocument struct {
+	AuthorizationCode string `yaml:"authorization_code"`
+	AccessToken       string `yaml:"access_token"`
+	RefreshToken      string `yaml:"refresh_token"`
+}
+
+type clientDocument struct {
+	GrantTypes                            []string `yaml:"grant_types"`
+	ResponseTypes                         []string `yaml:"response_types"`
+	TokenEndpointAuthMethods              []string `yaml:"token_endpoint_auth_methods"`
+	DefaultScopes                         []string `yaml:"default_scopes"`
+	SenderConstrainedAccessTokensRequired bool     `yaml:"sender_constrained_access_tokens_required"`
+}
+
+// Load reads a declarative server profile definition from the given reader.
+//
+// The document can be expressed in YAML or JSON. Unknown attributes are
+// rejected and all values are validated against the supported OAuth
+// application types, grant types, response types, client authentication
+// methods and signature algorithms.
+func Load(r io.Reader) (Server, error) {
+	// Check arguments
+	if r == nil {
+		return nil, fmt.Errorf("unable to load profile from nil reader")
+	}
+
+	// Read all content
+	payload, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read profile document: %w", err)
+	}
+
+	// Decode document (JSON is a subset of YAML)
+	var doc document
+	if err := yaml.UnmarshalStrict(payload, &doc); err != nil {
+		return nil, fmt.Errorf("unable to decode profile document: %w", err)
+	}
+
+	// Validate and build the server profile
+	return doc.build()
+}
+
+// MustLoad loads the given document and panics on error.
+func MustLoad(r io.Reader) Server {
+	p, err := Load(r)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// -----------------------------------------------------------------------------
+
+func (d *document) build() (Server, error) {
+	// Check application types
+	if len(d.ApplicationTypes) == 0 {
+		return nil, fmt.Errorf("profile document must declare at least one application type")
+	}
+
+	// Check signing algorithms
+	if err := checkValues("signing_algorithms_supported", d.SigningAlgorithmsSupported, knownSigningAlgorithms); err != nil {
+		return nil, err
+	}
+
+	// Parse lifetimes
+	codeLifetime, err := parseLifetime("authorization_code", d.Lifetimes.AuthorizationCode)
+	if err != nil {
+		return nil, err
+	}
+	accessTokenLifetime, err := parseLifetime("access_token", d.Lifetimes.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	refreshTokenLifetime, err := parseLifetime("refresh_token", d.Lifetimes.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build client profiles
+	clientProfiles := map[string]Client{}
+	for name, cd := range d.ApplicationTypes {
+		if !knownApplicationTypes.Contains(name) {
+			return nil, fmt.Errorf("application type '%s' is not supported", name)
+		}
+		if cd == nil {
+			return nil, fmt.Errorf("application type '%s' must not be empty", name)
+		}
+
+		cp, err := cd.build(name)
+		if err != nil {
+			return nil, err
+		}
+		clientProfiles[name] = cp
+	}
+
+	// No error
+	return &defaultServerProfile{
+		clientProfiles:              clientProfiles,
+		pushedAuthorizationRequired: d.PushedAuthorizationRequestsRequired,
+		signingAlgorithmsSupported:  d.SigningAlgorithmsSupported,
+		authorizationCodeLifetime:   codeLifetime,
+		accessTokenLifetime:         accessTokenLifetime,
+		refreshTokenLifetime:        refreshTokenLifetime,
+	}, nil
+}
+
+func (d *clientDocument) build(name string) (Client, error) {
+	// Check mandatory values
+	if len(d.GrantTypes) == 0 {
+		return nil, fmt.Errorf("application type '%s' must declare at least one grant type", name)
+	}
+	if len(d.TokenEndpointAuthMethods) == 0 {
+		return nil, fmt.Errorf("application type '%s' must declare at least one token endpoint authentication method", name)
+	}
+
+	// Check values against known ones
+	if err := checkValues(name+".grant_types", d.GrantTypes, knownGrantTypes); err != nil {
+		return nil, err
+	}
+	if err := checkValues(name+".response_types", d.ResponseTypes, knownResponseTypes); err != nil {
+		return nil, err
+	}
+	if err := checkValues(name+".token_endpoint_auth_methods", d.TokenEndpointAuthMethods, knownAuthMethods); err != nil {
+		return nil, err
+	}
+	for _, scope := range d.DefaultScopes {
+		if scope == "" || strings.ContainsAny(scope, " \t\n") {
+			return nil, fmt.Errorf("%s.default_scopes contains an invalid scope '%s'", name, scope)
+		}
+	}
+
+	// Authorization code flow requires code response type
+	if types.StringArray(d.GrantTypes).Contains(oidc.GrantTypeAuthorizationCode) && !types.StringArray(d.ResponseTypes).Contains(oidc.ResponseTypeCode) {
+		return nil, fmt.Errorf("application type '%s' declares '%s' grant type without '%s' response type", name, oidc.GrantTypeAuthorizationCode, oidc.ResponseTypeCode)
+	}
+
+	// No error
+	return &defaultClientProfile{
+		grantTypesSupported:               d.GrantTypes,
+		tokenEndpointAuthMethodsSupported: d.TokenEndpointAuthMethods,
+		responseTypesSupported:            d.ResponseTypes,
+		defaultScopes:                     d.DefaultScopes,
+		senderConstrainedAccessTokens:     d.SenderConstrainedAccessTokensRequired,
+	}, nil
+}
+
+func checkValues(attribute string, values []string, known types.StringArray) error {
+	for _, v := range values {
+		if !known.Contains(v) {
+			return fmt.Errorf("%s contains an unsupported value '%s'", attribute, v)
+		}
+	}
+
+	// No error
+	return nil
+}
+
+func parseLifetime(name, value string) (time.Duration, error) {
+	// Use default lifetime
+	if value == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("lifetimes.%s is not a valid duration: %w", name, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("lifetimes.%s must be positive", name)
+	}
+
+	return d, nil
+}
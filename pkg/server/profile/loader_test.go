@@ -0,0 +1,293 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package profile
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"zntr.io/solid/api/oidc"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			doc:     ``,
+			wantErr: true,
+		},
+		{
+			name:    "invalid syntax",
+			doc:     `application_types: [`,
+			wantErr: true,
+		},
+		{
+			name: "unknown attribute",
+			doc: `
+application_types:
+  web:
+    grant_types: [authorization_code]
+    response_types: [code]
+    token_endpoint_auth_methods: [private_key_jwt]
+    unknown: true
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown application type",
+			doc: `
+application_types:
+  mainframe:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "empty application type",
+			doc: `
+application_types:
+  web:
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing grant types",
+			doc: `
+application_types:
+  service:
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing authentication methods",
+			doc: `
+application_types:
+  service:
+    grant_types: [client_credentials]
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown grant type",
+			doc: `
+application_types:
+  service:
+    grant_types: [password]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown response type",
+			doc: `
+application_types:
+  web:
+    grant_types: [authorization_code]
+    response_types: [code, id_token]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown authentication method",
+			doc: `
+application_types:
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [tls_client_auth]
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid default scope",
+			doc: `
+application_types:
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+    default_scopes: ["openid admin"]
+`,
+			wantErr: true,
+		},
+		{
+			name: "authorization_code without code response type",
+			doc: `
+application_types:
+  web:
+    grant_types: [authorization_code]
+    response_types: [token]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown signing algorithm",
+			doc: `
+signing_algorithms_supported: [HS256]
+application_types:
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "unsupported signing algorithm",
+			doc: `
+signing_algorithms_supported: [RS256]
+application_types:
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid lifetime",
+			doc: `
+lifetimes:
+  access_token: one hour
+application_types:
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		{
+			name: "negative lifetime",
+			doc: `
+lifetimes:
+  refresh_token: -1h
+application_types:
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid: yaml",
+			doc: `
+application_types:
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+`,
+			wantErr: false,
+		},
+		{
+			name: "valid: json",
+			doc: `{
+	"application_types": {
+		"service": {
+			"grant_types": ["client_credentials"],
+			"token_endpoint_auth_methods": ["private_key_jwt"]
+		}
+	}
+}`,
+			wantErr: false,
+		},
+		{
+			name:    "valid: strict",
+			doc:     StrictDocument,
+			wantErr: false,
+		},
+		{
+			name:    "valid: fapi2",
+			doc:     FAPI2Document,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Load(strings.NewReader(tt.doc))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Load() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("Load() returned a nil profile")
+			}
+		})
+	}
+}
+
+func TestLoad_Values(t *testing.T) {
+	got, err := Load(strings.NewReader(`
+pushed_authorization_requests_required: true
+signing_algorithms_supported: [PS256, ES256]
+lifetimes:
+  authorization_code: 1m
+  access_token: 15m
+application_types:
+  web:
+    grant_types: [authorization_code, refresh_token]
+    response_types: [code]
+    token_endpoint_auth_methods: [private_key_jwt]
+    default_scopes: [openid, profile]
+    sender_constrained_access_tokens_required: true
+`))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// Server settings
+	if !got.PushedAuthorizationRequestsRequired() {
+		t.Errorf("PushedAuthorizationRequestsRequired() = false, want true")
+	}
+	if want := (types.StringArray{"PS256", "ES256"}); !got.SigningAlgorithmsSupported().HasAll(want...) || len(got.SigningAlgorithmsSupported()) != len(want) {
+		t.Errorf("SigningAlgorithmsSupported() = %v, want %v", got.SigningAlgorithmsSupported(), want)
+	}
+	if got.AuthorizationCodeLifetime() != time.Minute {
+		t.Errorf("AuthorizationCodeLifetime() = %v, want %v", got.AuthorizationCodeLifetime(), time.Minute)
+	}
+	if got.AccessTokenLifetime() != 15*time.Minute {
+		t.Errorf("AccessTokenLifetime() = %v, want %v", got.AccessTokenLifetime(), 15*time.Minute)
+	}
+	if got.RefreshTokenLifetime() != defaultRefreshTokenLifetime {
+		t.Errorf("RefreshTokenLifetime() = %v, want %v", got.RefreshTokenLifetime(), defaultRefreshTokenLifetime)
+	}
+
+	// Client settings
+	if _, ok := got.ApplicationType(oidc.ApplicationTypeService); ok {
+		t.Errorf("ApplicationType(%q) should not be declared", oidc.ApplicationTypeService)
+	}
+	web, ok := got.ApplicationType(oidc.ApplicationTypeServerSideWeb)
+	if !ok {
+		t.Fatalf("ApplicationType(%q) should be declared", oidc.ApplicationTypeServerSideWeb)
+	}
+	if !web.GrantTypesSupported().HasAll(oidc.GrantTypeAuthorizationCode, oidc.GrantTypeRefreshToken) {
+		t.Errorf("GrantTypesSupported() = %v", web.GrantTypesSupported())
+	}
+	if !web.DefaultScopes().HasAll(oidc.ScopeOpenID, "profile") {
+		t.Errorf("DefaultScopes() = %v", web.DefaultScopes())
+	}
+	if !web.SenderConstrainedAccessTokensRequired() {
+		t.Errorf("SenderConstrainedAccessTokensRequired() = false, want true")
+	}
+}
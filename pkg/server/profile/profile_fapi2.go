@@ -0,0 +1,61 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package profile
+
+import "strings"
+
+// FAPI2Document is the declarative definition of the FAPI 2.0 Security
+// Profile compliant server profile.
+//
+// https://openid.net/specs/fapi-2_0-security-profile.html
+const FAPI2Document = `
+# https://openid.net/specs/fapi-2_0-security-profile.html#section-5.3.2.2
+pushed_authorization_requests_required: true
+# https://openid.net/specs/fapi-2_0-security-profile.html#section-5.4.1
+signing_algorithms_supported: [PS256, ES256, EdDSA]
+# https://openid.net/specs/fapi-2_0-security-profile.html#section-5.3.2.1
+lifetimes:
+  authorization_code: 60s
+  access_token: 10m
+  refresh_token: 24h
+application_types:
+  # Server side web application
+  # Only confidential clients are supported by this profile.
+  web:
+    grant_types: [authorization_code, refresh_token]
+    response_types: [code]
+    token_endpoint_auth_methods: [private_key_jwt]
+    sender_constrained_access_tokens_required: true
+  # Service account
+  # Implicit response types are forbidden by this profile.
+  service:
+    grant_types: [client_credentials]
+    token_endpoint_auth_methods: [private_key_jwt]
+    sender_constrained_access_tokens_required: true
+`
+
+var fapi2Profile = MustLoad(strings.NewReader(FAPI2Document))
+
+// FAPI2 returns a FAPI 2.0 Security Profile compliant server profile.
+//
+// Authorization requests must be pushed, PKCE with S256 challenge and the iss
+// authorization response parameter are always enforced, and all access tokens
+// are sender-constrained.
+func FAPI2() Server {
+	return fapi2Profile
+}
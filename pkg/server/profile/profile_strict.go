@@ -17,65 +17,51 @@
 
 package profile
 
-import "zntr.io/solid/api/oidc"
+import "strings"
 
-var strictProfile = &defaultServerProfile{
-	clientProfiles: map[string]Client{
-		// Server side web application
-		oidc.ApplicationTypeServerSideWeb: &defaultClientProfile{
-			grantTypesSupported: []string{
-				oidc.GrantTypeAuthorizationCode,
-			},
-			responseTypesSupported: []string{
-				oidc.ResponseTypeCode,
-			},
-			tokenEndpointAuthMethodsSupported: []string{
-				oidc.AuthMethodPrivateKeyJWT,
-			},
-		},
-		// Client side web application
-		// Explicitly ignored (not supported in this profile)
-		//
-		// Desktop or mobile application
-		oidc.ApplicationTypeNative: &defaultClientProfile{
-			grantTypesSupported: []string{
-				oidc.GrantTypeAuthorizationCode,
-				oidc.GrantTypeRefreshToken,
-			},
-			responseTypesSupported: []string{
-				oidc.ResponseTypeCode,
-			},
-			tokenEndpointAuthMethodsSupported: []string{
-				oidc.AuthMethodPrivateKeyJWT,
-			},
-		},
-		// Constrained device without browser (TV, Box, Game console, IoT, Car, etc.)
-		oidc.ApplicationTypeDevice: &defaultClientProfile{
-			grantTypesSupported: []string{
-				oidc.GrantTypeDeviceCode,
-				oidc.GrantTypeRefreshToken,
-			},
-			responseTypesSupported: []string{
-				oidc.ResponseTypeCode,
-			},
-			tokenEndpointAuthMethodsSupported: []string{
-				oidc.AuthMethodPrivateKeyJWT,
-			},
-		},
-		// Service account
-		oidc.ApplicationTypeService: &defaultClientProfile{
-			grantTypesSupported: []string{
-				oidc.GrantTypeClientCredentials,
-			},
-			responseTypesSupported: []string{
-				oidc.ResponseTypeToken,
-			},
-			tokenEndpointAuthMethodsSupported: []string{
-				oidc.AuthMethodPrivateKeyJWT,
-			},
-		},
-	},
-}
+// StrictDocument is the declarative definition of the strict server profile.
+//
+// Client side web applications never hold credentials, they must be served
+// through a backend-for-frontend (see pkg/bff) acting as a confidential client
+// on their behalf.
+const StrictDocument = `
+signing_algorithms_supported: [ES256, ES384, PS256, EdDSA]
+lifetimes:
+  authorization_code: 10m
+  access_token: 1h
+  refresh_token: 168h
+application_types:
+  # Server side web application
+  web:
+    grant_types: [authorization_code]
+    response_types: [code]
+    token_endpoint_auth_methods: [private_key_jwt]
+  # Client side web application served through a backend-for-frontend
+  browser:
+    grant_types: [authorization_code]
+    response_types: [code]
+    token_endpoint_auth_methods: [private_key_jwt]
+    sender_constrained_access_tokens_required: true
+  # Desktop or mobile application
+  native:
+    grant_types: [authorization_code, refresh_token]
+    response_types: [code]
+    token_endpoint_auth_methods: [private_key_jwt]
+    sender_constrained_access_tokens_required: true
+  # Constrained device without browser (TV, Box, Game console, IoT, Car, etc.)
+  device:
+    grant_types: ["urn:ietf:params:oauth:grant-type:device_code", refresh_token]
+    response_types: [code]
+    token_endpoint_auth_methods: [private_key_jwt]
+    sender_constrained_access_tokens_required: true
+  # Service account
+  service:
+    grant_types: [client_credentials]
+    response_types: [token]
+    token_endpoint_auth_methods: [private_key_jwt]
+`
+
+var strictProfile = MustLoad(strings.NewReader(StrictDocument))
 
 // Strict returns a strict server profile.
 func Strict() Server {
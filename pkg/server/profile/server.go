@@ -17,11 +17,74 @@
 
 package profile
 
+import (
+	"sort"
+	"time"
+
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+const (
+	defaultAuthorizationCodeLifetime = 10 * time.Minute
+	defaultAccessTokenLifetime       = 1 * time.Hour
+	defaultRefreshTokenLifetime      = 7 * 24 * time.Hour
+)
+
 type defaultServerProfile struct {
-	clientProfiles map[string]Client
+	clientProfiles              map[string]Client
+	pushedAuthorizationRequired bool
+	signingAlgorithmsSupported  []string
+	authorizationCodeLifetime   time.Duration
+	accessTokenLifetime         time.Duration
+	refreshTokenLifetime        time.Duration
 }
 
 func (s *defaultServerProfile) ApplicationType(typeName string) (Client, bool) {
 	c, ok := s.clientProfiles[typeName]
 	return c, ok
 }
+
+func (s *defaultServerProfile) ApplicationTypes() types.StringArray {
+	names := types.StringArray{}
+	for name := range s.clientProfiles {
+		names = append(names, name)
+	}
+
+	// Stable ordering
+	sort.Strings(names)
+
+	return names
+}
+
+func (s *defaultServerProfile) PushedAuthorizationRequestsRequired() bool {
+	return s.pushedAuthorizationRequired
+}
+
+func (s *defaultServerProfile) SigningAlgorithmsSupported() types.StringArray {
+	if len(s.signingAlgorithmsSupported) == 0 {
+		return types.StringArray(jwk.SupportedSignatureAlgorithms)
+	}
+	return types.StringArray(s.signingAlgorithmsSupported)
+}
+
+func (s *defaultServerProfile) AuthorizationCodeLifetime() time.Duration {
+	return durationOrDefault(s.authorizationCodeLifetime, defaultAuthorizationCodeLifetime)
+}
+
+func (s *defaultServerProfile) AccessTokenLifetime() time.Duration {
+	return durationOrDefault(s.accessTokenLifetime, defaultAccessTokenLifetime)
+}
+
+func (s *defaultServerProfile) RefreshTokenLifetime() time.Duration {
+	return durationOrDefault(s.refreshTokenLifetime, defaultRefreshTokenLifetime)
+}
+
+// -----------------------------------------------------------------------------
+
+func durationOrDefault(value, defaultValue time.Duration) time.Duration {
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
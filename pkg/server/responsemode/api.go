@@ -0,0 +1,77 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package responsemode
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"zntr.io/solid/api/oidc"
+)
+
+// ErrUnsupportedResponseMode is raised when the response mode is not supported.
+var ErrUnsupportedResponseMode = errors.New("unsupported response mode")
+
+// Writer describes authorization response delivery contract.
+type Writer interface {
+	// Write sends the given parameters to the client redirect uri.
+	Write(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) error
+}
+
+// FromMode returns the writer used to deliver the given response mode.
+// JWT response modes are delivered like their plain counterpart, the caller
+// must wrap the JARM token in the `response` parameter.
+func FromMode(mode string) (Writer, error) {
+	switch mode {
+	case oidc.ResponseModeQuery, oidc.ResponseModeQueryJWT:
+		return Query(), nil
+	case oidc.ResponseModeFragment, oidc.ResponseModeFragmentJWT:
+		return Fragment(), nil
+	case oidc.ResponseModeFormPost, oidc.ResponseModeFormPostJWT:
+		return FormPost(), nil
+	default:
+	}
+
+	return nil, fmt.Errorf("response mode '%s': %w", mode, ErrUnsupportedResponseMode)
+}
+
+// IsSupported returns true if the given response mode can be requested by a
+// client.
+func IsSupported(mode string) bool {
+	switch mode {
+	case oidc.ResponseModeQuery, oidc.ResponseModeFragment, oidc.ResponseModeFormPost:
+		return true
+	default:
+	}
+
+	return IsJWT(mode)
+}
+
+// IsJWT returns true if the given response mode is a JARM response mode.
+// https://openid.net/specs/oauth-v2-jarm.html#section-2.3
+func IsJWT(mode string) bool {
+	switch mode {
+	case oidc.ResponseModeJWT, oidc.ResponseModeQueryJWT, oidc.ResponseModeFragmentJWT, oidc.ResponseModeFormPostJWT:
+		return true
+	default:
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package responsemode
+
+import (
+	"errors"
+	"testing"
+
+	"zntr.io/solid/api/oidc"
+)
+
+func TestFromMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    string
+		want    Writer
+		wantErr error
+	}{
+		{
+			name:    "empty",
+			wantErr: ErrUnsupportedResponseMode,
+		},
+		{
+			name:    "unknown",
+			mode:    "web_message",
+			wantErr: ErrUnsupportedResponseMode,
+		},
+		{
+			name:    "jwt shortcut",
+			mode:    oidc.ResponseModeJWT,
+			wantErr: ErrUnsupportedResponseMode,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "query",
+			mode: oidc.ResponseModeQuery,
+			want: &queryWriter{},
+		},
+		{
+			name: "query.jwt",
+			mode: oidc.ResponseModeQueryJWT,
+			want: &queryWriter{},
+		},
+		{
+			name: "fragment",
+			mode: oidc.ResponseModeFragment,
+			want: &fragmentWriter{},
+		},
+		{
+			name: "fragment.jwt",
+			mode: oidc.ResponseModeFragmentJWT,
+			want: &fragmentWriter{},
+		},
+		{
+			name: "form_post",
+			mode: oidc.ResponseModeFormPost,
+			want: FormPost(),
+		},
+		{
+			name: "form_post.jwt",
+			mode: oidc.ResponseModeFormPostJWT,
+			want: FormPost(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromMode(tt.mode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromMode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got == nil {
+				t.Errorf("FromMode() = nil, want %T", tt.want)
+				return
+			}
+			if gotType, wantType := typeName(got), typeName(tt.want); gotType != wantType {
+				t.Errorf("FromMode() = %v, want %v", gotType, wantType)
+			}
+		})
+	}
+}
+
+func typeName(w Writer) string {
+	switch w.(type) {
+	case *queryWriter:
+		return "query"
+	case *fragmentWriter:
+		return "fragment"
+	case *formPostWriter:
+		return "form_post"
+	default:
+	}
+	return ""
+}
+
+func TestIsJWT(t *testing.T) {
+	tests := []struct {
+		mode string
+		want bool
+	}{
+		{mode: "", want: false},
+		{mode: oidc.ResponseModeQuery, want: false},
+		{mode: oidc.ResponseModeFragment, want: false},
+		{mode: oidc.ResponseModeFormPost, want: false},
+		{mode: oidc.ResponseModeJWT, want: true},
+		{mode: oidc.ResponseModeQueryJWT, want: true},
+		{mode: oidc.ResponseModeFragmentJWT, want: true},
+		{mode: oidc.ResponseModeFormPostJWT, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			if got := IsJWT(tt.mode); got != tt.want {
+				t.Errorf("IsJWT() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package responsemode
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const formPostScript = `document.forms[0].submit();`
+
+var formPostTemplate = template.Must(template.New("form_post").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Submit This Form</title></head>
+<body>
+<form method="post" action="{{ .Action }}">
+{{- range $name, $values := .Params }}{{ range $values }}
+<input type="hidden" name="{{ $name }}" value="{{ . }}"/>
+{{- end }}{{ end }}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+<script>` + formPostScript + `</script>
+</body>
+</html>`))
+
+// FormPost returns a writer sending parameters through an auto-submitted
+// HTML form.
+// https://openid.net/specs/oauth-v2-form-post-response-mode-1_0.html
+func FormPost() Writer {
+	h := sha256.Sum256([]byte(formPostScript))
+	return &formPostWriter{
+		scriptHash: base64.StdEncoding.EncodeToString(h[:]),
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+type formPostWriter struct {
+	scriptHash string
+}
+
+func (fw *formPostWriter) Write(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) error {
+	// Parse redirect uri
+	u, err := url.ParseRequestURI(redirectURI)
+	if err != nil {
+		return fmt.Errorf("unable to parse redirect uri: %w", err)
+	}
+
+	// Reject script capable schemes
+	switch strings.ToLower(u.Scheme) {
+	case "javascript", "data", "vbscript":
+		return fmt.Errorf("unable to use redirect uri with '%s' scheme", u.Scheme)
+	}
+
+	// Compute allowed form target
+	formAction, err := formActionSource(u)
+	if err != nil {
+		return err
+	}
+
+	// Render form
+	var buf bytes.Buffer
+	if err := formPostTemplate.Execute(&buf, map[string]interface{}{
+		// Private-use uri schemes are rejected by the template sanitizer
+		"Action": template.URL(u.String()), //nolint:gosec // scheme checked
+		"Params": params,
+	}); err != nil {
+		return fmt.Errorf("unable to render form: %w", err)
+	}
+
+	// Set headers
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.Header().Set("Content-Security-Policy", fmt.Sprintf("default-src 'none'; script-src 'sha256-%s'; form-action %s", fw.scriptHash, formAction))
+
+	// Write response
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("unable to write response: %w", err)
+	}
+
+	// No error
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+
+// formActionSource serializes the redirect uri as a CSP source expression.
+// https://www.w3.org/TR/CSP3/#grammardef-source-expression
+func formActionSource(u *url.URL) (string, error) {
+	var source string
+	switch {
+	case u.Host != "":
+		// Host source restricted to the redirect uri origin
+		source = fmt.Sprintf("%s://%s", strings.ToLower(u.Scheme), u.Host)
+	case u.Scheme != "":
+		// Scheme source for host-less uris (private-use uri schemes)
+		source = fmt.Sprintf("%s:", strings.ToLower(u.Scheme))
+	default:
+		return "", fmt.Errorf("unable to derive form-action source from redirect uri without scheme")
+	}
+
+	// Source expressions must not break the policy syntax
+	if strings.ContainsAny(source, " \t;,'\"") {
+		return "", fmt.Errorf("unable to derive form-action source from redirect uri '%s'", u.Redacted())
+	}
+
+	// No error
+	return source, nil
+}
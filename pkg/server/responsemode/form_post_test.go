@@ -0,0 +1,113 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package responsemode
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func Test_formPostWriter_Write(t *testing.T) {
+	tests := []struct {
+		name        string
+		redirectURI string
+		params      url.Values
+		wantErr     bool
+		wantBody    []string
+		wantCSP     string
+	}{
+		{
+			name:        "invalid redirect uri",
+			redirectURI: "cb",
+			wantErr:     true,
+		},
+		{
+			name:        "script scheme",
+			redirectURI: "javascript:alert(1)",
+			wantErr:     true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:        "valid",
+			redirectURI: "https://client.example.org/cb",
+			params:      url.Values{"code": []string{"SplxlOBeZQQYbYS6WxSbIA"}, "state": []string{"af0ifjsldkj"}},
+			wantBody: []string{
+				`<form method="post" action="https://client.example.org/cb">`,
+				`<input type="hidden" name="code" value="SplxlOBeZQQYbYS6WxSbIA"/>`,
+				`<input type="hidden" name="state" value="af0ifjsldkj"/>`,
+			},
+			wantCSP: "form-action https://client.example.org",
+		},
+		{
+			name:        "private-use uri scheme",
+			redirectURI: "com.example.app:/oauth2redirect",
+			params:      url.Values{"code": []string{"SplxlOBeZQQYbYS6WxSbIA"}},
+			wantBody: []string{
+				`<form method="post" action="com.example.app:/oauth2redirect">`,
+			},
+			wantCSP: "form-action com.example.app:",
+		},
+		{
+			name:        "custom scheme with host",
+			redirectURI: "myapp://callback/cb",
+			wantCSP:     "form-action myapp://callback",
+		},
+		{
+			name:        "escaped values",
+			redirectURI: "https://client.example.org/cb",
+			params:      url.Values{"state": []string{`"><script>alert(1)</script>`}},
+			wantBody: []string{
+				`<input type="hidden" name="state" value="&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;"/>`,
+			},
+			wantCSP: "form-action https://client.example.org",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "https://as.example.org/authorize", nil)
+
+			err := FormPost().Write(w, r, tt.redirectURI, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if w.Code != http.StatusOK {
+				t.Errorf("Write() status = %v, want %v", w.Code, http.StatusOK)
+			}
+			if got := w.Header().Get("Cache-Control"); got != "no-store" {
+				t.Errorf("Write() cache-control = %v, want no-store", got)
+			}
+			if got := w.Header().Get("Content-Security-Policy"); !strings.Contains(got, tt.wantCSP) {
+				t.Errorf("Write() csp = %v, want %v", got, tt.wantCSP)
+			}
+			body := w.Body.String()
+			for _, want := range tt.wantBody {
+				if !strings.Contains(body, want) {
+					t.Errorf("Write() body = %v, want %v", body, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package responsemode
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Fragment returns a writer encoding parameters in the redirect uri fragment.
+// https://openid.net/specs/oauth-v2-multiple-response-types-1_0.html#ResponseModes
+func Fragment() Writer {
+	return &fragmentWriter{}
+}
+
+// -----------------------------------------------------------------------------
+
+type fragmentWriter struct{}
+
+func (fw *fragmentWriter) Write(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) error {
+	// Parse redirect uri
+	u, err := url.ParseRequestURI(redirectURI)
+	if err != nil {
+		return fmt.Errorf("unable to parse redirect uri: %w", err)
+	}
+
+	// Assign parameters
+	u.Fragment = ""
+	u.RawFragment = ""
+	target := fmt.Sprintf("%s#%s", u.String(), params.Encode())
+
+	// Redirect to application
+	http.Redirect(w, r, target, http.StatusFound)
+
+	// No error
+	return nil
+}
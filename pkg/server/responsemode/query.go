@@ -0,0 +1,58 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package responsemode
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Query returns a writer encoding parameters in the redirect uri query.
+// https://openid.net/specs/oauth-v2-multiple-response-types-1_0.html#ResponseModes
+func Query() Writer {
+	return &queryWriter{}
+}
+
+// -----------------------------------------------------------------------------
+
+type queryWriter struct{}
+
+func (qw *queryWriter) Write(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) error {
+	// Parse redirect uri
+	u, err := url.ParseRequestURI(redirectURI)
+	if err != nil {
+		return fmt.Errorf("unable to parse redirect uri: %w", err)
+	}
+
+	// Preserve registered query parameters
+	// https://tools.ietf.org/html/rfc6749#section-3.1.2
+	q := u.Query()
+	for k, values := range params {
+		for _, v := range values {
+			q.Add(k, v)
+		}
+	}
+	u.RawQuery = q.Encode()
+
+	// Redirect to application
+	http.Redirect(w, r, u.String(), http.StatusFound)
+
+	// No error
+	return nil
+}
@@ -0,0 +1,75 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package responsemode
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_queryWriter_Write(t *testing.T) {
+	tests := []struct {
+		name         string
+		redirectURI  string
+		params       url.Values
+		wantErr      bool
+		wantLocation string
+	}{
+		{
+			name:        "invalid redirect uri",
+			redirectURI: "cb",
+			wantErr:     true,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name:         "valid",
+			redirectURI:  "https://client.example.org/cb",
+			params:       url.Values{"code": []string{"SplxlOBeZQQYbYS6WxSbIA"}, "state": []string{"af0ifjsldkj"}},
+			wantLocation: "https://client.example.org/cb?code=SplxlOBeZQQYbYS6WxSbIA&state=af0ifjsldkj",
+		},
+		{
+			name:         "valid with registered query",
+			redirectURI:  "https://client.example.org/cb?tenant=foo",
+			params:       url.Values{"response": []string{"eyJ.eyJ.sig"}},
+			wantLocation: "https://client.example.org/cb?response=eyJ.eyJ.sig&tenant=foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "https://as.example.org/authorize", nil)
+
+			err := Query().Write(w, r, tt.redirectURI, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if w.Code != http.StatusFound {
+				t.Errorf("Write() status = %v, want %v", w.Code, http.StatusFound)
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Write() location = %v, want %v", got, tt.wantLocation)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package softwarestatement
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+const (
+	// DefaultClockSkew defines default tolerated clock skew for statement time claims.
+	DefaultClockSkew = 15 * time.Second
+)
+
+var (
+	// ErrInvalidStatement is raised when the statement can't be decoded or
+	// its signature is invalid.
+	ErrInvalidStatement = errors.New("invalid software statement")
+	// ErrUntrustedIssuer is raised when the statement issuer is not trusted.
+	ErrUntrustedIssuer = errors.New("untrusted software statement issuer")
+	// ErrUnapprovedSoftware is raised when the asserted software identifier is
+	// not approved.
+	ErrUnapprovedSoftware = errors.New("unapproved software")
+)
+
+//go:generate mockgen -destination mock/verifier.gen.go -package mock zntr.io/solid/pkg/server/softwarestatement Verifier
+
+// Verifier describes software statement verification contract.
+// https://tools.ietf.org/html/rfc7591#section-2.3
+type Verifier interface {
+	// Verify checks the statement against trusted issuers and returns the
+	// asserted client metadata.
+	Verify(ctx context.Context, statement string) (*corev1.SoftwareStatement, error)
+}
@@ -0,0 +1,66 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package softwarestatement
+
+import (
+	"time"
+
+	"zntr.io/solid/pkg/sdk/jwk"
+)
+
+// Verifier options holder
+type options struct {
+	issuers             map[string]jwk.KeySetProviderFunc
+	approvedSoftware    []string
+	supportedAlgorithms []string
+	clockSkew           time.Duration
+}
+
+// Option defines functional pattern function type contract.
+type Option func(*options)
+
+// TrustedIssuer registers a statement issuer with the key set used to verify
+// its statements.
+func TrustedIssuer(issuer string, keySetProvider jwk.KeySetProviderFunc) Option {
+	return func(opts *options) {
+		opts.issuers[issuer] = keySetProvider
+	}
+}
+
+// ApprovedSoftware restricts accepted statements to the given software
+// identifiers. All software identifiers asserted by a trusted issuer are
+// accepted when not specified.
+func ApprovedSoftware(softwareIDs ...string) Option {
+	return func(opts *options) {
+		opts.approvedSoftware = append(opts.approvedSoftware, softwareIDs...)
+	}
+}
+
+// SupportedAlgorithms defines the allowed statement signature algorithms.
+func SupportedAlgorithms(algs ...string) Option {
+	return func(opts *options) {
+		opts.supportedAlgorithms = algs
+	}
+}
+
+// ClockSkew defines the tolerated clock skew used to validate exp, iat and nbf claims.
+func ClockSkew(d time.Duration) Option {
+	return func(opts *options) {
+		opts.clockSkew = d
+	}
+}
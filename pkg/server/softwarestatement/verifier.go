@@ -0,0 +1,288 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package softwarestatement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/square/go-jose/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+	"zntr.io/solid/pkg/sdk/jwk"
+	"zntr.io/solid/pkg/sdk/locale"
+	"zntr.io/solid/pkg/sdk/types"
+)
+
+var timeFunc = time.Now
+
+// DefaultVerifier returns a verifier accepting statements signed by the
+// trusted issuers.
+func DefaultVerifier(opts ...Option) Verifier {
+	// Default options
+	defaultOptions := &options{
+		issuers:             map[string]jwk.KeySetProviderFunc{},
+		supportedAlgorithms: jwk.SupportedSignatureAlgorithms,
+		clockSkew:           DefaultClockSkew,
+	}
+
+	// Parse options
+	for _, o := range opts {
+		o(defaultOptions)
+	}
+
+	return &defaultVerifier{
+		issuers:             defaultOptions.issuers,
+		approvedSoftware:    types.StringArray(defaultOptions.approvedSoftware),
+		supportedAlgorithms: types.StringArray(defaultOptions.supportedAlgorithms),
+		clockSkew:           defaultOptions.clockSkew,
+	}
+}
+
+type defaultVerifier struct {
+	issuers             map[string]jwk.KeySetProviderFunc
+	approvedSoftware    types.StringArray
+	supportedAlgorithms types.StringArray
+	clockSkew           time.Duration
+}
+
+//nolint:gocyclo // to refactor
+func (v *defaultVerifier) Verify(ctx context.Context, statement string) (*corev1.SoftwareStatement, error) {
+	// Check arguments
+	if statement == "" {
+		return nil, fmt.Errorf("%w: statement must not be empty", ErrInvalidStatement)
+	}
+
+	// Decode statement without validation first
+	raw, err := jose.ParseSigned(statement)
+	if err != nil {
+		return nil, fmt.Errorf("%w: statement is syntaxically invalid: %v", ErrInvalidStatement, err)
+	}
+
+	// Check signature algorithm
+	if len(raw.Signatures) != 1 {
+		return nil, fmt.Errorf("%w: statement must have exactly one signature", ErrInvalidStatement)
+	}
+	header := raw.Signatures[0].Header
+	if !v.supportedAlgorithms.Contains(header.Algorithm) {
+		return nil, fmt.Errorf("%w: statement is signed with an unsupported algorithm '%s'", ErrInvalidStatement, header.Algorithm)
+	}
+
+	// Retrieve payload claims
+	var claims statementClaims
+	if errDecode := json.Unmarshal(raw.UnsafePayloadWithoutVerification(), &claims); errDecode != nil {
+		return nil, fmt.Errorf("%w: unable to decode payload claims: %v", ErrInvalidStatement, errDecode)
+	}
+
+	// Validate claims
+	if claims.Issuer == "" || claims.SoftwareID == "" {
+		return nil, fmt.Errorf("%w: iss and software_id are mandatory and not empty", ErrInvalidStatement)
+	}
+
+	// Check issuer
+	keySetProvider, ok := v.issuers[claims.Issuer]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrUntrustedIssuer, claims.Issuer)
+	}
+
+	// Check time claims
+	now := timeFunc()
+	if claims.Expires > 0 && claims.Expires < uint64(now.Add(-v.clockSkew).Unix()) {
+		return nil, fmt.Errorf("%w: expired statement", ErrInvalidStatement)
+	}
+	if claims.NotBefore > uint64(now.Add(v.clockSkew).Unix()) {
+		return nil, fmt.Errorf("%w: statement is not valid yet", ErrInvalidStatement)
+	}
+	if claims.IssuedAt > uint64(now.Add(v.clockSkew).Unix()) {
+		return nil, fmt.Errorf("%w: statement is issued in the future", ErrInvalidStatement)
+	}
+
+	// Retrieve issuer keys
+	keyCtx := ctx
+	if header.KeyID != "" {
+		keyCtx = jwk.WithKeyID(ctx, header.KeyID)
+	}
+	jwks, err := keySetProvider(keyCtx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve '%s' issuer keys: %w", claims.Issuer, err)
+	}
+	if jwks == nil {
+		return nil, fmt.Errorf("key set provider of '%s' issuer returned a nil key set", claims.Issuer)
+	}
+
+	// Select key by identifier if specified
+	if header.KeyID != "" {
+		keys := jwks.Key(header.KeyID)
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("%w: issuer jwks doesn't contain key '%s'", ErrInvalidStatement, header.KeyID)
+		}
+
+		// Restrict keyset
+		jwks = &jose.JSONWebKeySet{Keys: keys}
+	}
+
+	// Try to validate statement with one of keys
+	if err := jwk.ValidateSignature(jwks, raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+	}
+
+	// Check software approval
+	if len(v.approvedSoftware) > 0 && !v.approvedSoftware.Contains(claims.SoftwareID) {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnapprovedSoftware, claims.SoftwareID)
+	}
+
+	// Convert claims to client metadata
+	meta, err := claims.metadata()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+	}
+
+	// No error
+	return &corev1.SoftwareStatement{
+		SoftwareId: claims.SoftwareID,
+		Issuer:     claims.Issuer,
+		Metadata:   meta,
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+
+// https://tools.ietf.org/html/rfc7591#section-2
+type statementClaims struct {
+	Issuer                            string          `json:"iss"`
+	IssuedAt                          uint64          `json:"iat,omitempty"`
+	NotBefore                         uint64          `json:"nbf,omitempty"`
+	Expires                           uint64          `json:"exp,omitempty"`
+	SoftwareID                        string          `json:"software_id"`
+	SoftwareVersion                   string          `json:"software_version,omitempty"`
+	ApplicationType                   string          `json:"application_type,omitempty"`
+	RedirectURIs                      []string        `json:"redirect_uris,omitempty"`
+	TokenEndpointAuthMethod           string          `json:"token_endpoint_auth_method,omitempty"`
+	GrantTypes                        []string        `json:"grant_types,omitempty"`
+	ResponseTypes                     []string        `json:"response_types,omitempty"`
+	ResponseModes                     []string        `json:"response_modes,omitempty"`
+	ClientName                        string          `json:"client_name,omitempty"`
+	ClientURI                         string          `json:"client_uri,omitempty"`
+	LogoURI                           string          `json:"logo_uri,omitempty"`
+	Scope                             string          `json:"scope,omitempty"`
+	Contacts                          []string        `json:"contacts,omitempty"`
+	TosURI                            string          `json:"tos_uri,omitempty"`
+	PolicyURI                         string          `json:"policy_uri,omitempty"`
+	JwksURI                           string          `json:"jwks_uri,omitempty"`
+	JWKS                              json.RawMessage `json:"jwks,omitempty"`
+	TLSBoundAccessTokens              *bool           `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	DPoPBoundAccessTokens             *bool           `json:"dpop_bound_access_tokens,omitempty"`
+	AuthorizationEncryptedResponseAlg string          `json:"authorization_encrypted_response_alg,omitempty"`
+	AuthorizationEncryptedResponseEnc string          `json:"authorization_encrypted_response_enc,omitempty"`
+	AuthorizationSignedResponseAlg    string          `json:"authorization_signed_response_alg,omitempty"`
+	TokenEndpointAuthSigningAlg       string          `json:"token_endpoint_auth_signing_alg,omitempty"`
+	RequestObjectSigningAlg           string          `json:"request_object_signing_alg,omitempty"`
+
+	// Localized members
+	ClientNameI18N map[string]string `json:"-"`
+	LogoURII18N    map[string]string `json:"-"`
+	TosURII18N     map[string]string `json:"-"`
+	PolicyURII18N  map[string]string `json:"-"`
+}
+
+// UnmarshalJSON decodes statement claims and collects localized members
+// expressed as `<name>#<language tag>`.
+func (c *statementClaims) UnmarshalJSON(data []byte) error {
+	type alias statementClaims
+	if err := json.Unmarshal(data, (*alias)(c)); err != nil {
+		return err
+	}
+
+	// Decode localized members
+	members, err := locale.Members(data, "client_name", "logo_uri", "tos_uri", "policy_uri")
+	if err != nil {
+		return err
+	}
+	c.ClientNameI18N = members["client_name"]
+	c.LogoURII18N = members["logo_uri"]
+	c.TosURII18N = members["tos_uri"]
+	c.PolicyURII18N = members["policy_uri"]
+
+	// No error
+	return nil
+}
+
+func (c *statementClaims) metadata() (*corev1.ClientMeta, error) {
+	// Copy array
+	meta := &corev1.ClientMeta{
+		Contacts:       c.Contacts,
+		GrantTypes:     c.GrantTypes,
+		RedirectUris:   c.RedirectURIs,
+		ResponseTypes:  c.ResponseTypes,
+		ResponseModes:  c.ResponseModes,
+		SoftwareId:     &wrapperspb.StringValue{Value: c.SoftwareID},
+		ClientNameI18N: c.ClientNameI18N,
+		LogoUriI18N:    c.LogoURII18N,
+		TosUriI18N:     c.TosURII18N,
+		PolicyUriI18N:  c.PolicyURII18N,
+	}
+
+	// Process optional fields
+	for _, f := range []struct {
+		value  string
+		target **wrapperspb.StringValue
+	}{
+		{value: c.SoftwareVersion, target: &meta.SoftwareVersion},
+		{value: c.ApplicationType, target: &meta.ApplicationType},
+		{value: c.TokenEndpointAuthMethod, target: &meta.TokenEndpointAuthMethod},
+		{value: c.ClientName, target: &meta.ClientName},
+		{value: c.ClientURI, target: &meta.ClientUri},
+		{value: c.LogoURI, target: &meta.LogoUri},
+		{value: c.Scope, target: &meta.Scope},
+		{value: c.TosURI, target: &meta.TosUri},
+		{value: c.PolicyURI, target: &meta.PolicyUri},
+		{value: c.JwksURI, target: &meta.JwkUri},
+		{value: c.AuthorizationEncryptedResponseAlg, target: &meta.AuthorizationEncryptedResponseAlg},
+		{value: c.AuthorizationEncryptedResponseEnc, target: &meta.AuthorizationEncryptedResponseEnc},
+		{value: c.AuthorizationSignedResponseAlg, target: &meta.AuthorizationSignedResponseAlg},
+		{value: c.TokenEndpointAuthSigningAlg, target: &meta.TokenEndpointAuthSigningAlg},
+		{value: c.RequestObjectSigningAlg, target: &meta.RequestObjectSigningAlg},
+	} {
+		if f.value != "" {
+			*f.target = &wrapperspb.StringValue{Value: f.value}
+		}
+	}
+	if c.TLSBoundAccessTokens != nil {
+		meta.TlsClientCertificateBoundAccessTokens = &wrapperspb.BoolValue{Value: *c.TLSBoundAccessTokens}
+	}
+	if c.DPoPBoundAccessTokens != nil {
+		meta.DpopBoundAccessTokens = &wrapperspb.BoolValue{Value: *c.DPoPBoundAccessTokens}
+	}
+
+	// JWKS
+	if len(c.JWKS) > 0 {
+		var jwks jose.JSONWebKeySet
+		if err := json.Unmarshal(c.JWKS, &jwks); err != nil {
+			return nil, fmt.Errorf("jwks is invalid: %w", err)
+		}
+
+		// Set JWKS
+		meta.Jwks = &wrapperspb.BytesValue{Value: c.JWKS}
+	}
+
+	// No error
+	return meta, nil
+}
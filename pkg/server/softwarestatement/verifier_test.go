@@ -0,0 +1,316 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package softwarestatement
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/square/go-jose/v3"
+	"github.com/square/go-jose/v3/jwt"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+var (
+	issuerPrivateKey = []byte(`{"kid": "issuer-key", "kty": "EC","d": "olYJLJ3aiTyP44YXs0R3g1qChRKnYnk7GDxffQhAgL8","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ES256"}`)
+	issuerJWKS       = []byte(`{"keys": [{"kid": "issuer-key", "kty": "EC","use": "sig","crv": "P-256","x": "h6jud8ozOJ93MvHZCxvGZnOVHLeTX-3K9LkAvKy1RSs","y": "yY0UQDLFPM8OAgkOYfotwzXCGXtBYinBk1EURJQ7ONk","alg": "ES256"}]}`)
+)
+
+func Test_defaultVerifier_Verify(t *testing.T) {
+	// Freeze time
+	now := time.Unix(1600000000, 0)
+	timeFunc = func() time.Time { return now }
+	defer func() { timeFunc = time.Now }()
+
+	issuerKeys := func(_ context.Context) (*jose.JSONWebKeySet, error) {
+		var jwks jose.JSONWebKeySet
+		err := json.Unmarshal(issuerJWKS, &jwks)
+		return &jwks, err
+	}
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":                        "https://statements.example.org",
+			"iat":                        now.Unix(),
+			"software_id":                "4NRB1-0XZABZI9E6-5SM3R",
+			"software_version":           "2.1",
+			"client_name":                "Example Statement-based Client",
+			"client_uri":                 "https://client.example.net/",
+			"redirect_uris":              []string{"https://client.example.net/callback"},
+			"token_endpoint_auth_method": "private_key_jwt",
+			"jwks_uri":                   "https://client.example.net/jwks.json",
+			"dpop_bound_access_tokens":   true,
+		}
+	}
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		statement string
+		want      *corev1.SoftwareStatement
+		wantErr   error
+	}{
+		{
+			name:      "empty statement",
+			statement: "",
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "invalid syntax",
+			statement: "foo",
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "unsupported algorithm",
+			statement: signHMAC(t, validClaims()),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "missing software_id",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("software_id", nil)),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "missing issuer",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("iss", nil)),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "untrusted issuer",
+			statement: sign(t, issuerPrivateKey, validClaims()),
+			wantErr:   ErrUntrustedIssuer,
+		},
+		{
+			name:      "expired",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("exp", now.Add(-1*time.Hour).Unix())),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "not valid yet",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("nbf", now.Add(1*time.Hour).Unix())),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "issued in the future",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("iat", now.Add(1*time.Hour).Unix())),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name: "key set provider error",
+			opts: []Option{TrustedIssuer("https://statements.example.org", func(_ context.Context) (*jose.JSONWebKeySet, error) {
+				return nil, errors.New("test")
+			})},
+			statement: sign(t, issuerPrivateKey, validClaims()),
+			wantErr:   errors.New("test"),
+		},
+		{
+			name: "nil key set",
+			opts: []Option{TrustedIssuer("https://statements.example.org", func(_ context.Context) (*jose.JSONWebKeySet, error) {
+				return nil, nil
+			})},
+			statement: sign(t, issuerPrivateKey, validClaims()),
+			wantErr:   errors.New("nil key set"),
+		},
+		{
+			name:      "unknown key",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: signWithKey(t, &jose.JSONWebKey{KeyID: "unknown-key", Key: otherKey}, validClaims()),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name:      "invalid signature",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: signWithKey(t, &jose.JSONWebKey{KeyID: "issuer-key", Key: otherKey}, validClaims()),
+			wantErr:   ErrInvalidStatement,
+		},
+		{
+			name: "unapproved software",
+			opts: []Option{
+				TrustedIssuer("https://statements.example.org", issuerKeys),
+				ApprovedSoftware("other-software"),
+			},
+			statement: sign(t, issuerPrivateKey, validClaims()),
+			wantErr:   ErrUnapprovedSoftware,
+		},
+		{
+			name:      "invalid jwks",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("jwks", "foo")),
+			wantErr:   ErrInvalidStatement,
+		},
+		// ---------------------------------------------------------------------
+		{
+			name: "valid",
+			opts: []Option{
+				TrustedIssuer("https://statements.example.org", issuerKeys),
+				ApprovedSoftware("4NRB1-0XZABZI9E6-5SM3R"),
+			},
+			statement: sign(t, issuerPrivateKey, validClaims()),
+			want: &corev1.SoftwareStatement{
+				SoftwareId: "4NRB1-0XZABZI9E6-5SM3R",
+				Issuer:     "https://statements.example.org",
+				Metadata: &corev1.ClientMeta{
+					SoftwareId:              &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+					SoftwareVersion:         &wrapperspb.StringValue{Value: "2.1"},
+					ClientName:              &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+					ClientUri:               &wrapperspb.StringValue{Value: "https://client.example.net/"},
+					RedirectUris:            []string{"https://client.example.net/callback"},
+					TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: "private_key_jwt"},
+					JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.net/jwks.json"},
+					DpopBoundAccessTokens:   &wrapperspb.BoolValue{Value: true},
+				},
+			},
+		},
+		{
+			name:      "valid without approval list",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("jwks_uri", nil)),
+			want: &corev1.SoftwareStatement{
+				SoftwareId: "4NRB1-0XZABZI9E6-5SM3R",
+				Issuer:     "https://statements.example.org",
+				Metadata: &corev1.ClientMeta{
+					SoftwareId:              &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+					SoftwareVersion:         &wrapperspb.StringValue{Value: "2.1"},
+					ClientName:              &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+					ClientUri:               &wrapperspb.StringValue{Value: "https://client.example.net/"},
+					RedirectUris:            []string{"https://client.example.net/callback"},
+					TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: "private_key_jwt"},
+					DpopBoundAccessTokens:   &wrapperspb.BoolValue{Value: true},
+				},
+			},
+		},
+		{
+			name:      "valid with localized members",
+			opts:      []Option{TrustedIssuer("https://statements.example.org", issuerKeys)},
+			statement: sign(t, issuerPrivateKey, withClaim("client_name#fr", "Client basé sur une déclaration")),
+			want: &corev1.SoftwareStatement{
+				SoftwareId: "4NRB1-0XZABZI9E6-5SM3R",
+				Issuer:     "https://statements.example.org",
+				Metadata: &corev1.ClientMeta{
+					SoftwareId:              &wrapperspb.StringValue{Value: "4NRB1-0XZABZI9E6-5SM3R"},
+					SoftwareVersion:         &wrapperspb.StringValue{Value: "2.1"},
+					ClientName:              &wrapperspb.StringValue{Value: "Example Statement-based Client"},
+					ClientNameI18N:          map[string]string{"fr": "Client basé sur une déclaration"},
+					ClientUri:               &wrapperspb.StringValue{Value: "https://client.example.net/"},
+					RedirectUris:            []string{"https://client.example.net/callback"},
+					TokenEndpointAuthMethod: &wrapperspb.StringValue{Value: "private_key_jwt"},
+					JwkUri:                  &wrapperspb.StringValue{Value: "https://client.example.net/jwks.json"},
+					DpopBoundAccessTokens:   &wrapperspb.BoolValue{Value: true},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := DefaultVerifier(tt.opts...)
+
+			got, err := v.Verify(context.Background(), tt.statement)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) && !strings.Contains(err.Error(), tt.wantErr.Error()) {
+					t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("%q. Verify():\n-got/+want\ndiff %s", tt.name, diff)
+			}
+		})
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func sign(t *testing.T, rawKey []byte, claims interface{}) string {
+	var privateKey jose.JSONWebKey
+	// Decode JWK
+	if err := json.Unmarshal(rawKey, &privateKey); err != nil {
+		t.Fatalf("unable to decode private key: %v", err)
+		return ""
+	}
+
+	return signWithKey(t, &privateKey, claims)
+}
+
+func signWithKey(t *testing.T, privateKey *jose.JSONWebKey, claims interface{}) string {
+	// Prepare a signer
+	options := (&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), privateKey.KeyID)
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: privateKey.Key}, options)
+	if err != nil {
+		t.Fatalf("unable to prepare signer: %v", err)
+		return ""
+	}
+
+	raw, err := jwt.Signed(sig).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatalf("unable to generate final statement")
+	}
+
+	// Statement
+	return raw
+}
+
+func signHMAC(t *testing.T, claims interface{}) string {
+	// Prepare a signer
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("bhKjdyGqbEZZXpMHbU6Tzdsfp8D7qJvS")}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatalf("unable to prepare signer: %v", err)
+		return ""
+	}
+
+	raw, err := jwt.Signed(sig).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatalf("unable to generate final statement")
+	}
+
+	// Statement
+	return raw
+}
@@ -20,12 +20,17 @@ package storage
 import (
 	"context"
 	"errors"
+	"time"
 
 	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
 )
 
-// ErrNotFound is returned when the query return no result.
-var ErrNotFound = errors.New("no result found")
+var (
+	// ErrNotFound is returned when the query return no result.
+	ErrNotFound = errors.New("no result found")
+	// ErrAlreadyExists is returned when the identifier is already registered.
+	ErrAlreadyExists = errors.New("already exists")
+)
 
 //go:generate mockgen -destination mock/clientreader.gen.go -package mock zntr.io/solid/pkg/server/storage ClientReader
 
@@ -40,6 +45,8 @@ type ClientReader interface {
 // ClientWriter describes client storage write-only operation contract.
 type ClientWriter interface {
 	Register(ctx context.Context, c *corev1.Client) (string, error)
+	Update(ctx context.Context, c *corev1.Client) error
+	Delete(ctx context.Context, id string) error
 }
 
 //go:generate mockgen -destination mock/client.gen.go -package mock zntr.io/solid/pkg/server/storage Client
@@ -88,6 +95,8 @@ type TokenWriter interface {
 	Create(ctx context.Context, t *corev1.Token) error
 	Delete(ctx context.Context, id string) error
 	Revoke(ctx context.Context, id string) error
+	// RevokeByClient revokes all tokens issued to the given client.
+	RevokeByClient(ctx context.Context, clientID string) error
 }
 
 //go:generate mockgen -destination mock/token.gen.go -package mock zntr.io/solid/pkg/server/storage Token
@@ -154,3 +163,66 @@ type DPoP interface {
 	Delete(ctx context.Context, id string) error
 	Exists(ctx context.Context, id string) (bool, error)
 }
+
+//go:generate mockgen -destination mock/client_assertion.gen.go -package mock zntr.io/solid/pkg/server/storage ClientAssertion
+
+// ClientAssertion describes client assertion jti storage to prevent assertion replay attack.
+type ClientAssertion interface {
+	// Register atomically records the assertion identifier if absent. It
+	// returns ErrAlreadyExists when the identifier is already registered.
+	Register(ctx context.Context, id string, expiresIn time.Duration) error
+}
+
+//go:generate mockgen -destination mock/key_reader.gen.go -package mock zntr.io/solid/pkg/server/storage KeyReader
+
+// KeyReader describes server key storage read-only operation contract.
+type KeyReader interface {
+	All(ctx context.Context) ([]*corev1.Key, error)
+}
+
+//go:generate mockgen -destination mock/key_writer.gen.go -package mock zntr.io/solid/pkg/server/storage KeyWriter
+
+// KeyWriter describes server key storage write-only operation contract.
+type KeyWriter interface {
+	Register(ctx context.Context, k *corev1.Key) error
+	Update(ctx context.Context, k *corev1.Key) error
+	Delete(ctx context.Context, kid string) error
+}
+
+//go:generate mockgen -destination mock/key.gen.go -package mock zntr.io/solid/pkg/server/storage Key
+
+// Key describes server key storage contract.
+type Key interface {
+	KeyReader
+	KeyWriter
+}
+
+//go:generate mockgen -destination mock/initial_access_token_reader.gen.go -package mock zntr.io/solid/pkg/server/storage InitialAccessTokenReader
+
+// InitialAccessTokenReader describes initial access token storage read-only operation contract.
+type InitialAccessTokenReader interface {
+	// GetByValue retrieves a token by its value. Token values are credentials,
+	// implementations should only persist and index their hashes.
+	GetByValue(ctx context.Context, value string) (*corev1.InitialAccessToken, error)
+}
+
+//go:generate mockgen -destination mock/initial_access_token_writer.gen.go -package mock zntr.io/solid/pkg/server/storage InitialAccessTokenWriter
+
+// InitialAccessTokenWriter describes initial access token storage write-only operation contract.
+type InitialAccessTokenWriter interface {
+	Create(ctx context.Context, t *corev1.InitialAccessToken) error
+	// Use atomically increments the token usage count and returns the updated value.
+	Use(ctx context.Context, id string) (uint64, error)
+	// Release atomically decrements the token usage count, it cancels a usage
+	// which didn't lead to a registered client.
+	Release(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockgen -destination mock/initial_access_token.gen.go -package mock zntr.io/solid/pkg/server/storage InitialAccessToken
+
+// InitialAccessToken describes initial access token storage contract.
+type InitialAccessToken interface {
+	InitialAccessTokenReader
+	InitialAccessTokenWriter
+}
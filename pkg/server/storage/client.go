@@ -0,0 +1,34 @@
+// Licensed to SolID under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. SolID licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storage
+
+import (
+	corev1 "zntr.io/solid/api/gen/go/oidc/core/v1"
+)
+
+// ClientStatus returns the lifecycle status of the given client.
+//
+// Clients persisted before the status attribute was introduced have no status
+// and are considered active.
+func ClientStatus(c *corev1.Client) corev1.ClientStatus {
+	if c.GetStatus() == corev1.ClientStatus_CLIENT_STATUS_INVALID {
+		return corev1.ClientStatus_CLIENT_STATUS_ACTIVE
+	}
+
+	return c.GetStatus()
+}